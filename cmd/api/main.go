@@ -1,15 +1,20 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 
 	"github.com/codepnw/simple-bank/docs/swagger"
+	userrepository "github.com/codepnw/simple-bank/internal/features/user/repository"
 	"github.com/codepnw/simple-bank/internal/server"
 	"github.com/codepnw/simple-bank/pkg/config"
+	"github.com/codepnw/simple-bank/pkg/currency"
 	"github.com/codepnw/simple-bank/pkg/database"
+	"github.com/codepnw/simple-bank/pkg/fx"
 	"github.com/codepnw/simple-bank/pkg/token"
+	"github.com/codepnw/simple-bank/pkg/token/denylist"
 	"github.com/codepnw/simple-bank/pkg/token/jwtmaker"
 	"github.com/codepnw/simple-bank/pkg/token/pasetomaker"
 	"golang.org/x/sync/errgroup"
@@ -41,23 +46,60 @@ func main() {
 
 	// gRPC Server
 	g.Go(func() error {
-		return server.RunGrpcServer(cfg, app.db, app.tx, app.token)
+		return server.RunGrpcServer(cfg, app.db, app.tx, app.token, app.hasher, app.denylist, app.fx, app.currencies)
 	})
 
 	// HTTP Server
 	g.Go(func() error {
-		return server.RunHTTPServer(cfg, app.db, app.tx, app.token)
+		return server.RunHTTPServer(cfg, app.db, app.tx, app.token, app.hasher, app.denylist, app.fx, app.currencies)
 	})
 
+	// Scheduled Transfers Worker (optional)
+	if cfg.Scheduler.Enabled {
+		g.Go(func() error {
+			return server.RunScheduler(cfg, app.db, app.tx, app.fx, app.currencies)
+		})
+	}
+
+	// Hold Expiry: releases reserved funds, so it always runs
+	g.Go(func() error {
+		return server.RunHoldExpiry(cfg, app.db, app.tx, app.fx, app.currencies)
+	})
+
+	// Balance Reconciliation (optional, report only)
+	if cfg.Reconcile.Interval > 0 {
+		g.Go(func() error {
+			return server.RunReconciler(cfg, app.db, app.tx)
+		})
+	}
+
+	// Interest Accrual and Posting
+	if cfg.Interest.Interval > 0 {
+		g.Go(func() error {
+			return server.RunInterest(cfg, app.db, app.tx)
+		})
+	}
+
+	// gRPC Gateway (optional)
+	if cfg.Server.GatewayAddr != "" {
+		g.Go(func() error {
+			return server.RunGatewayServer(cfg)
+		})
+	}
+
 	if err := g.Wait(); err != nil {
 		log.Fatalf("server crashed: %v", err)
 	}
 }
 
 type appContainer struct {
-	db    *sql.DB
-	tx    database.TxManager
-	token token.TokenMaker
+	db         *sql.DB
+	tx         database.TxManager
+	token      token.TokenMaker
+	hasher     *token.Hasher
+	denylist   token.Denylist
+	fx         fx.FXRateProvider
+	currencies *currency.Registry
 }
 
 func initialize(cfg *config.EnvConfig) (*appContainer, func(), error) {
@@ -89,6 +131,42 @@ func initialize(cfg *config.EnvConfig) (*appContainer, func(), error) {
 		return nil, nil, fmt.Errorf("failed init paseto: %v", err)
 	}
 
+	// New FX Rate Provider : Static
+	fxProvider, err := fx.LoadStaticProvider(cfg.FX.RatesFile)
+	if err != nil {
+		db.Close()
+		return nil, nil, fmt.Errorf("failed init fx: %v", err)
+	}
+
+	// Currency Registry : file or currencies table; file codes must exist in the table
+	var currencies *currency.Registry
+	if cfg.Currency.File != "" {
+		currencies, err = currency.LoadFile(cfg.Currency.File)
+		if err == nil {
+			err = currencies.CheckDB(context.Background(), db)
+		}
+	} else {
+		currencies, err = currency.LoadDB(context.Background(), db)
+	}
+	if err != nil {
+		db.Close()
+		return nil, nil, fmt.Errorf("failed init currencies: %v", err)
+	}
+
+	// Refresh Token Hasher : hash any plaintext tokens left by migration 000011
+	hasher := token.NewHasher(cfg.Auth.RefreshTokenSecret)
+	hashed, err := userrepository.HashLegacyTokens(context.Background(), db, hasher)
+	if err != nil {
+		db.Close()
+		return nil, nil, fmt.Errorf("failed hash legacy tokens: %v", err)
+	}
+	if hashed > 0 {
+		log.Printf("hashed %d legacy refresh tokens", hashed)
+	}
+
+	// Access Token Denylist : in-memory cache over the shared table
+	denied := denylist.NewLayered(denylist.NewMemory(), denylist.NewPostgres(db))
+
 	cleanup := func() {
 		if err := db.Close(); err != nil {
 			log.Printf("failed to close db: %v", err)
@@ -96,8 +174,12 @@ func initialize(cfg *config.EnvConfig) (*appContainer, func(), error) {
 	}
 
 	return &appContainer{
-		db:    db,
-		tx:    tx,
-		token: pasetoToken, // Change Token PASETO or JWT
+		db:         db,
+		tx:         tx,
+		token:      pasetoToken, // Change Token PASETO or JWT
+		hasher:     hasher,
+		denylist:   denied,
+		fx:         fxProvider,
+		currencies: currencies,
 	}, cleanup, nil
 }
@@ -0,0 +1,119 @@
+// Command reconcile recomputes every account balance from its entries,
+// checks every transfer against its journal and writes the discrepancies as
+// JSON or CSV. It exits with status 2 when any are found.
+//
+//	go run ./cmd/reconcile -format csv -out report.csv
+//
+// With -correct and the email of an admin, it also opens correction journals
+// that book each difference against the currency's suspense account:
+//
+//	go run ./cmd/reconcile -correct -admin admin@example.com
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"io"
+	"log"
+	"os"
+
+	accountrepository "github.com/codepnw/simple-bank/internal/features/account/repository"
+	entryrepository "github.com/codepnw/simple-bank/internal/features/entry/repository"
+	"github.com/codepnw/simple-bank/internal/features/ledger"
+	ledgerrepository "github.com/codepnw/simple-bank/internal/features/ledger/repository"
+	ledgerusecase "github.com/codepnw/simple-bank/internal/features/ledger/usecase"
+	userrepository "github.com/codepnw/simple-bank/internal/features/user/repository"
+	"github.com/codepnw/simple-bank/pkg/auth"
+	"github.com/codepnw/simple-bank/pkg/config"
+	"github.com/codepnw/simple-bank/pkg/database"
+	"github.com/codepnw/simple-bank/pkg/token"
+)
+
+func main() {
+	envFile := flag.String("env", ".env", "environment file")
+	format := flag.String("format", "json", "report format: json or csv")
+	out := flag.String("out", "", "report file (default stdout)")
+	correct := flag.Bool("correct", false, "open correction journals for balance drift")
+	admin := flag.String("admin", "", "email of the admin making the correction (required with -correct)")
+	flag.Parse()
+
+	f, err := ledger.ParseReportFormat(*format)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *correct && *admin == "" {
+		log.Fatal("-correct requires -admin")
+	}
+
+	cfg, err := config.LoadEnv(*envFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	db, err := database.ConnectPostgres(&cfg.DB)
+	if err != nil {
+		log.Fatalf("failed connect db: %v", err)
+	}
+	defer db.Close()
+
+	tx, err := database.NewTransaction(db)
+	if err != nil {
+		log.Fatalf("failed init tx: %v", err)
+	}
+
+	uc := ledgerusecase.NewReconcileUsecase(
+		ledgerrepository.NewLedgerRepository(db),
+		accountrepository.NewAccountRepository(db),
+		entryrepository.NewEntryRepository(db),
+		tx,
+	)
+	ctx := context.Background()
+
+	report, err := uc.Reconcile(ctx)
+	if err != nil {
+		log.Fatalf("reconcile failed: %v", err)
+	}
+
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		file, err := os.Create(*out)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer file.Close()
+		w = file
+	}
+	if err := ledger.WriteReport(w, f, report); err != nil {
+		log.Fatalf("write report failed: %v", err)
+	}
+
+	if report.Clean() {
+		return
+	}
+	if !*correct {
+		log.Printf("%d balance and %d transfer discrepancies", len(report.Balances), len(report.Transfers))
+		os.Exit(2)
+	}
+
+	// Correction runs as the admin, with the role checked in the database
+	// rather than taken from a token
+	usr, err := userrepository.NewUserRepository(db, token.NewHasher(cfg.Auth.RefreshTokenSecret)).FindByEmail(ctx, *admin)
+	if err != nil {
+		log.Fatalf("find admin failed: %v", err)
+	}
+	ctx = auth.SetRole(auth.SetUserID(ctx, usr.ID), usr.Role)
+
+	journals, err := uc.Correct(ctx)
+	if err != nil {
+		log.Fatalf("correct failed: %v", err)
+	}
+
+	enc := json.NewEncoder(os.Stderr)
+	for _, j := range journals {
+		if err := enc.Encode(j); err != nil {
+			log.Fatal(err)
+		}
+	}
+	log.Printf("opened %d correction journals", len(journals))
+}
@@ -0,0 +1,8 @@
+// Package openapi embeds the OpenAPI document generated from
+// proto/transfer_service.proto by protoc-gen-openapiv2.
+package openapi
+
+import _ "embed"
+
+//go:embed simplebank.swagger.json
+var Spec []byte
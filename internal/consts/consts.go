@@ -12,11 +12,40 @@ type contextKey string
 
 // Context Config
 const (
-	ContextTimeout = time.Second * 10
+	ContextTimeout   = time.Second * 10
+	StatementTimeout = time.Minute * 2
 
 	// Context Key
 	ContextUserClaimsKey contextKey = "user-claims"
 	ContextUserIDKey     contextKey = "user-id"
+	ContextUserRoleKey   contextKey = "user-role"
+	ContextClientKey     contextKey = "client"
 )
 
-const ParamAccountID = "account_id"
+const (
+	ParamAccountID   = "account_id"
+	ParamTransferID  = "transfer_id"
+	ParamSessionID   = "session_id"
+	ParamUserID      = "user_id"
+	ParamEntryID     = "entry_id"
+	ParamScheduledID = "scheduled_id"
+	ParamHoldID      = "hold_id"
+)
+
+// List Direction
+const (
+	DirectionIncoming = "incoming"
+	DirectionOutgoing = "outgoing"
+)
+
+// Idempotency
+const (
+	HeaderIdempotencyKey   = "Idempotency-Key"
+	MetadataIdempotencyKey = "idempotency-key"
+	IdempotencyKeyMaxLen   = 255
+)
+
+// Transfer Limits
+const (
+	TransferLimitWindow = time.Hour * 24
+)
@@ -1,19 +1,119 @@
 package account
 
-import "time"
+import (
+	"time"
 
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+)
+
+// AccountCurrency is an ISO 4217 code from the currency registry.
 type AccountCurrency string
 
+type AccountType string
+
+const (
+	TypeCustomer AccountType = "customer"
+	// TypeCash is the system clearing account backing deposits and withdrawals
+	TypeCash AccountType = "cash"
+	// TypeFX holds the bank's position in a currency; cross-currency
+	// transfers pass through it so each currency balances on its own
+	TypeFX AccountType = "fx"
+	// TypeFees collects fee revenue
+	TypeFees AccountType = "fees"
+	// TypeSuspense parks amounts that can't be attributed yet, such as
+	// balances that predate the journal
+	TypeSuspense AccountType = "suspense"
+	// TypeInterest is the house interest-expense account that pays interest
+	// to savings accounts
+	TypeInterest AccountType = "interest"
+)
+
+// Product is what a customer account is sold as. Savings accounts earn
+// interest at their currency's rate; current accounts don't.
+type Product string
+
 const (
-	CurrencyTHB AccountCurrency = "THB"
-	CurrencyUSD AccountCurrency = "USD"
+	ProductCurrent Product = "current"
+	ProductSavings Product = "savings"
 )
 
+func (p Product) Valid() bool {
+	switch p {
+	case ProductCurrent, ProductSavings:
+		return true
+	}
+	return false
+}
+
+// Status is the lifecycle state of an account. Only active accounts move
+// money; closed is final.
+type Status string
+
+const (
+	StatusActive Status = "active"
+	StatusFrozen Status = "frozen"
+	StatusClosed Status = "closed"
+)
+
+var statusTransitions = map[Status][]Status{
+	StatusActive: {StatusFrozen, StatusClosed},
+	StatusFrozen: {StatusActive, StatusClosed},
+}
+
+func (s Status) Valid() bool {
+	switch s {
+	case StatusActive, StatusFrozen, StatusClosed:
+		return true
+	}
+	return false
+}
+
+// CanBecome reports whether an account in status s may move to next.
+func (s Status) CanBecome(next Status) bool {
+	for _, allowed := range statusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
 type Account struct {
-	ID        int64           `json:"id"`
-	OwnerID   int64           `json:"owner_id"`
-	Balance   int64           `json:"balance"`
-	Currency  AccountCurrency `json:"currency"`
-	CreatedAt time.Time       `json:"created_at"`
-	UpdatedAt time.Time       `json:"updated_at"`
+	ID               int64           `json:"id"`
+	OwnerID          int64           `json:"owner_id"`
+	Balance          int64           `json:"balance"` // ledger balance
+	BalanceDisplay   string          `json:"balance_display,omitempty" example:"฿5,000.00"`
+	HeldAmount       int64           `json:"held_amount"`       // reserved by open holds
+	AvailableBalance int64           `json:"available_balance"` // Balance - HeldAmount + OverdraftLimit
+	OverdraftLimit   int64           `json:"overdraft_limit"`   // how far below zero Balance may go
+	OverdraftUsed    int64           `json:"overdraft_used"`    // how far below zero Balance is
+	Currency         AccountCurrency `json:"currency"`
+	Type             AccountType     `json:"type"`
+	Status           Status          `json:"status"`
+	Product          Product         `json:"product"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+}
+
+// CheckActive returns why money can't move in or out of the account, or nil
+// when it can.
+func (a *Account) CheckActive() error {
+	switch a.Status {
+	case StatusFrozen:
+		return errs.ErrAccountFrozen
+	case StatusClosed:
+		return errs.ErrAccountClosed
+	}
+	return nil
+}
+
+// StatusChange is the audit record of one status transition.
+type StatusChange struct {
+	ID        int64     `json:"id"`
+	AccountID int64     `json:"account_id"`
+	From      Status    `json:"from_status"`
+	To        Status    `json:"to_status"`
+	Reason    string    `json:"reason"`
+	ChangedBy int64     `json:"changed_by"`
+	CreatedAt time.Time `json:"created_at"`
 }
@@ -0,0 +1,185 @@
+package accountgrpc
+
+import (
+	"context"
+
+	"github.com/codepnw/simple-bank/internal/features/account"
+	accountusecase "github.com/codepnw/simple-bank/internal/features/account/usecase"
+	"github.com/codepnw/simple-bank/internal/features/entry"
+	"github.com/codepnw/simple-bank/internal/features/fee"
+	pb "github.com/codepnw/simple-bank/pb/proto"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/codepnw/simple-bank/pkg/utils/helper"
+	"github.com/codepnw/simple-bank/pkg/utils/pagination"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AccountServer struct {
+	pb.UnimplementedSimpleBankServer
+	uc accountusecase.AccountUsecase
+}
+
+func NewAccountServer(uc accountusecase.AccountUsecase) *AccountServer {
+	return &AccountServer{uc: uc}
+}
+
+// createAccountInput mirrors the REST CreateAccountReq rules.
+type createAccountInput struct {
+	Currency string `validate:"required,len=3"`
+	Product  string `validate:"omitempty,oneof=current savings"`
+}
+
+func (s *AccountServer) CreateAccount(ctx context.Context, req *pb.CreateAccountRequest) (*pb.CreateAccountResponse, error) {
+	input := &createAccountInput{Currency: req.GetCurrency(), Product: req.GetProduct()}
+	if err := helper.Validate(input); err != nil {
+		return nil, errs.InvalidInput(err)
+	}
+
+	data, err := s.uc.CreateAccount(ctx, account.AccountCurrency(input.Currency), account.Product(input.Product))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateAccountResponse{Account: ToPbAccount(data)}, nil
+}
+
+func (s *AccountServer) GetAccount(ctx context.Context, req *pb.GetAccountRequest) (*pb.GetAccountResponse, error) {
+	if req.GetAccountId() <= 0 {
+		return nil, errs.ErrInvalidID
+	}
+
+	data, err := s.uc.GetAccount(ctx, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetAccountResponse{Account: ToPbAccount(data)}, nil
+}
+
+func (s *AccountServer) ListAccounts(ctx context.Context, req *pb.ListAccountsRequest) (*pb.ListAccountsResponse, error) {
+	page, err := pagination.NewPage(int(req.GetPage()), int(req.GetSize()), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	data, meta, err := s.uc.ListAccounts(ctx, page)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]*pb.Account, 0, len(data))
+	for _, acc := range data {
+		accounts = append(accounts, ToPbAccount(acc))
+	}
+	return &pb.ListAccountsResponse{
+		Accounts:   accounts,
+		Pagination: toPbPagination(meta),
+	}, nil
+}
+
+func (s *AccountServer) Deposit(ctx context.Context, req *pb.DepositRequest) (*pb.DepositResponse, error) {
+	input := &accountusecase.MoneyParams{
+		AccountID: req.GetAccountId(),
+		Amount:    req.GetAmount(),
+		Currency:  req.GetCurrency(),
+	}
+
+	data, err := s.uc.Deposit(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.DepositResponse{
+		Account: ToPbAccount(data.Account),
+		Entry:   toPbEntry(data.Entry),
+	}
+	return resp, nil
+}
+
+func (s *AccountServer) Withdraw(ctx context.Context, req *pb.WithdrawRequest) (*pb.WithdrawResponse, error) {
+	input := &accountusecase.MoneyParams{
+		AccountID: req.GetAccountId(),
+		Amount:    req.GetAmount(),
+		Currency:  req.GetCurrency(),
+	}
+
+	data, err := s.uc.Withdraw(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.WithdrawResponse{
+		Account: ToPbAccount(data.Account),
+		Entry:   toPbEntry(data.Entry),
+		Fee:     toPbFee(data.Fee),
+	}
+	if data.FeeEntry != nil {
+		resp.FeeEntry = toPbEntry(data.FeeEntry)
+	}
+	return resp, nil
+}
+
+func (s *AccountServer) QuoteWithdraw(ctx context.Context, req *pb.QuoteWithdrawRequest) (*pb.QuoteWithdrawResponse, error) {
+	input := &accountusecase.MoneyParams{
+		AccountID: req.GetAccountId(),
+		Amount:    req.GetAmount(),
+		Currency:  req.GetCurrency(),
+	}
+
+	data, err := s.uc.QuoteWithdraw(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.QuoteWithdrawResponse{Fee: toPbFee(data)}, nil
+}
+
+// ToPbAccount is the one account mapping every gRPC response uses, so none
+// drops a field.
+func ToPbAccount(acc *account.Account) *pb.Account {
+	return &pb.Account{
+		Id:               acc.ID,
+		OwnerId:          acc.OwnerID,
+		Balance:          acc.Balance,
+		Currency:         string(acc.Currency),
+		Type:             string(acc.Type),
+		Status:           string(acc.Status),
+		CreatedAt:        timestamppb.New(acc.CreatedAt),
+		UpdatedAt:        timestamppb.New(acc.UpdatedAt),
+		BalanceDisplay:   acc.BalanceDisplay,
+		HeldAmount:       acc.HeldAmount,
+		AvailableBalance: acc.AvailableBalance,
+		Product:          string(acc.Product),
+		OverdraftLimit:   acc.OverdraftLimit,
+		OverdraftUsed:    acc.OverdraftUsed,
+	}
+}
+
+func toPbFee(f *fee.Breakdown) *pb.Fee {
+	if f == nil {
+		return nil
+	}
+	return &pb.Fee{
+		RuleId:     f.RuleID,
+		Currency:   f.Currency,
+		Flat:       f.Flat,
+		Percentage: f.Percentage,
+		Adjustment: f.Adjustment,
+		Total:      f.Total,
+	}
+}
+
+func toPbPagination(meta *pagination.Meta) *pb.Pagination {
+	return &pb.Pagination{
+		NextCursor: meta.NextCursor,
+		HasMore:    meta.HasMore,
+		PageSize:   int32(meta.PageSize),
+	}
+}
+
+func toPbEntry(ent *entry.Entry) *pb.Entry {
+	return &pb.Entry{
+		Id:        ent.ID,
+		JournalId: ent.JournalID,
+		AccountId: ent.AccountID,
+		Amount:    ent.Amount,
+		CreatedAt: timestamppb.New(ent.CreatedAt),
+	}
+}
@@ -1,5 +1,28 @@
 package accounthandler
 
 type CreateAccountReq struct {
-	Currency string `json:"currency" binding:"required,oneof=THB thb USD usd" example:"THB"`
+	Currency string `json:"currency" binding:"required,len=3" example:"THB"`
+	Product  string `json:"product" binding:"omitempty,oneof=current savings" example:"savings"` // default current
+}
+
+type MoneyReq struct {
+	Amount   int64  `json:"amount" binding:"required,gt=0" example:"10000"`
+	Currency string `json:"currency" binding:"required,len=3" example:"THB"`
+}
+
+type ListEntriesReq struct {
+	Direction string `form:"direction" binding:"omitempty,oneof=incoming outgoing"`
+	From      string `form:"from"`
+	To        string `form:"to"`
+	MinAmount int64  `form:"min_amount" binding:"omitempty,min=1"`
+	MaxAmount int64  `form:"max_amount" binding:"omitempty,min=1"`
+	Cursor    string `form:"cursor"`
+	Page      int    `form:"page"`
+	Size      int    `form:"size"`
+}
+
+type StatementReq struct {
+	From   string `form:"from"`
+	To     string `form:"to"`
+	Format string `form:"format" binding:"omitempty,oneof=csv jsonl pdf"`
 }
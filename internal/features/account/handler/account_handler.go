@@ -1,13 +1,17 @@
 package accounthandler
 
 import (
-	"errors"
+	"fmt"
+	"log"
+	"net/http"
 
 	"github.com/codepnw/simple-bank/internal/consts"
 	"github.com/codepnw/simple-bank/internal/features/account"
+	accountstatement "github.com/codepnw/simple-bank/internal/features/account/statement"
 	accountusecase "github.com/codepnw/simple-bank/internal/features/account/usecase"
 	"github.com/codepnw/simple-bank/pkg/utils/errs"
 	"github.com/codepnw/simple-bank/pkg/utils/helper"
+	"github.com/codepnw/simple-bank/pkg/utils/pagination"
 	"github.com/codepnw/simple-bank/pkg/utils/response"
 	"github.com/gin-gonic/gin"
 )
@@ -21,7 +25,7 @@ func NewAccountHandler(uc accountusecase.AccountUsecase) *accountHandler {
 }
 
 // @Summary Create Account
-// @Description user create account
+// @Description user create account (current by default, or savings)
 // @Tags accounts
 // @Accept       json
 // @Produce      json
@@ -36,29 +40,14 @@ func NewAccountHandler(uc accountusecase.AccountUsecase) *accountHandler {
 func (h *accountHandler) CreateAccount(c *gin.Context) {
 	req := new(CreateAccountReq)
 	if err := c.ShouldBindJSON(req); err != nil {
-		response.BadRequest(c, err.Error())
-		return
-	}
-
-	data, err := h.uc.CreateAccount(c.Request.Context(), account.AccountCurrency(req.Currency))
-	if err != nil {
-		switch err {
-		case errs.ErrNoUserID:
-			response.Unauthorized(c, err.Error())
-			return
-		case errs.ErrInvalidCurrency:
-			response.BadRequest(c, err.Error())
-			return
-		case errs.ErrCurrencyAlreadyExists:
-			response.BadRequest(c, err.Error())
-			return
-		case errs.ErrAccountNotFound:
-			response.NotFound(c, err.Error())
-			return
-		default:
-			response.InternalServerError(c, err)
-			return
-		}
+		response.Error(c, errs.InvalidInput(err))
+		return
+	}
+
+	data, err := h.uc.CreateAccount(c.Request.Context(), account.AccountCurrency(req.Currency), account.Product(req.Product))
+	if err != nil {
+		response.Error(c, err)
+		return
 	}
 	response.Created(c, "", data)
 }
@@ -79,20 +68,14 @@ func (h *accountHandler) CreateAccount(c *gin.Context) {
 func (h *accountHandler) GetAccount(c *gin.Context) {
 	id, err := helper.ParseInt64(c.Param(consts.ParamAccountID))
 	if err != nil {
-		response.BadRequest(c, err.Error())
+		response.Error(c, errs.ErrInvalidID.Wrap(err))
 		return
 	}
 
 	data, err := h.uc.GetAccount(c.Request.Context(), id)
 	if err != nil {
-		switch err {
-		case errs.ErrAccountNotFound:
-			response.NotFound(c, err.Error())
-			return
-		default:
-			response.InternalServerError(c, err)
-			return
-		}
+		response.Error(c, err)
+		return
 	}
 	response.Success(c, "", data)
 }
@@ -102,25 +85,269 @@ func (h *accountHandler) GetAccount(c *gin.Context) {
 // @Tags accounts
 // @Accept       json
 // @Produce      json
-// @Param page query int false "Page number"
+// @Param cursor query string false "Cursor from the previous page's next_cursor"
+// @Param page query int false "Page number (offset mode, ignored when cursor is set)"
 // @Param size query int false "Page size"
-// @Success 200 {array} account.Account "List Accounts Successfully"
+// @Success 200 {object} response.PageResponse{data=[]account.Account} "List Accounts Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
 // @Failure 401 {object} response.ErrorResponse "Unauthorized"
 // @Failure 500 {object} response.ErrorResponse "Internal Server Error"
 // @Security     BearerAuth
 // @Router /accounts [get]
 func (h *accountHandler) ListAccounts(c *gin.Context) {
-	page := helper.ParseInt(c.Query("page"))
-	size := helper.ParseInt(c.Query("size"))
+	page, err := pagination.NewPage(
+		helper.ParseInt(c.Query("page")),
+		helper.ParseInt(c.Query("size")),
+		c.Query("cursor"),
+	)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	data, meta, err := h.uc.ListAccounts(c.Request.Context(), page)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.SuccessPage(c, "", data, meta)
+}
+
+// @Summary Deposit
+// @Description admin only: deposit cash into a customer's account
+// @Tags accounts
+// @Accept       json
+// @Produce      json
+// @Param id path int true "Account ID"
+// @Param request body MoneyReq true "Deposit Data"
+// @Success 201 {object} accountusecase.MoneyResult "Deposit Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Account Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /accounts/{id}/deposits [post]
+func (h *accountHandler) Deposit(c *gin.Context) {
+	input, ok := h.bindMoneyParams(c)
+	if !ok {
+		return
+	}
+
+	data, err := h.uc.Deposit(c.Request.Context(), input)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.Created(c, "deposit success", data)
+}
+
+// @Summary Withdraw
+// @Description withdraw money from account
+// @Tags accounts
+// @Accept       json
+// @Produce      json
+// @Param id path int true "Account ID"
+// @Param request body MoneyReq true "Withdraw Data"
+// @Success 201 {object} accountusecase.MoneyResult "Withdraw Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Account Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /accounts/{id}/withdrawals [post]
+func (h *accountHandler) Withdraw(c *gin.Context) {
+	input, ok := h.bindMoneyParams(c)
+	if !ok {
+		return
+	}
+
+	data, err := h.uc.Withdraw(c.Request.Context(), input)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.Created(c, "withdraw success", data)
+}
+
+// @Summary Quote Withdraw
+// @Description price a withdrawal before making it; the fee is charged on top of the amount
+// @Tags accounts
+// @Accept       json
+// @Produce      json
+// @Param id path int true "Account ID"
+// @Param request body MoneyReq true "Withdraw Data"
+// @Success 200 {object} fee.Breakdown "Quote Withdraw Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Account Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /accounts/{id}/withdrawals/quote [post]
+func (h *accountHandler) QuoteWithdraw(c *gin.Context) {
+	input, ok := h.bindMoneyParams(c)
+	if !ok {
+		return
+	}
 
-	data, err := h.uc.ListAccounts(c.Request.Context(), page, size)
+	data, err := h.uc.QuoteWithdraw(c.Request.Context(), input)
 	if err != nil {
-		if errors.Is(err, errs.ErrNoUserID) {
-			response.Unauthorized(c, err.Error())
-			return
-		}
-		response.InternalServerError(c, err)
+		response.Error(c, err)
 		return
 	}
 	response.Success(c, "", data)
 }
+
+func (h *accountHandler) bindMoneyParams(c *gin.Context) (*accountusecase.MoneyParams, bool) {
+	id, err := helper.ParseInt64(c.Param(consts.ParamAccountID))
+	if err != nil {
+		response.Error(c, errs.ErrInvalidID.Wrap(err))
+		return nil, false
+	}
+
+	req := new(MoneyReq)
+	if err := c.ShouldBindJSON(req); err != nil {
+		response.Error(c, errs.InvalidInput(err))
+		return nil, false
+	}
+
+	input := &accountusecase.MoneyParams{
+		AccountID: id,
+		Amount:    req.Amount,
+		Currency:  req.Currency,
+	}
+	return input, true
+}
+
+// @Summary List Entries
+// @Description list balance entries of an account
+// @Tags accounts
+// @Accept       json
+// @Produce      json
+// @Param id path int true "Account ID"
+// @Param direction query string false "incoming (credit) or outgoing (debit)"
+// @Param from query string false "From time (RFC3339 or YYYY-MM-DD)"
+// @Param to query string false "To time, inclusive (RFC3339 or YYYY-MM-DD)"
+// @Param min_amount query int false "Minimum absolute amount"
+// @Param max_amount query int false "Maximum absolute amount"
+// @Param cursor query string false "Cursor from the previous page's next_cursor"
+// @Param page query int false "Page number (offset mode, ignored when cursor is set)"
+// @Param size query int false "Page size"
+// @Success 200 {object} response.PageResponse{data=[]entry.Entry} "List Entries Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Account Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /accounts/{id}/entries [get]
+func (h *accountHandler) ListEntries(c *gin.Context) {
+	id, err := helper.ParseInt64(c.Param(consts.ParamAccountID))
+	if err != nil {
+		response.Error(c, errs.ErrInvalidID.Wrap(err))
+		return
+	}
+
+	req := new(ListEntriesReq)
+	if err := c.ShouldBindQuery(req); err != nil {
+		response.Error(c, errs.InvalidInput(err))
+		return
+	}
+
+	from, to, err := helper.ParseTimeRange(req.From, req.To)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	page, err := pagination.NewPage(req.Page, req.Size, req.Cursor)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	input := &accountusecase.ListEntriesParams{
+		AccountID: id,
+		Direction: req.Direction,
+		From:      from,
+		To:        to,
+		MinAmount: req.MinAmount,
+		MaxAmount: req.MaxAmount,
+		Page:      page,
+	}
+	data, meta, err := h.uc.ListEntries(c.Request.Context(), input)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.SuccessPage(c, "", data, meta)
+}
+
+// @Summary Account Statement
+// @Description download an account statement for a period (defaults to the current month)
+// @Tags accounts
+// @Produce      text/csv
+// @Produce      application/x-ndjson
+// @Produce      application/pdf
+// @Param id path int true "Account ID"
+// @Param from query string false "From time (RFC3339 or YYYY-MM-DD)"
+// @Param to query string false "To time, inclusive (RFC3339 or YYYY-MM-DD)"
+// @Param format query string false "csv (default), jsonl or pdf"
+// @Success 200 {file} file "Statement File"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Account Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /accounts/{id}/statement [get]
+func (h *accountHandler) Statement(c *gin.Context) {
+	id, err := helper.ParseInt64(c.Param(consts.ParamAccountID))
+	if err != nil {
+		response.Error(c, errs.ErrInvalidID.Wrap(err))
+		return
+	}
+
+	req := new(StatementReq)
+	if err := c.ShouldBindQuery(req); err != nil {
+		response.Error(c, errs.InvalidInput(err))
+		return
+	}
+
+	format, err := accountstatement.ParseFormat(req.Format)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	from, to, err := helper.ParseTimeRange(req.From, req.To)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	input := &accountusecase.StatementParams{
+		AccountID: id,
+		From:      from,
+		To:        to,
+	}
+	header, err := h.uc.PrepareStatement(c.Request.Context(), input)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	filename := fmt.Sprintf("statement-%d-%s-%s.%s",
+		header.AccountID,
+		header.From.Format("20060102"),
+		header.To.Format("20060102"),
+		format,
+	)
+	c.Header("Content-Type", format.ContentType())
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Status(http.StatusOK)
+
+	// The body is already streaming, so a failure can only cut it short.
+	w := accountstatement.NewWriter(format, c.Writer)
+	if err := h.uc.WriteStatement(c.Request.Context(), header, w); err != nil {
+		log.Printf("write statement %d failed: %v", header.AccountID, err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/codepnw/simple-bank/internal/features/account"
 	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/codepnw/simple-bank/pkg/utils/pagination"
 	"github.com/lib/pq"
 )
 
@@ -15,10 +16,17 @@ import (
 type AccountRepository interface {
 	Insert(ctx context.Context, input *account.Account) (*account.Account, error)
 	FindByID(ctx context.Context, accountID int64) (*account.Account, error)
-	List(ctx context.Context, ownerID int64, limit, offset int) ([]*account.Account, error)
+	FindSystemAccount(ctx context.Context, accType account.AccountType, currency account.AccountCurrency) (*account.Account, error)
+	List(ctx context.Context, ownerID int64, page *pagination.Page) ([]*account.Account, error)
+	ListStatusChanges(ctx context.Context, accountID int64) ([]*account.StatusChange, error)
+	SetOverdraftLimit(ctx context.Context, accountID, limit int64) (*account.Account, error)
 
 	// Transaction
 	AddAccountBalance(ctx context.Context, tx *sql.Tx, accountID, amount int64) (*account.Account, error)
+	AddHeldAmount(ctx context.Context, tx *sql.Tx, accountID, amount int64) (*account.Account, error)
+	FindByIDForUpdate(ctx context.Context, tx *sql.Tx, accountID int64) (*account.Account, error)
+	UpdateStatus(ctx context.Context, tx *sql.Tx, accountID int64, status account.Status) (*account.Account, error)
+	InsertStatusChange(ctx context.Context, tx *sql.Tx, input *account.StatusChange) error
 }
 
 type accountRepository struct {
@@ -29,13 +37,50 @@ func NewAccountRepository(db *sql.DB) AccountRepository {
 	return &accountRepository{db: db}
 }
 
+// accountColumns are read by scanAccount.
+const accountColumns = `
+	id, owner_id, balance, held_amount, overdraft_limit, currency, type, status, product, created_at, updated_at
+`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAccount(row scanner) (*account.Account, error) {
+	acc := new(account.Account)
+	err := row.Scan(
+		&acc.ID,
+		&acc.OwnerID,
+		&acc.Balance,
+		&acc.HeldAmount,
+		&acc.OverdraftLimit,
+		&acc.Currency,
+		&acc.Type,
+		&acc.Status,
+		&acc.Product,
+		&acc.CreatedAt,
+		&acc.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	// The overdraft limit can be spent; held funds can't
+	acc.AvailableBalance = acc.Balance - acc.HeldAmount + acc.OverdraftLimit
+	if acc.Balance < 0 {
+		acc.OverdraftUsed = -acc.Balance
+	}
+	return acc, nil
+}
+
 func (r *accountRepository) Insert(ctx context.Context, input *account.Account) (*account.Account, error) {
 	query := `
-		INSERT INTO accounts (owner_id, balance, currency)
-		VALUES ($1, $2, $3) RETURNING id, created_at, updated_at
+		INSERT INTO accounts (owner_id, balance, currency, product)
+		VALUES ($1, $2, $3, $4) RETURNING id, type, status, created_at, updated_at
 	`
-	err := r.db.QueryRowContext(ctx, query, input.OwnerID, input.Balance, input.Currency).Scan(
+	err := r.db.QueryRowContext(ctx, query, input.OwnerID, input.Balance, input.Currency, input.Product).Scan(
 		&input.ID,
+		&input.Type,
+		&input.Status,
 		&input.CreatedAt,
 		&input.UpdatedAt,
 	)
@@ -52,18 +97,10 @@ func (r *accountRepository) Insert(ctx context.Context, input *account.Account)
 
 func (r *accountRepository) FindByID(ctx context.Context, accountID int64) (*account.Account, error) {
 	query := `
-		SELECT id, owner_id, balance, currency, created_at, updated_at
+		SELECT ` + accountColumns + `
 		FROM accounts WHERE id = $1 LIMIT 1
 	`
-	acc := new(account.Account)
-	err := r.db.QueryRowContext(ctx, query, accountID).Scan(
-		&acc.ID,
-		&acc.OwnerID,
-		&acc.Balance,
-		&acc.Currency,
-		&acc.CreatedAt,
-		&acc.UpdatedAt,
-	)
+	acc, err := scanAccount(r.db.QueryRowContext(ctx, query, accountID))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, errs.ErrAccountNotFound
@@ -73,12 +110,43 @@ func (r *accountRepository) FindByID(ctx context.Context, accountID int64) (*acc
 	return acc, nil
 }
 
-func (r *accountRepository) List(ctx context.Context, ownerID int64, limit int, offset int) ([]*account.Account, error) {
+func (r *accountRepository) FindSystemAccount(ctx context.Context, accType account.AccountType, currency account.AccountCurrency) (*account.Account, error) {
 	query := `
-		SELECT id, owner_id, balance, currency, created_at, updated_at
-		FROM accounts WHERE owner_id = $1 LIMIT $2 OFFSET $3
+		SELECT ` + accountColumns + `
+		FROM accounts WHERE type = $1 AND currency = $2 LIMIT 1
 	`
-	rows, err := r.db.QueryContext(ctx, query, ownerID, limit, offset)
+	acc, err := scanAccount(r.db.QueryRowContext(ctx, query, accType, currency))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrSystemAccountNotFound
+		}
+		return nil, err
+	}
+	return acc, nil
+}
+
+// List returns accounts oldest first. It fetches page.FetchLimit() rows so
+// the caller can tell whether another page exists.
+func (r *accountRepository) List(ctx context.Context, ownerID int64, page *pagination.Page) ([]*account.Account, error) {
+	var (
+		rows *sql.Rows
+		err  error
+	)
+	if page.After != nil {
+		query := `
+			SELECT ` + accountColumns + `
+			FROM accounts WHERE owner_id = $1 AND (created_at, id) > ($2, $3)
+			ORDER BY created_at, id LIMIT $4
+		`
+		rows, err = r.db.QueryContext(ctx, query, ownerID, page.After.CreatedAt, page.After.ID, page.FetchLimit())
+	} else {
+		query := `
+			SELECT ` + accountColumns + `
+			FROM accounts WHERE owner_id = $1
+			ORDER BY created_at, id LIMIT $2 OFFSET $3
+		`
+		rows, err = r.db.QueryContext(ctx, query, ownerID, page.FetchLimit(), page.Offset)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -87,15 +155,8 @@ func (r *accountRepository) List(ctx context.Context, ownerID int64, limit int,
 	accs := make([]*account.Account, 0)
 
 	for rows.Next() {
-		acc := new(account.Account)
-		if err = rows.Scan(
-			&acc.ID,
-			&acc.OwnerID,
-			&acc.Balance,
-			&acc.Currency,
-			&acc.CreatedAt,
-			&acc.UpdatedAt,
-		); err != nil {
+		acc, err := scanAccount(rows)
+		if err != nil {
 			return nil, err
 		}
 		accs = append(accs, acc)
@@ -107,21 +168,153 @@ func (r *accountRepository) List(ctx context.Context, ownerID int64, limit int,
 	return accs, nil
 }
 
+// AddAccountBalance credits (positive) or debits (negative) the account,
+// locking its row until tx ends. A debit that would spend held funds or take
+// a customer balance below -overdraft_limit updates nothing and fails with
+// ErrMoneyNotEnough, however stale the caller's own check was. System
+// accounts have no floor.
 func (r *accountRepository) AddAccountBalance(ctx context.Context, tx *sql.Tx, accountID int64, amount int64) (*account.Account, error) {
 	query := `
-		UPDATE accounts SET balance = balance + $1 WHERE id = $2
-		RETURNING id, owner_id, balance, currency, created_at
+		UPDATE accounts SET balance = balance + $1
+		WHERE id = $2 AND ($1 >= 0 OR type <> 'customer' OR balance - held_amount + $1 >= -overdraft_limit)
+		RETURNING ` + accountColumns + `
 	`
-	acc := new(account.Account)
-	err := tx.QueryRowContext(ctx, query, amount, accountID).Scan(
-		&acc.ID,
-		&acc.OwnerID,
-		&acc.Balance,
-		&acc.Currency,
-		&acc.CreatedAt,
-	)
+	acc, err := scanAccount(tx.QueryRowContext(ctx, query, amount, accountID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// The row is missing or the debit would pass the limit
+			if _, err := r.FindByIDForUpdate(ctx, tx, accountID); err != nil {
+				return nil, err
+			}
+			return nil, errs.ErrMoneyNotEnough
+		}
+		return nil, err
+	}
+	return acc, nil
+}
+
+// SetOverdraftLimit changes how far below zero a customer account may go.
+// Lowering it never touches the balance: an account already past the new
+// limit just can't be debited until it's back within it.
+func (r *accountRepository) SetOverdraftLimit(ctx context.Context, accountID, limit int64) (*account.Account, error) {
+	query := `
+		UPDATE accounts SET overdraft_limit = $1, updated_at = NOW()
+		WHERE id = $2 AND type = 'customer'
+		RETURNING ` + accountColumns + `
+	`
+	acc, err := scanAccount(r.db.QueryRowContext(ctx, query, limit, accountID))
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrAccountNotFound
+		}
 		return nil, err
 	}
 	return acc, nil
 }
+
+// AddHeldAmount reserves (positive) or releases (negative) funds for holds,
+// locking the account row until tx ends.
+func (r *accountRepository) AddHeldAmount(ctx context.Context, tx *sql.Tx, accountID int64, amount int64) (*account.Account, error) {
+	query := `
+		UPDATE accounts SET held_amount = held_amount + $1 WHERE id = $2
+		RETURNING ` + accountColumns + `
+	`
+	acc, err := scanAccount(tx.QueryRowContext(ctx, query, amount, accountID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrAccountNotFound
+		}
+		return nil, err
+	}
+	return acc, nil
+}
+
+// FindByIDForUpdate locks the account row until tx ends.
+func (r *accountRepository) FindByIDForUpdate(ctx context.Context, tx *sql.Tx, accountID int64) (*account.Account, error) {
+	query := `
+		SELECT ` + accountColumns + `
+		FROM accounts WHERE id = $1 FOR UPDATE
+	`
+	acc, err := scanAccount(tx.QueryRowContext(ctx, query, accountID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrAccountNotFound
+		}
+		return nil, err
+	}
+	return acc, nil
+}
+
+func (r *accountRepository) UpdateStatus(ctx context.Context, tx *sql.Tx, accountID int64, status account.Status) (*account.Account, error) {
+	query := `
+		UPDATE accounts SET status = $1, updated_at = NOW() WHERE id = $2
+		RETURNING ` + accountColumns + `
+	`
+	acc, err := scanAccount(tx.QueryRowContext(ctx, query, status, accountID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrAccountNotFound
+		}
+		if pqErr, ok := err.(*pq.Error); ok {
+			// Reopening while another open account holds the currency
+			if pqErr.Code.Name() == "unique_violation" && strings.Contains(pqErr.Constraint, "idx_accounts_owner_currency") {
+				return nil, errs.ErrCurrencyAlreadyExists
+			}
+		}
+		return nil, err
+	}
+	return acc, nil
+}
+
+func (r *accountRepository) InsertStatusChange(ctx context.Context, tx *sql.Tx, input *account.StatusChange) error {
+	query := `
+		INSERT INTO account_status_changes (account_id, from_status, to_status, reason, changed_by)
+		VALUES ($1, $2, $3, $4, $5) RETURNING id, created_at
+	`
+	return tx.QueryRowContext(
+		ctx,
+		query,
+		input.AccountID,
+		input.From,
+		input.To,
+		input.Reason,
+		input.ChangedBy,
+	).Scan(&input.ID, &input.CreatedAt)
+}
+
+// ListStatusChanges returns the status history of an account, newest first.
+func (r *accountRepository) ListStatusChanges(ctx context.Context, accountID int64) ([]*account.StatusChange, error) {
+	query := `
+		SELECT id, account_id, from_status, to_status, reason, changed_by, created_at
+		FROM account_status_changes WHERE account_id = $1
+		ORDER BY created_at DESC, id DESC
+	`
+	rows, err := r.db.QueryContext(ctx, query, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	changes := make([]*account.StatusChange, 0)
+
+	for rows.Next() {
+		c := new(account.StatusChange)
+		if err = rows.Scan(
+			&c.ID,
+			&c.AccountID,
+			&c.From,
+			&c.To,
+			&c.Reason,
+			&c.ChangedBy,
+			&c.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		changes = append(changes, c)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
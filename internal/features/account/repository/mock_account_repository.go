@@ -10,6 +10,7 @@ import (
 	reflect "reflect"
 
 	account "github.com/codepnw/simple-bank/internal/features/account"
+	pagination "github.com/codepnw/simple-bank/pkg/utils/pagination"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -51,6 +52,21 @@ func (mr *MockAccountRepositoryMockRecorder) AddAccountBalance(ctx, tx, accountI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccountBalance", reflect.TypeOf((*MockAccountRepository)(nil).AddAccountBalance), ctx, tx, accountID, amount)
 }
 
+// AddHeldAmount mocks base method.
+func (m *MockAccountRepository) AddHeldAmount(ctx context.Context, tx *sql.Tx, accountID, amount int64) (*account.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddHeldAmount", ctx, tx, accountID, amount)
+	ret0, _ := ret[0].(*account.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddHeldAmount indicates an expected call of AddHeldAmount.
+func (mr *MockAccountRepositoryMockRecorder) AddHeldAmount(ctx, tx, accountID, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddHeldAmount", reflect.TypeOf((*MockAccountRepository)(nil).AddHeldAmount), ctx, tx, accountID, amount)
+}
+
 // FindByID mocks base method.
 func (m *MockAccountRepository) FindByID(ctx context.Context, accountID int64) (*account.Account, error) {
 	m.ctrl.T.Helper()
@@ -66,6 +82,36 @@ func (mr *MockAccountRepositoryMockRecorder) FindByID(ctx, accountID interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockAccountRepository)(nil).FindByID), ctx, accountID)
 }
 
+// FindByIDForUpdate mocks base method.
+func (m *MockAccountRepository) FindByIDForUpdate(ctx context.Context, tx *sql.Tx, accountID int64) (*account.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDForUpdate", ctx, tx, accountID)
+	ret0, _ := ret[0].(*account.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDForUpdate indicates an expected call of FindByIDForUpdate.
+func (mr *MockAccountRepositoryMockRecorder) FindByIDForUpdate(ctx, tx, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDForUpdate", reflect.TypeOf((*MockAccountRepository)(nil).FindByIDForUpdate), ctx, tx, accountID)
+}
+
+// FindSystemAccount mocks base method.
+func (m *MockAccountRepository) FindSystemAccount(ctx context.Context, accType account.AccountType, currency account.AccountCurrency) (*account.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSystemAccount", ctx, accType, currency)
+	ret0, _ := ret[0].(*account.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSystemAccount indicates an expected call of FindSystemAccount.
+func (mr *MockAccountRepositoryMockRecorder) FindSystemAccount(ctx, accType, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSystemAccount", reflect.TypeOf((*MockAccountRepository)(nil).FindSystemAccount), ctx, accType, currency)
+}
+
 // Insert mocks base method.
 func (m *MockAccountRepository) Insert(ctx context.Context, input *account.Account) (*account.Account, error) {
 	m.ctrl.T.Helper()
@@ -81,17 +127,117 @@ func (mr *MockAccountRepositoryMockRecorder) Insert(ctx, input interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockAccountRepository)(nil).Insert), ctx, input)
 }
 
+// InsertStatusChange mocks base method.
+func (m *MockAccountRepository) InsertStatusChange(ctx context.Context, tx *sql.Tx, input *account.StatusChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertStatusChange", ctx, tx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertStatusChange indicates an expected call of InsertStatusChange.
+func (mr *MockAccountRepositoryMockRecorder) InsertStatusChange(ctx, tx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertStatusChange", reflect.TypeOf((*MockAccountRepository)(nil).InsertStatusChange), ctx, tx, input)
+}
+
 // List mocks base method.
-func (m *MockAccountRepository) List(ctx context.Context, ownerID int64, limit, offset int) ([]*account.Account, error) {
+func (m *MockAccountRepository) List(ctx context.Context, ownerID int64, page *pagination.Page) ([]*account.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, ownerID, limit, offset)
+	ret := m.ctrl.Call(m, "List", ctx, ownerID, page)
 	ret0, _ := ret[0].([]*account.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *MockAccountRepositoryMockRecorder) List(ctx, ownerID, limit, offset interface{}) *gomock.Call {
+func (mr *MockAccountRepositoryMockRecorder) List(ctx, ownerID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAccountRepository)(nil).List), ctx, ownerID, page)
+}
+
+// ListStatusChanges mocks base method.
+func (m *MockAccountRepository) ListStatusChanges(ctx context.Context, accountID int64) ([]*account.StatusChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStatusChanges", ctx, accountID)
+	ret0, _ := ret[0].([]*account.StatusChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStatusChanges indicates an expected call of ListStatusChanges.
+func (mr *MockAccountRepositoryMockRecorder) ListStatusChanges(ctx, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStatusChanges", reflect.TypeOf((*MockAccountRepository)(nil).ListStatusChanges), ctx, accountID)
+}
+
+// SetOverdraftLimit mocks base method.
+func (m *MockAccountRepository) SetOverdraftLimit(ctx context.Context, accountID, limit int64) (*account.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOverdraftLimit", ctx, accountID, limit)
+	ret0, _ := ret[0].(*account.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetOverdraftLimit indicates an expected call of SetOverdraftLimit.
+func (mr *MockAccountRepositoryMockRecorder) SetOverdraftLimit(ctx, accountID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOverdraftLimit", reflect.TypeOf((*MockAccountRepository)(nil).SetOverdraftLimit), ctx, accountID, limit)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockAccountRepository) UpdateStatus(ctx context.Context, tx *sql.Tx, accountID int64, status account.Status) (*account.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, tx, accountID, status)
+	ret0, _ := ret[0].(*account.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockAccountRepositoryMockRecorder) UpdateStatus(ctx, tx, accountID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockAccountRepository)(nil).UpdateStatus), ctx, tx, accountID, status)
+}
+
+// Mockscanner is a mock of scanner interface.
+type Mockscanner struct {
+	ctrl     *gomock.Controller
+	recorder *MockscannerMockRecorder
+}
+
+// MockscannerMockRecorder is the mock recorder for Mockscanner.
+type MockscannerMockRecorder struct {
+	mock *Mockscanner
+}
+
+// NewMockscanner creates a new mock instance.
+func NewMockscanner(ctrl *gomock.Controller) *Mockscanner {
+	mock := &Mockscanner{ctrl: ctrl}
+	mock.recorder = &MockscannerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockscanner) EXPECT() *MockscannerMockRecorder {
+	return m.recorder
+}
+
+// Scan mocks base method.
+func (m *Mockscanner) Scan(dest ...any) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range dest {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Scan", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Scan indicates an expected call of Scan.
+func (mr *MockscannerMockRecorder) Scan(dest ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAccountRepository)(nil).List), ctx, ownerID, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*Mockscanner)(nil).Scan), dest...)
 }
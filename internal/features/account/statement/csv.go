@@ -0,0 +1,41 @@
+package accountstatement
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+)
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCSVWriter(w io.Writer) *csvWriter {
+	return &csvWriter{w: csv.NewWriter(w)}
+}
+
+func (c *csvWriter) WriteHeader(h *Header) error {
+	if err := c.w.Write([]string{"type", "entry_id", "created_at", "amount", "balance"}); err != nil {
+		return err
+	}
+	return c.w.Write([]string{"opening", "", h.From.Format(time.RFC3339), "", strconv.FormatInt(h.OpeningBalance, 10)})
+}
+
+func (c *csvWriter) WriteLine(l *Line) error {
+	return c.w.Write([]string{
+		"entry",
+		strconv.FormatInt(l.EntryID, 10),
+		l.CreatedAt.Format(time.RFC3339Nano),
+		strconv.FormatInt(l.Amount, 10),
+		strconv.FormatInt(l.Balance, 10),
+	})
+}
+
+func (c *csvWriter) WriteSummary(s *Summary) error {
+	if err := c.w.Write([]string{"closing", "", "", "", strconv.FormatInt(s.ClosingBalance, 10)}); err != nil {
+		return err
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
@@ -0,0 +1,36 @@
+package accountstatement
+
+import (
+	"encoding/json"
+	"io"
+)
+
+type jsonlWriter struct {
+	enc *json.Encoder
+}
+
+func newJSONLWriter(w io.Writer) *jsonlWriter {
+	return &jsonlWriter{enc: json.NewEncoder(w)}
+}
+
+// Every line carries a "type" so consumers can tell the records apart.
+func (j *jsonlWriter) WriteHeader(h *Header) error {
+	return j.enc.Encode(struct {
+		Type string `json:"type"`
+		*Header
+	}{"opening", h})
+}
+
+func (j *jsonlWriter) WriteLine(l *Line) error {
+	return j.enc.Encode(struct {
+		Type string `json:"type"`
+		*Line
+	}{"entry", l})
+}
+
+func (j *jsonlWriter) WriteSummary(s *Summary) error {
+	return j.enc.Encode(struct {
+		Type string `json:"type"`
+		*Summary
+	}{"closing", s})
+}
@@ -0,0 +1,166 @@
+package accountstatement
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/codepnw/simple-bank/pkg/currency"
+)
+
+// pdfWriter emits a plain-text PDF (A4, Courier) one page at a time. Only the
+// current page and the object offsets are kept in memory; the page tree is
+// written last and referenced up front by a reserved object number.
+const (
+	pdfPageWidth    = 595
+	pdfPageHeight   = 842
+	pdfMarginLeft   = 40
+	pdfMarginTop    = 800
+	pdfFontSize     = 9
+	pdfLineHeight   = 12
+	pdfLinesPerPage = 62
+
+	pdfCatalogID = 1
+	pdfPagesID   = 2
+	pdfFontID    = 3
+)
+
+type pdfWriter struct {
+	w       *countingWriter
+	offsets map[int]int64
+	nextID  int
+	pageIDs []int
+	lines   []string
+	cur     *currency.Currency
+	err     error
+}
+
+func newPDFWriter(w io.Writer) *pdfWriter {
+	return &pdfWriter{
+		w:       &countingWriter{w: w},
+		offsets: make(map[int]int64),
+		nextID:  pdfFontID + 1,
+	}
+}
+
+func (p *pdfWriter) WriteHeader(h *Header) error {
+	p.cur = &currency.Currency{Code: h.Currency, Exponent: h.Exponent}
+
+	p.printf("%%PDF-1.4\n")
+	p.object(pdfCatalogID, fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pdfPagesID))
+	p.object(pdfFontID, "<< /Type /Font /Subtype /Type1 /BaseFont /Courier >>")
+
+	p.addLine("ACCOUNT STATEMENT")
+	p.addLine("")
+	p.addLine(fmt.Sprintf("Account   : %d (%s)", h.AccountID, h.Currency))
+	p.addLine(fmt.Sprintf("Period    : %s - %s", h.From.Format(time.RFC3339), h.To.Format(time.RFC3339)))
+	p.addLine(fmt.Sprintf("Generated : %s", h.GeneratedAt.Format(time.RFC3339)))
+	p.addLine("")
+	p.addLine(pdfColumns)
+	p.addLine(fmt.Sprintf("%-10s %-25s %18s %18s", "", "Opening balance", "", p.cur.FormatAmount(h.OpeningBalance)))
+	return p.err
+}
+
+const pdfColumns = "Entry      Date                                  Amount            Balance"
+
+func (p *pdfWriter) WriteLine(l *Line) error {
+	p.addLine(fmt.Sprintf("%-10d %-25s %18s %18s",
+		l.EntryID,
+		l.CreatedAt.Format("2006-01-02 15:04:05"),
+		p.cur.FormatAmount(l.Amount),
+		p.cur.FormatAmount(l.Balance),
+	))
+	return p.err
+}
+
+func (p *pdfWriter) WriteSummary(s *Summary) error {
+	p.addLine("")
+	p.addLine(fmt.Sprintf("Entries        : %d", s.Count))
+	p.addLine(fmt.Sprintf("Total credit   : %s", p.cur.FormatAmount(s.TotalCredit)))
+	p.addLine(fmt.Sprintf("Total debit    : %s", p.cur.FormatAmount(s.TotalDebit)))
+	p.addLine(fmt.Sprintf("Closing balance: %s", p.cur.FormatAmount(s.ClosingBalance)))
+	p.flushPage()
+
+	kids := make([]string, len(p.pageIDs))
+	for i, id := range p.pageIDs {
+		kids[i] = fmt.Sprintf("%d 0 R", id)
+	}
+	p.object(pdfPagesID, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(p.pageIDs)))
+
+	// Cross-reference table
+	size := p.nextID
+	xref := p.w.n
+	p.printf("xref\n0 %d\n0000000000 65535 f \n", size)
+	for id := 1; id < size; id++ {
+		p.printf("%010d 00000 n \n", p.offsets[id])
+	}
+	p.printf("trailer\n<< /Size %d /Root %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", size, pdfCatalogID, xref)
+	return p.err
+}
+
+func (p *pdfWriter) addLine(line string) {
+	if len(p.lines) == pdfLinesPerPage {
+		p.flushPage()
+		p.lines = append(p.lines, pdfColumns)
+	}
+	p.lines = append(p.lines, line)
+}
+
+func (p *pdfWriter) flushPage() {
+	if len(p.lines) == 0 {
+		return
+	}
+
+	var content bytes.Buffer
+	fmt.Fprintf(&content, "BT /F1 %d Tf %d TL %d %d Td\n", pdfFontSize, pdfLineHeight, pdfMarginLeft, pdfMarginTop)
+	for _, line := range p.lines {
+		fmt.Fprintf(&content, "(%s) Tj T*\n", pdfEscape(line))
+	}
+	content.WriteString("ET")
+
+	contentID := p.reserve()
+	p.object(contentID, fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()))
+
+	pageID := p.reserve()
+	p.object(pageID, fmt.Sprintf(
+		"<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 %d 0 R >> >> /Contents %d 0 R >>",
+		pdfPagesID, pdfPageWidth, pdfPageHeight, pdfFontID, contentID,
+	))
+	p.pageIDs = append(p.pageIDs, pageID)
+	p.lines = p.lines[:0]
+}
+
+func (p *pdfWriter) reserve() int {
+	id := p.nextID
+	p.nextID++
+	return id
+}
+
+func (p *pdfWriter) object(id int, body string) {
+	p.offsets[id] = p.w.n
+	p.printf("%d 0 obj\n%s\nendobj\n", id, body)
+}
+
+func (p *pdfWriter) printf(format string, args ...any) {
+	if p.err != nil {
+		return
+	}
+	_, p.err = fmt.Fprintf(p.w, format, args...)
+}
+
+func pdfEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `(`, `\(`, `)`, `\)`).Replace(s)
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(b []byte) (int, error) {
+	n, err := c.w.Write(b)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,84 @@
+package accountstatement
+
+import (
+	"io"
+	"strings"
+	"time"
+
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+)
+
+type Format string
+
+const (
+	FormatCSV   Format = "csv"
+	FormatJSONL Format = "jsonl"
+	FormatPDF   Format = "pdf"
+)
+
+// Header opens a statement: the period and the balance carried into it.
+type Header struct {
+	AccountID      int64     `json:"account_id"`
+	Currency       string    `json:"currency"`
+	Exponent       int       `json:"exponent"`
+	From           time.Time `json:"from"`
+	To             time.Time `json:"to"`
+	OpeningBalance int64     `json:"opening_balance"`
+	GeneratedAt    time.Time `json:"generated_at"`
+}
+
+// Line is one entry with the balance right after it was posted.
+type Line struct {
+	EntryID   int64     `json:"entry_id"`
+	CreatedAt time.Time `json:"created_at"`
+	Amount    int64     `json:"amount"`
+	Balance   int64     `json:"balance"`
+}
+
+type Summary struct {
+	ClosingBalance int64 `json:"closing_balance"`
+	TotalCredit    int64 `json:"total_credit"`
+	TotalDebit     int64 `json:"total_debit"`
+	Count          int   `json:"count"`
+}
+
+// Writer receives a statement as a stream: one header, any number of lines,
+// then one summary. Implementations must not hold lines in memory.
+type Writer interface {
+	WriteHeader(h *Header) error
+	WriteLine(l *Line) error
+	WriteSummary(s *Summary) error
+}
+
+func ParseFormat(format string) (Format, error) {
+	switch f := Format(strings.ToLower(format)); f {
+	case "":
+		return FormatCSV, nil
+	case FormatCSV, FormatJSONL, FormatPDF:
+		return f, nil
+	default:
+		return "", errs.ErrInvalidStatementFormat
+	}
+}
+
+func (f Format) ContentType() string {
+	switch f {
+	case FormatJSONL:
+		return "application/x-ndjson"
+	case FormatPDF:
+		return "application/pdf"
+	default:
+		return "text/csv"
+	}
+}
+
+func NewWriter(f Format, w io.Writer) Writer {
+	switch f {
+	case FormatJSONL:
+		return newJSONLWriter(w)
+	case FormatPDF:
+		return newPDFWriter(w)
+	default:
+		return newCSVWriter(w)
+	}
+}
@@ -0,0 +1,183 @@
+package accountstatement_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	accountstatement "github.com/codepnw/simple-bank/internal/features/account/statement"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCSVWriter(t *testing.T) {
+	out := writeStatement(t, accountstatement.FormatCSV, mockLines(2))
+
+	expected := "type,entry_id,created_at,amount,balance\n" +
+		"opening,,2025-01-01T00:00:00Z,,1000\n" +
+		"entry,1,2025-01-01T01:00:00Z,100,1100\n" +
+		"entry,2,2025-01-01T02:00:00Z,-50,1050\n" +
+		"closing,,,,1050\n"
+	assert.Equal(t, expected, out)
+}
+
+func TestJSONLWriter(t *testing.T) {
+	out := writeStatement(t, accountstatement.FormatJSONL, mockLines(2))
+
+	var records []map[string]any
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		var rec map[string]any
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, rec)
+	}
+	if !assert.Len(t, records, 4) {
+		return
+	}
+
+	assert.Equal(t, "opening", records[0]["type"])
+	assert.EqualValues(t, 10, records[0]["account_id"])
+	assert.EqualValues(t, 1000, records[0]["opening_balance"])
+
+	assert.Equal(t, "entry", records[1]["type"])
+	assert.EqualValues(t, 1, records[1]["entry_id"])
+	assert.EqualValues(t, 100, records[1]["amount"])
+	assert.EqualValues(t, 1100, records[1]["balance"])
+	assert.Equal(t, "entry", records[2]["type"])
+	assert.EqualValues(t, -50, records[2]["amount"])
+	assert.EqualValues(t, 1050, records[2]["balance"])
+
+	assert.Equal(t, "closing", records[3]["type"])
+	assert.EqualValues(t, 1050, records[3]["closing_balance"])
+	assert.EqualValues(t, 100, records[3]["total_credit"])
+	assert.EqualValues(t, 50, records[3]["total_debit"])
+	assert.EqualValues(t, 2, records[3]["count"])
+}
+
+func TestPDFWriter(t *testing.T) {
+	type testCase struct {
+		name  string
+		lines int
+		pages int
+	}
+
+	testCases := []testCase{
+		{name: "empty period", lines: 0, pages: 1},
+		{name: "one page", lines: 40, pages: 1},
+		{name: "multi page", lines: 150, pages: 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out := writeStatement(t, accountstatement.FormatPDF, mockLines(tc.lines))
+
+			assert.True(t, strings.HasPrefix(out, "%PDF-1.4\n"))
+			assert.True(t, strings.HasSuffix(out, "%%EOF\n"))
+
+			// startxref points at the xref table
+			m := regexp.MustCompile(`startxref\n(\d+)\n%%EOF\n$`).FindStringSubmatch(out)
+			if !assert.NotNil(t, m) {
+				return
+			}
+			xref, _ := strconv.Atoi(m[1])
+			if !assert.True(t, strings.HasPrefix(out[xref:], "xref\n")) {
+				return
+			}
+
+			// Every object offset lands on that object
+			table := strings.Split(out[xref:], "\n")
+			var first, size int
+			if _, err := fmt.Sscanf(table[1], "%d %d", &first, &size); err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, 0, first)
+			assert.Equal(t, "0000000000 65535 f ", table[2])
+			for id := 1; id < size; id++ {
+				entry := table[2+id]
+				if !assert.Regexp(t, `^\d{10} 00000 n $`, entry) {
+					return
+				}
+				offset, _ := strconv.Atoi(entry[:10])
+				assert.True(t, strings.HasPrefix(out[offset:], fmt.Sprintf("%d 0 obj\n", id)), "object %d at %d", id, offset)
+			}
+			assert.Equal(t, "trailer", table[2+size])
+			assert.Equal(t, fmt.Sprintf("<< /Size %d /Root 1 0 R >>", size), table[3+size])
+
+			// Stream lengths match their content
+			for _, s := range regexp.MustCompile(`<< /Length (\d+) >>\nstream\n`).FindAllStringSubmatchIndex(out, -1) {
+				length, _ := strconv.Atoi(out[s[2]:s[3]])
+				assert.True(t, strings.HasPrefix(out[s[1]+length:], "\nendstream"))
+			}
+
+			assert.Equal(t, tc.pages, strings.Count(out, "/Type /Page /Parent"))
+			assert.Contains(t, out, fmt.Sprintf("/Count %d >>", tc.pages))
+			assert.Contains(t, out, fmt.Sprintf("(Entries        : %d) Tj", tc.lines))
+		})
+	}
+}
+
+// writeStatement runs a statement for account 10 opening at 1000 through the
+// writer for format, keeping the running balance like the usecase does.
+func writeStatement(t *testing.T, format accountstatement.Format, lines []*accountstatement.Line) string {
+	t.Helper()
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	header := &accountstatement.Header{
+		AccountID:      10,
+		Currency:       "THB",
+		Exponent:       2,
+		From:           from,
+		To:             from.AddDate(0, 1, 0),
+		OpeningBalance: 1000,
+		GeneratedAt:    from.AddDate(0, 1, 0),
+	}
+	summary := &accountstatement.Summary{ClosingBalance: header.OpeningBalance}
+
+	var buf bytes.Buffer
+	w := accountstatement.NewWriter(format, &buf)
+	if err := w.WriteHeader(header); err != nil {
+		t.Fatal(err)
+	}
+	for _, l := range lines {
+		summary.ClosingBalance += l.Amount
+		summary.Count++
+		if l.Amount > 0 {
+			summary.TotalCredit += l.Amount
+		} else {
+			summary.TotalDebit -= l.Amount
+		}
+		l.Balance = summary.ClosingBalance
+		if err := w.WriteLine(l); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.WriteSummary(summary); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// mockLines alternates a credit of 100 and a debit of 50, an hour apart.
+func mockLines(n int) []*accountstatement.Line {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	lines := make([]*accountstatement.Line, n)
+	for i := range lines {
+		amount := int64(100)
+		if i%2 == 1 {
+			amount = -50
+		}
+		lines[i] = &accountstatement.Line{
+			EntryID:   int64(i + 1),
+			CreatedAt: from.Add(time.Duration(i+1) * time.Hour),
+			Amount:    amount,
+		}
+	}
+	return lines
+}
@@ -0,0 +1,66 @@
+package accountusecase
+
+import (
+	"time"
+
+	"github.com/codepnw/simple-bank/internal/features/account"
+	"github.com/codepnw/simple-bank/internal/features/entry"
+	"github.com/codepnw/simple-bank/internal/features/fee"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/codepnw/simple-bank/pkg/utils/pagination"
+)
+
+type MoneyParams struct {
+	AccountID int64  `json:"account_id"`
+	Amount    int64  `json:"amount"`
+	Currency  string `json:"currency"`
+}
+
+type MoneyResult struct {
+	Account  *account.Account `json:"account"`
+	Entry    *entry.Entry     `json:"entry"`
+	Fee      *fee.Breakdown   `json:"fee,omitempty"`       // withdrawals only
+	FeeEntry *entry.Entry     `json:"fee_entry,omitempty"` // the fee debit, if any
+}
+
+type ListEntriesParams struct {
+	AccountID int64
+	Direction string
+	From      *time.Time
+	To        *time.Time
+	MinAmount int64
+	MaxAmount int64
+	Page      *pagination.Page
+}
+
+func (p *ListEntriesParams) validate() error {
+	if p.From != nil && p.To != nil && p.From.After(*p.To) {
+		return errs.ErrInvalidTimeRange
+	}
+	if p.MinAmount > 0 && p.MaxAmount > 0 && p.MinAmount > p.MaxAmount {
+		return errs.ErrInvalidAmountRange
+	}
+	return nil
+}
+
+type StatementParams struct {
+	AccountID int64
+	From      *time.Time
+	To        *time.Time
+}
+
+// period defaults to the current month up to now.
+func (p *StatementParams) period(now time.Time) (time.Time, time.Time, error) {
+	from := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	to := now
+	if p.From != nil {
+		from = *p.From
+	}
+	if p.To != nil {
+		to = *p.To
+	}
+	if from.After(to) {
+		return time.Time{}, time.Time{}, errs.ErrInvalidTimeRange
+	}
+	return from, to, nil
+}
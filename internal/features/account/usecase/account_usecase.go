@@ -2,29 +2,68 @@ package accountusecase
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/codepnw/simple-bank/internal/consts"
 	"github.com/codepnw/simple-bank/internal/features/account"
 	accountrepository "github.com/codepnw/simple-bank/internal/features/account/repository"
+	accountstatement "github.com/codepnw/simple-bank/internal/features/account/statement"
+	"github.com/codepnw/simple-bank/internal/features/entry"
+	entryrepository "github.com/codepnw/simple-bank/internal/features/entry/repository"
+	"github.com/codepnw/simple-bank/internal/features/fee"
+	feerepository "github.com/codepnw/simple-bank/internal/features/fee/repository"
+	"github.com/codepnw/simple-bank/internal/features/ledger"
+	ledgerusecase "github.com/codepnw/simple-bank/internal/features/ledger/usecase"
+	"github.com/codepnw/simple-bank/internal/features/user"
 	"github.com/codepnw/simple-bank/pkg/auth"
+	"github.com/codepnw/simple-bank/pkg/currency"
+	"github.com/codepnw/simple-bank/pkg/database"
 	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/codepnw/simple-bank/pkg/utils/pagination"
 )
 
 type AccountUsecase interface {
-	CreateAccount(ctx context.Context, currency account.AccountCurrency) (*account.Account, error)
+	CreateAccount(ctx context.Context, currency account.AccountCurrency, product account.Product) (*account.Account, error)
 	GetAccount(ctx context.Context, id int64) (*account.Account, error)
-	ListAccounts(ctx context.Context, pageID, pageSize int) ([]*account.Account, error)
+	ListAccounts(ctx context.Context, page *pagination.Page) ([]*account.Account, *pagination.Meta, error)
+	Deposit(ctx context.Context, input *MoneyParams) (*MoneyResult, error)
+	Withdraw(ctx context.Context, input *MoneyParams) (*MoneyResult, error)
+	QuoteWithdraw(ctx context.Context, input *MoneyParams) (*fee.Breakdown, error)
+	ListEntries(ctx context.Context, input *ListEntriesParams) ([]*entry.Entry, *pagination.Meta, error)
+	PrepareStatement(ctx context.Context, input *StatementParams) (*accountstatement.Header, error)
+	WriteStatement(ctx context.Context, header *accountstatement.Header, w accountstatement.Writer) error
 }
 
 type accountUsecase struct {
-	repo accountrepository.AccountRepository
+	repo       accountrepository.AccountRepository
+	entRepo    entryrepository.EntryRepository
+	feeRepo    feerepository.FeeRepository
+	ledger     ledgerusecase.LedgerUsecase
+	tx         database.TxManager
+	currencies *currency.Registry
 }
 
-func NewAccountUsecase(repo accountrepository.AccountRepository) AccountUsecase {
-	return &accountUsecase{repo: repo}
+func NewAccountUsecase(
+	repo accountrepository.AccountRepository,
+	entRepo entryrepository.EntryRepository,
+	feeRepo feerepository.FeeRepository,
+	ledger ledgerusecase.LedgerUsecase,
+	tx database.TxManager,
+	currencies *currency.Registry,
+) AccountUsecase {
+	return &accountUsecase{
+		repo:       repo,
+		entRepo:    entRepo,
+		feeRepo:    feeRepo,
+		ledger:     ledger,
+		tx:         tx,
+		currencies: currencies,
+	}
 }
 
-func (u *accountUsecase) CreateAccount(ctx context.Context, currency account.AccountCurrency) (*account.Account, error) {
+// CreateAccount opens a current account unless product says otherwise.
+func (u *accountUsecase) CreateAccount(ctx context.Context, currency account.AccountCurrency, product account.Product) (*account.Account, error) {
 	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
 	defer cancel()
 
@@ -33,7 +72,14 @@ func (u *accountUsecase) CreateAccount(ctx context.Context, currency account.Acc
 		return nil, errs.ErrNoUserID
 	}
 
-	curr, err := u.validateCurrency(currency)
+	if product == "" {
+		product = account.ProductCurrent
+	}
+	if !product.Valid() {
+		return nil, errs.ErrInvalidProduct
+	}
+
+	curr, err := u.currencies.Lookup(string(currency))
 	if err != nil {
 		return nil, err
 	}
@@ -41,22 +87,20 @@ func (u *accountUsecase) CreateAccount(ctx context.Context, currency account.Acc
 	accountData, err := u.repo.Insert(ctx, &account.Account{
 		OwnerID:  userID,
 		Balance:  0,
-		Currency: curr,
+		Currency: account.AccountCurrency(curr.Code),
+		Product:  product,
 	})
 	if err != nil {
 		return nil, err
 	}
+	u.display(accountData)
 	return accountData, nil
 }
 
-func (u *accountUsecase) validateCurrency(currency account.AccountCurrency) (account.AccountCurrency, error) {
-	switch currency {
-	case "THB", "thb":
-		return account.CurrencyTHB, nil
-	case "USD", "usd":
-		return account.CurrencyUSD, nil
-	default:
-		return "", errs.ErrInvalidCurrency
+// display fills in the formatted balance of each account.
+func (u *accountUsecase) display(accounts ...*account.Account) {
+	for _, a := range accounts {
+		a.BalanceDisplay = u.currencies.Format(a.Balance, string(a.Currency))
 	}
 }
 
@@ -77,10 +121,230 @@ func (u *accountUsecase) GetAccount(ctx context.Context, id int64) (*account.Acc
 	if accountData.OwnerID != userID {
 		return nil, errs.ErrAccountNotFound
 	}
+	u.display(accountData)
 	return accountData, nil
 }
 
-func (u *accountUsecase) ListAccounts(ctx context.Context, pageID, pageSize int) ([]*account.Account, error) {
+func (u *accountUsecase) ListAccounts(ctx context.Context, page *pagination.Page) ([]*account.Account, *pagination.Meta, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	userID := auth.GetUserID(ctx)
+	if userID == 0 {
+		return nil, nil, errs.ErrNoUserID
+	}
+
+	accounts, err := u.repo.List(ctx, userID, page)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	accounts, meta := pagination.Trim(accounts, page, func(a *account.Account) pagination.Cursor {
+		return pagination.Cursor{CreatedAt: a.CreatedAt, ID: a.ID}
+	})
+	u.display(accounts...)
+	return accounts, meta, nil
+}
+
+func (u *accountUsecase) Deposit(ctx context.Context, input *MoneyParams) (*MoneyResult, error) {
+	return u.moveCash(ctx, input, input.Amount)
+}
+
+func (u *accountUsecase) Withdraw(ctx context.Context, input *MoneyParams) (*MoneyResult, error) {
+	return u.moveCash(ctx, input, -input.Amount)
+}
+
+func (u *accountUsecase) QuoteWithdraw(ctx context.Context, input *MoneyParams) (*fee.Breakdown, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	userID := auth.GetUserID(ctx)
+	if userID == 0 {
+		return nil, errs.ErrNoUserID
+	}
+
+	acc, err := u.cashAccount(ctx, userID, input, false)
+	if err != nil {
+		return nil, err
+	}
+	return u.withdrawalFee(ctx, acc, input.Amount)
+}
+
+// moveCash posts amount (+ deposit, - withdraw) to the customer account and
+// the opposite amount to the system cash account of the same currency. A
+// withdrawal fee is a second debit, credited to the fees account.
+//
+// A deposit creates money out of system cash, so only an admin may post one,
+// into any customer's account; the journal records who did.
+func (u *accountUsecase) moveCash(ctx context.Context, input *MoneyParams, amount int64) (*MoneyResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	userID := auth.GetUserID(ctx)
+	if userID == 0 {
+		return nil, errs.ErrNoUserID
+	}
+	deposit := amount > 0
+	if deposit && !auth.HasRole(ctx, user.RoleAdmin) {
+		return nil, errs.ErrNoPermission
+	}
+
+	acc, err := u.cashAccount(ctx, userID, input, deposit)
+	if err != nil {
+		return nil, err
+	}
+
+	var fees *fee.Breakdown
+	if amount < 0 {
+		fees, err = u.withdrawalFee(ctx, acc, input.Amount)
+		if err != nil {
+			return nil, err
+		}
+		// Check Balance
+		if acc.AvailableBalance < input.Amount+fees.Total {
+			return nil, errs.ErrMoneyNotEnough
+		}
+	}
+
+	cashAcc, err := u.repo.FindSystemAccount(ctx, account.TypeCash, acc.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &ledgerusecase.PostParams{Kind: ledger.KindWithdrawal}
+	if deposit {
+		params = &ledgerusecase.PostParams{Kind: ledger.KindDeposit, CreatedBy: &userID}
+	}
+	curr := string(acc.Currency)
+	lines := []ledger.Line{
+		{AccountID: acc.ID, Currency: curr, Amount: amount},
+		{AccountID: cashAcc.ID, Currency: curr, Amount: -amount}, // opposite
+	}
+	if fees != nil && fees.Total > 0 {
+		feeAcc, err := u.repo.FindSystemAccount(ctx, account.TypeFees, acc.Currency)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines,
+			ledger.Line{AccountID: acc.ID, Currency: curr, Amount: -fees.Total},
+			ledger.Line{AccountID: feeAcc.ID, Currency: curr, Amount: fees.Total},
+		)
+	}
+
+	params.Lines = lines
+
+	result := &MoneyResult{Fee: fees}
+	err = u.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
+		posted, err := u.ledger.Post(ctx, tx, params)
+		if err != nil {
+			return err
+		}
+		result.Entry = posted.Journal.Entries[0]
+		if len(lines) > 2 {
+			result.FeeEntry = posted.Journal.Entries[2]
+		}
+		result.Account = posted.Accounts[acc.ID]
+
+		// Re-check under the row lock: a freeze or a hold may have landed
+		// since the account was read
+		if err = result.Account.CheckActive(); err != nil {
+			return err
+		}
+		if amount < 0 && result.Account.AvailableBalance < 0 {
+			return errs.ErrMoneyNotEnough
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	u.display(result.Account)
+	return result, nil
+}
+
+// cashAccount returns the account for a deposit or withdrawal once the amount,
+// owner, status and currency check out. anyOwner skips the owner check for
+// staff posting to a customer's account.
+func (u *accountUsecase) cashAccount(ctx context.Context, userID int64, input *MoneyParams, anyOwner bool) (*account.Account, error) {
+	if input.Amount <= 0 {
+		return nil, errs.ErrInvalidAmount
+	}
+
+	acc, err := u.repo.FindByID(ctx, input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	// Check Owner
+	if acc.Type != account.TypeCustomer || (!anyOwner && acc.OwnerID != userID) {
+		return nil, errs.ErrAccountNotFound
+	}
+	// Check Status
+	if err = acc.CheckActive(); err != nil {
+		return nil, err
+	}
+	// Check Currency
+	curr, err := u.currencies.Lookup(input.Currency)
+	if err != nil {
+		return nil, err
+	}
+	if acc.Currency != account.AccountCurrency(curr.Code) {
+		return nil, errs.ErrCurrencyMismatch
+	}
+	return acc, nil
+}
+
+func (u *accountUsecase) withdrawalFee(ctx context.Context, acc *account.Account, amount int64) (*fee.Breakdown, error) {
+	rule, err := u.feeRepo.FindRule(ctx, fee.OpWithdrawal, string(acc.Currency), amount)
+	if err != nil {
+		return nil, err
+	}
+	return rule.Apply(amount), nil
+}
+
+func (u *accountUsecase) ListEntries(ctx context.Context, input *ListEntriesParams) ([]*entry.Entry, *pagination.Meta, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	userID := auth.GetUserID(ctx)
+	if userID == 0 {
+		return nil, nil, errs.ErrNoUserID
+	}
+
+	if err := input.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	acc, err := u.repo.FindByID(ctx, input.AccountID)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Check Owner
+	if acc.OwnerID != userID {
+		return nil, nil, errs.ErrAccountNotFound
+	}
+
+	entries, err := u.entRepo.List(ctx, &entry.Filter{
+		AccountID: acc.ID,
+		Direction: input.Direction,
+		From:      input.From,
+		To:        input.To,
+		MinAmount: input.MinAmount,
+		MaxAmount: input.MaxAmount,
+		Page:      input.Page,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entries, meta := pagination.Trim(entries, input.Page, func(e *entry.Entry) pagination.Cursor {
+		return pagination.Cursor{CreatedAt: e.CreatedAt, ID: e.ID}
+	})
+	return entries, meta, nil
+}
+
+// PrepareStatement checks ownership and resolves the period and opening
+// balance, so errors can still be reported before any output is written.
+func (u *accountUsecase) PrepareStatement(ctx context.Context, input *StatementParams) (*accountstatement.Header, error) {
 	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
 	defer cancel()
 
@@ -89,17 +353,73 @@ func (u *accountUsecase) ListAccounts(ctx context.Context, pageID, pageSize int)
 		return nil, errs.ErrNoUserID
 	}
 
-	if pageID < 1 {
-		pageID = 1
+	now := time.Now()
+	from, to, err := input.period(now)
+	if err != nil {
+		return nil, err
 	}
-	if pageSize < 5 {
-		pageSize = 5
+
+	acc, err := u.repo.FindByID(ctx, input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	// Check Owner
+	if acc.OwnerID != userID {
+		return nil, errs.ErrAccountNotFound
 	}
-	offset := (pageID - 1) * pageSize
 
-	accounts, err := u.repo.List(ctx, userID, pageSize, offset)
+	opening, err := u.entRepo.BalanceAt(ctx, acc.ID, from)
 	if err != nil {
 		return nil, err
 	}
-	return accounts, nil
+
+	// Disabled currencies still format existing balances
+	var exponent int
+	if curr, ok := u.currencies.Get(string(acc.Currency)); ok {
+		exponent = curr.Exponent
+	}
+
+	return &accountstatement.Header{
+		AccountID:      acc.ID,
+		Currency:       string(acc.Currency),
+		Exponent:       exponent,
+		From:           from,
+		To:             to,
+		OpeningBalance: opening,
+		GeneratedAt:    now,
+	}, nil
+}
+
+// WriteStatement streams the entries of a prepared statement into w with a
+// running balance, followed by the period totals.
+func (u *accountUsecase) WriteStatement(ctx context.Context, header *accountstatement.Header, w accountstatement.Writer) error {
+	ctx, cancel := context.WithTimeout(ctx, consts.StatementTimeout)
+	defer cancel()
+
+	if err := w.WriteHeader(header); err != nil {
+		return err
+	}
+
+	summary := &accountstatement.Summary{ClosingBalance: header.OpeningBalance}
+
+	err := u.entRepo.Stream(ctx, header.AccountID, header.From, header.To, func(e *entry.Entry) error {
+		summary.ClosingBalance += e.Amount
+		summary.Count++
+		if e.Amount > 0 {
+			summary.TotalCredit += e.Amount
+		} else {
+			summary.TotalDebit -= e.Amount
+		}
+
+		return w.WriteLine(&accountstatement.Line{
+			EntryID:   e.ID,
+			CreatedAt: e.CreatedAt,
+			Amount:    e.Amount,
+			Balance:   summary.ClosingBalance,
+		})
+	})
+	if err != nil {
+		return err
+	}
+	return w.WriteSummary(summary)
 }
@@ -1,14 +1,27 @@
 package accountusecase_test
 
 import (
+	"bytes"
 	"context"
 	"testing"
+	"time"
 
+	"github.com/codepnw/simple-bank/internal/consts"
 	"github.com/codepnw/simple-bank/internal/features/account"
 	accountrepository "github.com/codepnw/simple-bank/internal/features/account/repository"
+	accountstatement "github.com/codepnw/simple-bank/internal/features/account/statement"
 	accountusecase "github.com/codepnw/simple-bank/internal/features/account/usecase"
+	"github.com/codepnw/simple-bank/internal/features/entry"
+	entryrepository "github.com/codepnw/simple-bank/internal/features/entry/repository"
+	"github.com/codepnw/simple-bank/internal/features/fee"
+	feerepository "github.com/codepnw/simple-bank/internal/features/fee/repository"
+	"github.com/codepnw/simple-bank/internal/features/ledger"
+	ledgerusecase "github.com/codepnw/simple-bank/internal/features/ledger/usecase"
+	"github.com/codepnw/simple-bank/internal/features/user"
 	"github.com/codepnw/simple-bank/internal/mocks"
 	"github.com/codepnw/simple-bank/pkg/auth"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/codepnw/simple-bank/pkg/utils/pagination"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
@@ -18,6 +31,7 @@ func TestCreateAccount(t *testing.T) {
 		name        string
 		userID      int64
 		currency    account.AccountCurrency
+		product     account.Product
 		mockFn      func(mockRepo *accountrepository.MockAccountRepository, currency account.AccountCurrency)
 		expectedErr error
 	}
@@ -33,6 +47,54 @@ func TestCreateAccount(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		{
+			name:     "success lowercase code",
+			userID:   10,
+			currency: account.AccountCurrency("usd"),
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, currency account.AccountCurrency) {
+				a := mocks.MockAccountData()
+				a.Currency = "USD"
+				mockRepo.EXPECT().Insert(gomock.Any(), &account.Account{OwnerID: 10, Currency: "USD", Product: account.ProductCurrent}).Return(a, nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:     "success savings",
+			userID:   10,
+			currency: account.AccountCurrency("THB"),
+			product:  account.ProductSavings,
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, currency account.AccountCurrency) {
+				a := mocks.MockAccountData()
+				a.Product = account.ProductSavings
+				mockRepo.EXPECT().Insert(gomock.Any(), &account.Account{OwnerID: 10, Currency: "THB", Product: account.ProductSavings}).Return(a, nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:     "fail invalid product",
+			userID:   10,
+			currency: account.AccountCurrency("THB"),
+			product:  account.Product("fixed"),
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, currency account.AccountCurrency) {
+			},
+			expectedErr: errs.ErrInvalidProduct,
+		},
+		{
+			name:     "fail unknown currency",
+			userID:   10,
+			currency: account.AccountCurrency("XYZ"),
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, currency account.AccountCurrency) {
+			},
+			expectedErr: errs.ErrInvalidCurrency,
+		},
+		{
+			name:     "fail disabled currency",
+			userID:   10,
+			currency: account.AccountCurrency("EUR"),
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, currency account.AccountCurrency) {
+			},
+			expectedErr: errs.ErrInvalidCurrency,
+		},
 		{
 			name:     "fail db error",
 			userID:   10,
@@ -46,7 +108,7 @@ func TestCreateAccount(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			uc, mockRepo := setup(t)
+			uc, mockRepo, _, _ := setup(t)
 
 			tc.mockFn(mockRepo, tc.currency)
 
@@ -55,13 +117,14 @@ func TestCreateAccount(t *testing.T) {
 				ctx = auth.SetUserID(ctx, tc.userID)
 			}
 
-			result, err := uc.CreateAccount(ctx, tc.currency)
+			result, err := uc.CreateAccount(ctx, tc.currency, tc.product)
 
 			if tc.expectedErr != nil {
-				assert.Error(t, err)
+				assert.ErrorIs(t, err, tc.expectedErr)
 			} else {
 				assert.NoError(t, err)
 				assert.NotNil(t, result)
+				assert.NotEmpty(t, result.BalanceDisplay)
 			}
 		})
 	}
@@ -100,7 +163,7 @@ func TestGetAccount(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			uc, mockRepo := setup(t)
+			uc, mockRepo, _, _ := setup(t)
 
 			tc.mockFn(mockRepo, tc.accountID)
 
@@ -125,28 +188,50 @@ func TestListAccounts(t *testing.T) {
 	type testCase struct {
 		name        string
 		userID      int64
-		mockFn      func(mockRepo *accountrepository.MockAccountRepository, userID int64)
+		page        *pagination.Page
+		mockFn      func(mockRepo *accountrepository.MockAccountRepository, userID int64, page *pagination.Page)
+		hasMore     bool
 		expectedErr error
 	}
 
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
 	testCases := []testCase{
 		{
-			name:   "success",
+			name:   "success last page",
 			userID: 10,
-			mockFn: func(mockRepo *accountrepository.MockAccountRepository, userID int64) {
+			page:   &pagination.Page{Limit: 5},
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, userID int64, page *pagination.Page) {
 				accs := []*account.Account{
 					{ID: 10, OwnerID: 10},
 					{ID: 11, OwnerID: 10},
 				}
-				mockRepo.EXPECT().List(gomock.Any(), userID, gomock.Any(), gomock.Any()).Return(accs, nil).Times(1)
+				mockRepo.EXPECT().List(gomock.Any(), userID, page).Return(accs, nil).Times(1)
+			},
+			hasMore:     false,
+			expectedErr: nil,
+		},
+		{
+			name:   "success has more",
+			userID: 10,
+			page:   &pagination.Page{Limit: 2},
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, userID int64, page *pagination.Page) {
+				accs := []*account.Account{
+					{ID: 10, OwnerID: 10, CreatedAt: createdAt},
+					{ID: 11, OwnerID: 10, CreatedAt: createdAt},
+					{ID: 12, OwnerID: 10, CreatedAt: createdAt},
+				}
+				mockRepo.EXPECT().List(gomock.Any(), userID, page).Return(accs, nil).Times(1)
 			},
+			hasMore:     true,
 			expectedErr: nil,
 		},
 		{
 			name:   "fail db error",
 			userID: 10,
-			mockFn: func(mockRepo *accountrepository.MockAccountRepository, userID int64) {
-				mockRepo.EXPECT().List(gomock.Any(), userID, gomock.Any(), gomock.Any()).Return(nil, mocks.ErrDatabase).Times(1)
+			page:   &pagination.Page{Limit: 5},
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, userID int64, page *pagination.Page) {
+				mockRepo.EXPECT().List(gomock.Any(), userID, page).Return(nil, mocks.ErrDatabase).Times(1)
 			},
 			expectedErr: mocks.ErrDatabase,
 		},
@@ -154,19 +239,464 @@ func TestListAccounts(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			uc, mockRepo := setup(t)
+			uc, mockRepo, _, _ := setup(t)
 
-			tc.mockFn(mockRepo, tc.userID)
+			tc.mockFn(mockRepo, tc.userID, tc.page)
 
 			ctx := context.Background()
 			if tc.userID != 0 {
 				ctx = auth.SetUserID(ctx, tc.userID)
 			}
 
-			result, err := uc.ListAccounts(ctx, 1, 5)
+			result, meta, err := uc.ListAccounts(ctx, tc.page)
 
 			if tc.expectedErr != nil {
 				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotNil(t, result)
+			assert.Equal(t, tc.hasMore, meta.HasMore)
+			assert.Equal(t, tc.page.Limit, meta.PageSize)
+
+			if tc.hasMore {
+				assert.Len(t, result, tc.page.Limit)
+
+				cursor, err := pagination.DecodeCursor(meta.NextCursor)
+				assert.NoError(t, err)
+				assert.Equal(t, result[len(result)-1].ID, cursor.ID)
+				assert.True(t, createdAt.Equal(cursor.CreatedAt))
+			} else {
+				assert.Empty(t, meta.NextCursor)
+			}
+		})
+	}
+}
+
+func TestDeposit(t *testing.T) {
+	type testCase struct {
+		name        string
+		userID      int64
+		role        user.Role
+		input       *accountusecase.MoneyParams
+		mockFn      func(mockRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *accountusecase.MoneyParams)
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:   "success into a customer's account",
+			userID: 1,
+			role:   user.RoleAdmin,
+			input:  &accountusecase.MoneyParams{AccountID: 10, Amount: 100, Currency: "THB"},
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *accountusecase.MoneyParams) {
+				acc := mocks.MockAccountData()
+				mockRepo.EXPECT().FindByID(gomock.Any(), input.AccountID).Return(acc, nil).Times(1)
+
+				cash := mocks.MockCashAccountData()
+				mockRepo.EXPECT().FindSystemAccount(gomock.Any(), account.TypeCash, acc.Currency).Return(cash, nil).Times(1)
+
+				admin := int64(1)
+				post := &ledgerusecase.PostParams{
+					Kind:      ledger.KindDeposit,
+					CreatedBy: &admin,
+					Lines: []ledger.Line{
+						{AccountID: acc.ID, Currency: "THB", Amount: input.Amount},
+						{AccountID: cash.ID, Currency: "THB", Amount: -input.Amount},
+					},
+				}
+				posted := mocks.MockPostResult(post, map[int64]*account.Account{acc.ID: acc, cash.ID: cash})
+				ledgerUC.EXPECT().Post(gomock.Any(), gomock.Any(), post).Return(posted, nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:   "fail customer cannot deposit",
+			userID: 10,
+			role:   user.RoleCustomer,
+			input:  &accountusecase.MoneyParams{AccountID: 10, Amount: 100, Currency: "THB"},
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *accountusecase.MoneyParams) {
+			},
+			expectedErr: errs.ErrNoPermission,
+		},
+		{
+			name:   "fail invalid amount",
+			userID: 1,
+			role:   user.RoleAdmin,
+			input:  &accountusecase.MoneyParams{AccountID: 10, Amount: 0, Currency: "THB"},
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *accountusecase.MoneyParams) {
+			},
+			expectedErr: errs.ErrInvalidAmount,
+		},
+		{
+			name:   "fail account frozen",
+			userID: 1,
+			role:   user.RoleAdmin,
+			input:  &accountusecase.MoneyParams{AccountID: 10, Amount: 100, Currency: "THB"},
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *accountusecase.MoneyParams) {
+				acc := mocks.MockAccountData()
+				acc.Status = account.StatusFrozen
+				mockRepo.EXPECT().FindByID(gomock.Any(), input.AccountID).Return(acc, nil).Times(1)
+			},
+			expectedErr: errs.ErrAccountFrozen,
+		},
+		{
+			name:   "fail system account",
+			userID: 1,
+			role:   user.RoleAdmin,
+			input:  &accountusecase.MoneyParams{AccountID: 1, Amount: 100, Currency: "THB"},
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *accountusecase.MoneyParams) {
+				mockRepo.EXPECT().FindByID(gomock.Any(), input.AccountID).Return(mocks.MockCashAccountData(), nil).Times(1)
+			},
+			expectedErr: errs.ErrAccountNotFound,
+		},
+		{
+			name:   "fail currency mismatch",
+			userID: 1,
+			role:   user.RoleAdmin,
+			input:  &accountusecase.MoneyParams{AccountID: 10, Amount: 100, Currency: "USD"},
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *accountusecase.MoneyParams) {
+				acc := mocks.MockAccountData()
+				mockRepo.EXPECT().FindByID(gomock.Any(), input.AccountID).Return(acc, nil).Times(1)
+			},
+			expectedErr: errs.ErrCurrencyMismatch,
+		},
+		{
+			name:   "fail post journal",
+			userID: 1,
+			role:   user.RoleAdmin,
+			input:  &accountusecase.MoneyParams{AccountID: 10, Amount: 100, Currency: "THB"},
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *accountusecase.MoneyParams) {
+				acc := mocks.MockAccountData()
+				mockRepo.EXPECT().FindByID(gomock.Any(), input.AccountID).Return(acc, nil).Times(1)
+
+				cash := mocks.MockCashAccountData()
+				mockRepo.EXPECT().FindSystemAccount(gomock.Any(), account.TypeCash, acc.Currency).Return(cash, nil).Times(1)
+
+				ledgerUC.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, mocks.ErrDatabase).Times(1)
+			},
+			expectedErr: mocks.ErrDatabase,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, mockRepo, _, ledgerUC := setup(t)
+
+			tc.mockFn(mockRepo, ledgerUC, tc.input)
+
+			ctx := auth.SetUserID(context.Background(), tc.userID)
+			ctx = auth.SetRole(ctx, tc.role)
+
+			result, err := uc.Deposit(ctx, tc.input)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, result)
+			}
+		})
+	}
+}
+
+func TestWithdraw(t *testing.T) {
+	type testCase struct {
+		name        string
+		userID      int64
+		rule        *fee.Rule
+		input       *accountusecase.MoneyParams
+		mockFn      func(mockRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *accountusecase.MoneyParams)
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:   "success",
+			userID: 10,
+			input:  &accountusecase.MoneyParams{AccountID: 10, Amount: 100, Currency: "THB"},
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *accountusecase.MoneyParams) {
+				acc := mocks.MockAccountData()
+				mockRepo.EXPECT().FindByID(gomock.Any(), input.AccountID).Return(acc, nil).Times(1)
+
+				cash := mocks.MockCashAccountData()
+				mockRepo.EXPECT().FindSystemAccount(gomock.Any(), account.TypeCash, acc.Currency).Return(cash, nil).Times(1)
+
+				post := &ledgerusecase.PostParams{
+					Kind: ledger.KindWithdrawal,
+					Lines: []ledger.Line{
+						{AccountID: acc.ID, Currency: "THB", Amount: -input.Amount},
+						{AccountID: cash.ID, Currency: "THB", Amount: input.Amount},
+					},
+				}
+				posted := mocks.MockPostResult(post, map[int64]*account.Account{acc.ID: acc, cash.ID: cash})
+				ledgerUC.EXPECT().Post(gomock.Any(), gomock.Any(), post).Return(posted, nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:   "success with fee",
+			userID: 10,
+			rule:   &fee.Rule{Currency: "THB", Flat: 20},
+			input:  &accountusecase.MoneyParams{AccountID: 10, Amount: 100, Currency: "THB"},
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *accountusecase.MoneyParams) {
+				acc := mocks.MockAccountData()
+				mockRepo.EXPECT().FindByID(gomock.Any(), input.AccountID).Return(acc, nil).Times(1)
+
+				cash := mocks.MockCashAccountData()
+				mockRepo.EXPECT().FindSystemAccount(gomock.Any(), account.TypeCash, acc.Currency).Return(cash, nil).Times(1)
+
+				feeAcc := mocks.MockCashAccountData()
+				feeAcc.ID, feeAcc.Type = 3, account.TypeFees
+				mockRepo.EXPECT().FindSystemAccount(gomock.Any(), account.TypeFees, acc.Currency).Return(feeAcc, nil).Times(1)
+
+				post := &ledgerusecase.PostParams{
+					Kind: ledger.KindWithdrawal,
+					Lines: []ledger.Line{
+						{AccountID: acc.ID, Currency: "THB", Amount: -input.Amount},
+						{AccountID: cash.ID, Currency: "THB", Amount: input.Amount},
+						{AccountID: acc.ID, Currency: "THB", Amount: -20},
+						{AccountID: feeAcc.ID, Currency: "THB", Amount: 20},
+					},
+				}
+				posted := mocks.MockPostResult(post, map[int64]*account.Account{acc.ID: acc, cash.ID: cash, feeAcc.ID: feeAcc})
+				ledgerUC.EXPECT().Post(gomock.Any(), gomock.Any(), post).Return(posted, nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			// A hold landed between the check and the row lock
+			name:   "fail hold reserved funds under lock",
+			userID: 10,
+			input:  &accountusecase.MoneyParams{AccountID: 10, Amount: 100, Currency: "THB"},
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *accountusecase.MoneyParams) {
+				acc := mocks.MockAccountData()
+				mockRepo.EXPECT().FindByID(gomock.Any(), input.AccountID).Return(acc, nil).Times(1)
+
+				cash := mocks.MockCashAccountData()
+				mockRepo.EXPECT().FindSystemAccount(gomock.Any(), account.TypeCash, acc.Currency).Return(cash, nil).Times(1)
+
+				locked := mocks.MockAccountData()
+				locked.Balance, locked.HeldAmount, locked.AvailableBalance = 900, 950, -50
+				post := &ledgerusecase.PostParams{
+					Kind: ledger.KindWithdrawal,
+					Lines: []ledger.Line{
+						{AccountID: acc.ID, Currency: "THB", Amount: -input.Amount},
+						{AccountID: cash.ID, Currency: "THB", Amount: input.Amount},
+					},
+				}
+				posted := mocks.MockPostResult(post, map[int64]*account.Account{locked.ID: locked, cash.ID: cash})
+				ledgerUC.EXPECT().Post(gomock.Any(), gomock.Any(), post).Return(posted, nil).Times(1)
+			},
+			expectedErr: errs.ErrMoneyNotEnough,
+		},
+		{
+			name:   "fail account closed",
+			userID: 10,
+			input:  &accountusecase.MoneyParams{AccountID: 10, Amount: 100, Currency: "THB"},
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *accountusecase.MoneyParams) {
+				acc := mocks.MockAccountData()
+				acc.Status = account.StatusClosed
+				mockRepo.EXPECT().FindByID(gomock.Any(), input.AccountID).Return(acc, nil).Times(1)
+			},
+			expectedErr: errs.ErrAccountClosed,
+		},
+		{
+			name:   "fail money not enough",
+			userID: 10,
+			input:  &accountusecase.MoneyParams{AccountID: 10, Amount: 5000, Currency: "THB"},
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *accountusecase.MoneyParams) {
+				acc := mocks.MockAccountData()
+				mockRepo.EXPECT().FindByID(gomock.Any(), input.AccountID).Return(acc, nil).Times(1)
+			},
+			expectedErr: errs.ErrMoneyNotEnough,
+		},
+		{
+			name:   "fail balance does not cover fee",
+			userID: 10,
+			rule:   &fee.Rule{Currency: "THB", Flat: 20},
+			input:  &accountusecase.MoneyParams{AccountID: 10, Amount: 990, Currency: "THB"},
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *accountusecase.MoneyParams) {
+				acc := mocks.MockAccountData()
+				mockRepo.EXPECT().FindByID(gomock.Any(), input.AccountID).Return(acc, nil).Times(1)
+			},
+			expectedErr: errs.ErrMoneyNotEnough,
+		},
+		{
+			name:   "fail post journal",
+			userID: 10,
+			input:  &accountusecase.MoneyParams{AccountID: 10, Amount: 100, Currency: "THB"},
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *accountusecase.MoneyParams) {
+				acc := mocks.MockAccountData()
+				mockRepo.EXPECT().FindByID(gomock.Any(), input.AccountID).Return(acc, nil).Times(1)
+
+				cash := mocks.MockCashAccountData()
+				mockRepo.EXPECT().FindSystemAccount(gomock.Any(), account.TypeCash, acc.Currency).Return(cash, nil).Times(1)
+
+				ledgerUC.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, mocks.ErrDatabase).Times(1)
+			},
+			expectedErr: mocks.ErrDatabase,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rule := tc.rule
+			if rule == nil {
+				rule = &fee.Rule{}
+			}
+			uc, mockRepo, _, ledgerUC := setupWithFee(t, rule)
+
+			tc.mockFn(mockRepo, ledgerUC, tc.input)
+
+			ctx := auth.SetUserID(context.Background(), tc.userID)
+
+			result, err := uc.Withdraw(ctx, tc.input)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, result)
+				if rule.Flat > 0 {
+					assert.Equal(t, rule.Flat, result.Fee.Total)
+					assert.Equal(t, -rule.Flat, result.FeeEntry.Amount)
+				}
+			}
+		})
+	}
+}
+
+func TestQuoteWithdraw(t *testing.T) {
+	type testCase struct {
+		name        string
+		userID      int64
+		input       *accountusecase.MoneyParams
+		mockFn      func(mockRepo *accountrepository.MockAccountRepository, input *accountusecase.MoneyParams)
+		expected    *fee.Breakdown
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:   "success",
+			userID: 10,
+			input:  &accountusecase.MoneyParams{AccountID: 10, Amount: 100000, Currency: "THB"},
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, input *accountusecase.MoneyParams) {
+				mockRepo.EXPECT().FindByID(gomock.Any(), input.AccountID).Return(mocks.MockAccountData(), nil).Times(1)
+			},
+			expected:    &fee.Breakdown{RuleID: 1, Currency: "THB", Flat: 2000, Percentage: 50, Adjustment: -50, Total: 2000},
+			expectedErr: nil,
+		},
+		{
+			name:        "fail no user id",
+			userID:      0,
+			input:       &accountusecase.MoneyParams{AccountID: 10, Amount: 100, Currency: "THB"},
+			mockFn:      func(mockRepo *accountrepository.MockAccountRepository, input *accountusecase.MoneyParams) {},
+			expectedErr: errs.ErrNoUserID,
+		},
+		{
+			name:        "fail invalid amount",
+			userID:      10,
+			input:       &accountusecase.MoneyParams{AccountID: 10, Amount: 0, Currency: "THB"},
+			mockFn:      func(mockRepo *accountrepository.MockAccountRepository, input *accountusecase.MoneyParams) {},
+			expectedErr: errs.ErrInvalidAmount,
+		},
+		{
+			name:   "fail not owner",
+			userID: 99,
+			input:  &accountusecase.MoneyParams{AccountID: 10, Amount: 100, Currency: "THB"},
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, input *accountusecase.MoneyParams) {
+				mockRepo.EXPECT().FindByID(gomock.Any(), input.AccountID).Return(mocks.MockAccountData(), nil).Times(1)
+			},
+			expectedErr: errs.ErrAccountNotFound,
+		},
+	}
+
+	// 20.00 flat plus 0.05%, capped at 20.00
+	rule := &fee.Rule{ID: 1, Operation: fee.OpWithdrawal, Currency: "THB", Flat: 2000, RateBps: 5, MaxFee: 2000}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, mockRepo, _, _ := setupWithFee(t, rule)
+
+			tc.mockFn(mockRepo, tc.input)
+
+			ctx := auth.SetUserID(context.Background(), tc.userID)
+
+			result, err := uc.QuoteWithdraw(ctx, tc.input)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestListEntries(t *testing.T) {
+	type testCase struct {
+		name        string
+		input       *accountusecase.ListEntriesParams
+		mockFn      func(mockRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *accountusecase.ListEntriesParams)
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:  "success",
+			input: &accountusecase.ListEntriesParams{AccountID: 10, Direction: consts.DirectionIncoming, MinAmount: 10, MaxAmount: 100, Page: &pagination.Page{Limit: 5}},
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *accountusecase.ListEntriesParams) {
+				mockRepo.EXPECT().FindByID(gomock.Any(), input.AccountID).Return(mocks.MockAccountData(), nil).Times(1)
+
+				filter := &entry.Filter{AccountID: 10, Direction: consts.DirectionIncoming, MinAmount: 10, MaxAmount: 100, Page: input.Page}
+				entRepo.EXPECT().List(gomock.Any(), filter).Return([]*entry.Entry{{ID: 1}}, nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:  "fail not owner",
+			input: &accountusecase.ListEntriesParams{AccountID: 10, Page: &pagination.Page{Limit: 5}},
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *accountusecase.ListEntriesParams) {
+				a := mocks.MockAccountData()
+				a.OwnerID = 100
+				mockRepo.EXPECT().FindByID(gomock.Any(), input.AccountID).Return(a, nil).Times(1)
+			},
+			expectedErr: errs.ErrAccountNotFound,
+		},
+		{
+			name:  "fail invalid amount range",
+			input: &accountusecase.ListEntriesParams{AccountID: 10, MinAmount: 100, MaxAmount: 10},
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *accountusecase.ListEntriesParams) {
+			},
+			expectedErr: errs.ErrInvalidAmountRange,
+		},
+		{
+			name:  "fail db error",
+			input: &accountusecase.ListEntriesParams{AccountID: 10, Page: &pagination.Page{Limit: 5}},
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *accountusecase.ListEntriesParams) {
+				mockRepo.EXPECT().FindByID(gomock.Any(), input.AccountID).Return(mocks.MockAccountData(), nil).Times(1)
+
+				entRepo.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, mocks.ErrDatabase).Times(1)
+			},
+			expectedErr: mocks.ErrDatabase,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, mockRepo, entRepo, _ := setup(t)
+
+			tc.mockFn(mockRepo, entRepo, tc.input)
+
+			ctx := auth.SetUserID(context.Background(), int64(10))
+
+			result, _, err := uc.ListEntries(ctx, tc.input)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
 			} else {
 				assert.NoError(t, err)
 				assert.NotNil(t, result)
@@ -175,14 +705,171 @@ func TestListAccounts(t *testing.T) {
 	}
 }
 
-func setup(t *testing.T) (accountusecase.AccountUsecase, *accountrepository.MockAccountRepository) {
+func TestPrepareStatement(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 1, 31, 23, 59, 59, 0, time.UTC)
+
+	type testCase struct {
+		name        string
+		input       *accountusecase.StatementParams
+		mockFn      func(mockRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *accountusecase.StatementParams)
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:  "success",
+			input: &accountusecase.StatementParams{AccountID: 10, From: &from, To: &to},
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *accountusecase.StatementParams) {
+				mockRepo.EXPECT().FindByID(gomock.Any(), input.AccountID).Return(mocks.MockAccountData(), nil).Times(1)
+
+				entRepo.EXPECT().BalanceAt(gomock.Any(), int64(10), from).Return(int64(700), nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:  "fail invalid time range",
+			input: &accountusecase.StatementParams{AccountID: 10, From: &to, To: &from},
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *accountusecase.StatementParams) {
+			},
+			expectedErr: errs.ErrInvalidTimeRange,
+		},
+		{
+			name:  "fail not owner",
+			input: &accountusecase.StatementParams{AccountID: 10},
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *accountusecase.StatementParams) {
+				a := mocks.MockAccountData()
+				a.OwnerID = 100
+				mockRepo.EXPECT().FindByID(gomock.Any(), input.AccountID).Return(a, nil).Times(1)
+			},
+			expectedErr: errs.ErrAccountNotFound,
+		},
+		{
+			name:  "fail db error",
+			input: &accountusecase.StatementParams{AccountID: 10},
+			mockFn: func(mockRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *accountusecase.StatementParams) {
+				mockRepo.EXPECT().FindByID(gomock.Any(), input.AccountID).Return(mocks.MockAccountData(), nil).Times(1)
+
+				entRepo.EXPECT().BalanceAt(gomock.Any(), int64(10), gomock.Any()).Return(int64(0), mocks.ErrDatabase).Times(1)
+			},
+			expectedErr: mocks.ErrDatabase,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, mockRepo, entRepo, _ := setup(t)
+
+			tc.mockFn(mockRepo, entRepo, tc.input)
+
+			ctx := auth.SetUserID(context.Background(), int64(10))
+
+			result, err := uc.PrepareStatement(ctx, tc.input)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(700), result.OpeningBalance)
+				assert.Equal(t, from, result.From)
+				assert.Equal(t, to, result.To)
+			}
+		})
+	}
+}
+
+func TestWriteStatement(t *testing.T) {
+	header := &accountstatement.Header{
+		AccountID:      10,
+		Currency:       "THB",
+		From:           time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:             time.Date(2025, 1, 31, 23, 59, 59, 0, time.UTC),
+		OpeningBalance: 700,
+	}
+	createdAt := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	type testCase struct {
+		name        string
+		mockFn      func(entRepo *entryrepository.MockEntryRepository)
+		expected    string
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name: "success",
+			mockFn: func(entRepo *entryrepository.MockEntryRepository) {
+				entRepo.EXPECT().Stream(gomock.Any(), header.AccountID, header.From, header.To, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ int64, _, _ time.Time, fn func(*entry.Entry) error) error {
+						for _, e := range []*entry.Entry{
+							{ID: 1, AccountID: 10, Amount: 500, CreatedAt: createdAt},
+							{ID: 2, AccountID: 10, Amount: -200, CreatedAt: createdAt},
+						} {
+							if err := fn(e); err != nil {
+								return err
+							}
+						}
+						return nil
+					}).Times(1)
+			},
+			expected: "type,entry_id,created_at,amount,balance\n" +
+				"opening,,2025-01-01T00:00:00Z,,700\n" +
+				"entry,1,2025-01-02T00:00:00Z,500,1200\n" +
+				"entry,2,2025-01-02T00:00:00Z,-200,1000\n" +
+				"closing,,,,1000\n",
+			expectedErr: nil,
+		},
+		{
+			name: "fail db error",
+			mockFn: func(entRepo *entryrepository.MockEntryRepository) {
+				entRepo.EXPECT().Stream(gomock.Any(), header.AccountID, header.From, header.To, gomock.Any()).Return(mocks.ErrDatabase).Times(1)
+			},
+			expectedErr: mocks.ErrDatabase,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, _, entRepo, _ := setup(t)
+
+			tc.mockFn(entRepo)
+
+			buf := new(bytes.Buffer)
+			w := accountstatement.NewWriter(accountstatement.FormatCSV, buf)
+
+			err := uc.WriteStatement(context.Background(), header, w)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, buf.String())
+			}
+		})
+	}
+}
+
+func setup(t *testing.T) (accountusecase.AccountUsecase, *accountrepository.MockAccountRepository, *entryrepository.MockEntryRepository, *ledgerusecase.MockLedgerUsecase) {
+	t.Helper()
+	return setupWithFee(t, &fee.Rule{})
+}
+
+// setupWithFee charges every withdrawal by rule.
+func setupWithFee(t *testing.T, rule *fee.Rule) (accountusecase.AccountUsecase, *accountrepository.MockAccountRepository, *entryrepository.MockEntryRepository, *ledgerusecase.MockLedgerUsecase) {
 	t.Helper()
 
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := accountrepository.NewMockAccountRepository(ctrl)
-	uc := accountusecase.NewAccountUsecase(mockRepo)
+	entRepo := entryrepository.NewMockEntryRepository(ctrl)
+	feeRepo := feerepository.NewMockFeeRepository(ctrl)
+	ledgerUC := ledgerusecase.NewMockLedgerUsecase(ctrl)
+	mockTx := &mocks.MockTx{}
+
+	feeRepo.EXPECT().FindRule(gomock.Any(), fee.OpWithdrawal, gomock.Any(), gomock.Any()).Return(rule, nil).AnyTimes()
+
+	uc := accountusecase.NewAccountUsecase(mockRepo, entRepo, feeRepo, ledgerUC, mockTx, mocks.MockCurrencies())
 
-	return uc, mockRepo
+	return uc, mockRepo, entRepo, ledgerUC
 }
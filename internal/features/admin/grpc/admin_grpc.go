@@ -0,0 +1,322 @@
+package admingrpc
+
+import (
+	"context"
+
+	"github.com/codepnw/simple-bank/internal/features/account"
+	accountgrpc "github.com/codepnw/simple-bank/internal/features/account/grpc"
+	adminusecase "github.com/codepnw/simple-bank/internal/features/admin/usecase"
+	"github.com/codepnw/simple-bank/internal/features/entry"
+	"github.com/codepnw/simple-bank/internal/features/transfer"
+	"github.com/codepnw/simple-bank/internal/features/user"
+	pb "github.com/codepnw/simple-bank/pb/proto"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/codepnw/simple-bank/pkg/utils/helper"
+	"github.com/codepnw/simple-bank/pkg/utils/pagination"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AdminServer struct {
+	pb.UnimplementedSimpleBankServer
+	uc adminusecase.AdminUsecase
+}
+
+func NewAdminServer(uc adminusecase.AdminUsecase) *AdminServer {
+	return &AdminServer{uc: uc}
+}
+
+// findUserInput mirrors the REST FindUserReq rules.
+type findUserInput struct {
+	Email string `validate:"required,email"`
+}
+
+func (s *AdminServer) AdminFindUser(ctx context.Context, req *pb.AdminFindUserRequest) (*pb.AdminFindUserResponse, error) {
+	input := &findUserInput{Email: req.GetEmail()}
+	if err := helper.Validate(input); err != nil {
+		return nil, errs.InvalidInput(err)
+	}
+
+	data, err := s.uc.FindUserByEmail(ctx, input.Email)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AdminFindUserResponse{User: toPbUser(data)}, nil
+}
+
+func (s *AdminServer) AdminGetUser(ctx context.Context, req *pb.AdminGetUserRequest) (*pb.AdminGetUserResponse, error) {
+	if req.GetUserId() <= 0 {
+		return nil, errs.ErrInvalidID
+	}
+
+	data, err := s.uc.GetUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AdminGetUserResponse{User: toPbUser(data)}, nil
+}
+
+func (s *AdminServer) AdminListUserAccounts(ctx context.Context, req *pb.AdminListUserAccountsRequest) (*pb.AdminListUserAccountsResponse, error) {
+	if req.GetUserId() <= 0 {
+		return nil, errs.ErrInvalidID
+	}
+
+	page, err := pagination.NewPage(int(req.GetPage()), int(req.GetSize()), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	data, meta, err := s.uc.ListUserAccounts(ctx, req.GetUserId(), page)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]*pb.Account, 0, len(data))
+	for _, acc := range data {
+		accounts = append(accounts, accountgrpc.ToPbAccount(acc))
+	}
+	return &pb.AdminListUserAccountsResponse{
+		Accounts:   accounts,
+		Pagination: toPbPagination(meta),
+	}, nil
+}
+
+func (s *AdminServer) AdminGetAccount(ctx context.Context, req *pb.AdminGetAccountRequest) (*pb.AdminGetAccountResponse, error) {
+	if req.GetAccountId() <= 0 {
+		return nil, errs.ErrInvalidID
+	}
+
+	data, err := s.uc.GetAccount(ctx, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AdminGetAccountResponse{Account: accountgrpc.ToPbAccount(data)}, nil
+}
+
+// changeStatusInput mirrors the REST ChangeStatusReq rules.
+type changeStatusInput struct {
+	Status string `validate:"required,oneof=active frozen closed"`
+	Reason string `validate:"required,max=500"`
+}
+
+func (s *AdminServer) AdminChangeAccountStatus(ctx context.Context, req *pb.AdminChangeAccountStatusRequest) (*pb.AdminChangeAccountStatusResponse, error) {
+	if req.GetAccountId() <= 0 {
+		return nil, errs.ErrInvalidID
+	}
+
+	input := &changeStatusInput{Status: req.GetStatus(), Reason: req.GetReason()}
+	if err := helper.Validate(input); err != nil {
+		return nil, errs.InvalidInput(err)
+	}
+
+	data, err := s.uc.ChangeAccountStatus(ctx, &adminusecase.ChangeStatusParams{
+		AccountID: req.GetAccountId(),
+		Status:    account.Status(input.Status),
+		Reason:    input.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AdminChangeAccountStatusResponse{Account: accountgrpc.ToPbAccount(data)}, nil
+}
+
+func (s *AdminServer) AdminListStatusChanges(ctx context.Context, req *pb.AdminListStatusChangesRequest) (*pb.AdminListStatusChangesResponse, error) {
+	if req.GetAccountId() <= 0 {
+		return nil, errs.ErrInvalidID
+	}
+
+	data, err := s.uc.ListStatusChanges(ctx, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*pb.AccountStatusChange, 0, len(data))
+	for _, c := range data {
+		changes = append(changes, &pb.AccountStatusChange{
+			Id:         c.ID,
+			AccountId:  c.AccountID,
+			FromStatus: string(c.From),
+			ToStatus:   string(c.To),
+			Reason:     c.Reason,
+			ChangedBy:  c.ChangedBy,
+			CreatedAt:  timestamppb.New(c.CreatedAt),
+		})
+	}
+	return &pb.AdminListStatusChangesResponse{Changes: changes}, nil
+}
+
+func (s *AdminServer) AdminGetTransferLimits(ctx context.Context, req *pb.AdminGetTransferLimitsRequest) (*pb.AdminGetTransferLimitsResponse, error) {
+	if req.GetAccountId() <= 0 {
+		return nil, errs.ErrInvalidID
+	}
+
+	data, err := s.uc.GetTransferLimits(ctx, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AdminGetTransferLimitsResponse{Limits: toPbLimits(data)}, nil
+}
+
+func (s *AdminServer) AdminSetTransferLimits(ctx context.Context, req *pb.AdminSetTransferLimitsRequest) (*pb.AdminSetTransferLimitsResponse, error) {
+	if req.GetAccountId() <= 0 {
+		return nil, errs.ErrInvalidID
+	}
+
+	data, err := s.uc.SetTransferLimits(ctx, &adminusecase.SetLimitsParams{
+		AccountID:   req.GetAccountId(),
+		MaxAmount:   req.MaxAmount,
+		DailyAmount: req.DailyAmount,
+		DailyCount:  req.DailyCount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AdminSetTransferLimitsResponse{Limits: toPbLimits(data)}, nil
+}
+
+func (s *AdminServer) AdminSetOverdraftLimit(ctx context.Context, req *pb.AdminSetOverdraftLimitRequest) (*pb.AdminSetOverdraftLimitResponse, error) {
+	if req.GetAccountId() <= 0 {
+		return nil, errs.ErrInvalidID
+	}
+
+	data, err := s.uc.SetOverdraftLimit(ctx, &adminusecase.SetOverdraftParams{
+		AccountID: req.GetAccountId(),
+		Limit:     req.GetLimit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AdminSetOverdraftLimitResponse{Account: accountgrpc.ToPbAccount(data)}, nil
+}
+
+func (s *AdminServer) AdminListEntries(ctx context.Context, req *pb.AdminListEntriesRequest) (*pb.AdminListEntriesResponse, error) {
+	if req.GetAccountId() <= 0 {
+		return nil, errs.ErrInvalidID
+	}
+
+	page, err := pagination.NewPage(int(req.GetPage()), int(req.GetSize()), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	data, meta, err := s.uc.ListEntries(ctx, &adminusecase.ListEntriesParams{
+		AccountID: req.GetAccountId(),
+		Page:      page,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*pb.Entry, 0, len(data))
+	for _, ent := range data {
+		entries = append(entries, toPbEntry(ent))
+	}
+	return &pb.AdminListEntriesResponse{
+		Entries:    entries,
+		Pagination: toPbPagination(meta),
+	}, nil
+}
+
+func (s *AdminServer) AdminGetEntry(ctx context.Context, req *pb.AdminGetEntryRequest) (*pb.AdminGetEntryResponse, error) {
+	if req.GetEntryId() <= 0 {
+		return nil, errs.ErrInvalidID
+	}
+
+	data, err := s.uc.GetEntry(ctx, req.GetEntryId())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AdminGetEntryResponse{Entry: toPbEntry(data)}, nil
+}
+
+func (s *AdminServer) AdminListTransfers(ctx context.Context, req *pb.AdminListTransfersRequest) (*pb.AdminListTransfersResponse, error) {
+	page, err := pagination.NewPage(int(req.GetPage()), int(req.GetSize()), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	data, meta, err := s.uc.ListTransfers(ctx, &adminusecase.ListTransfersParams{
+		OwnerID:   req.GetUserId(),
+		AccountID: req.GetAccountId(),
+		Page:      page,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	transfers := make([]*pb.Transfer, 0, len(data))
+	for _, t := range data {
+		transfers = append(transfers, toPbTransfer(t))
+	}
+	return &pb.AdminListTransfersResponse{
+		Transfers:  transfers,
+		Pagination: toPbPagination(meta),
+	}, nil
+}
+
+func (s *AdminServer) AdminGetTransfer(ctx context.Context, req *pb.AdminGetTransferRequest) (*pb.AdminGetTransferResponse, error) {
+	if req.GetTransferId() <= 0 {
+		return nil, errs.ErrInvalidID
+	}
+
+	data, err := s.uc.GetTransfer(ctx, req.GetTransferId())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AdminGetTransferResponse{Transfer: toPbTransfer(data)}, nil
+}
+
+func toPbUser(u *user.User) *pb.User {
+	return &pb.User{
+		Id:        u.ID,
+		Username:  u.Username,
+		FirstName: u.FirstName,
+		LastName:  u.LastName,
+		Email:     u.Email,
+		Role:      string(u.Role),
+		CreatedAt: timestamppb.New(u.CreatedAt),
+		UpdatedAt: timestamppb.New(u.UpdatedAt),
+	}
+}
+
+func toPbEntry(ent *entry.Entry) *pb.Entry {
+	return &pb.Entry{
+		Id:        ent.ID,
+		JournalId: ent.JournalID,
+		AccountId: ent.AccountID,
+		Amount:    ent.Amount,
+		CreatedAt: timestamppb.New(ent.CreatedAt),
+	}
+}
+
+func toPbTransfer(t *transfer.Transfer) *pb.Transfer {
+	return &pb.Transfer{
+		Id:            t.ID,
+		FromAccountId: t.FromAccountID,
+		ToAccountId:   t.ToAccountID,
+		Amount:        t.Amount,
+		ToAmount:      t.ToAmount,
+		ExchangeRate:  t.ExchangeRate.String(),
+		Fee:           t.Fee,
+		ReversalOf:    t.ReversalOf,
+		ReversedBy:    t.ReversedBy,
+		CreatedAt:     timestamppb.New(t.CreatedAt),
+	}
+}
+
+func toPbLimits(l *transfer.Limits) *pb.TransferLimits {
+	return &pb.TransferLimits{
+		AccountId:   l.AccountID,
+		Currency:    l.Currency,
+		MaxAmount:   l.MaxAmount,
+		DailyAmount: l.DailyAmount,
+		DailyCount:  l.DailyCount,
+	}
+}
+
+func toPbPagination(meta *pagination.Meta) *pb.Pagination {
+	return &pb.Pagination{
+		NextCursor: meta.NextCursor,
+		HasMore:    meta.HasMore,
+		PageSize:   int32(meta.PageSize),
+	}
+}
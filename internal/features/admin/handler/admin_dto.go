@@ -0,0 +1,48 @@
+package adminhandler
+
+type FindUserReq struct {
+	Email string `form:"email" binding:"required,email"`
+}
+
+type ListEntriesReq struct {
+	Direction string `form:"direction" binding:"omitempty,oneof=incoming outgoing"`
+	From      string `form:"from"`
+	To        string `form:"to"`
+	MinAmount int64  `form:"min_amount" binding:"omitempty,min=1"`
+	MaxAmount int64  `form:"max_amount" binding:"omitempty,min=1"`
+	Cursor    string `form:"cursor"`
+	Page      int    `form:"page"`
+	Size      int    `form:"size"`
+}
+
+type ListTransfersReq struct {
+	UserID    int64  `form:"user_id" binding:"omitempty,min=1"`
+	AccountID int64  `form:"account_id" binding:"omitempty,min=1"`
+	Direction string `form:"direction" binding:"omitempty,oneof=incoming outgoing"`
+	From      string `form:"from"`
+	To        string `form:"to"`
+	MinAmount int64  `form:"min_amount" binding:"omitempty,min=1"`
+	MaxAmount int64  `form:"max_amount" binding:"omitempty,min=1"`
+	Cursor    string `form:"cursor"`
+	Page      int    `form:"page"`
+	Size      int    `form:"size"`
+}
+
+type ChangeStatusReq struct {
+	Status string `json:"status" binding:"required,oneof=active frozen closed" example:"frozen"`
+	Reason string `json:"reason" binding:"required,max=500" example:"customer reported a stolen card"`
+}
+
+// SetLimitsReq replaces an account's limit override; omitted or null fields
+// fall back to the currency limit.
+type SetLimitsReq struct {
+	MaxAmount   *int64 `json:"max_amount" binding:"omitempty,min=1" example:"10000000"`
+	DailyAmount *int64 `json:"daily_amount" binding:"omitempty,min=1" example:"50000000"`
+	DailyCount  *int64 `json:"daily_count" binding:"omitempty,min=1" example:"20"`
+}
+
+// SetOverdraftReq sets how far below zero the account may go; 0 removes the
+// overdraft.
+type SetOverdraftReq struct {
+	Limit *int64 `json:"limit" binding:"required,min=0" example:"500000"`
+}
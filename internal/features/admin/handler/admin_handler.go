@@ -0,0 +1,504 @@
+package adminhandler
+
+import (
+	"github.com/codepnw/simple-bank/internal/consts"
+	"github.com/codepnw/simple-bank/internal/features/account"
+	adminusecase "github.com/codepnw/simple-bank/internal/features/admin/usecase"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/codepnw/simple-bank/pkg/utils/helper"
+	"github.com/codepnw/simple-bank/pkg/utils/pagination"
+	"github.com/codepnw/simple-bank/pkg/utils/response"
+	"github.com/gin-gonic/gin"
+)
+
+type adminHandler struct {
+	uc adminusecase.AdminUsecase
+}
+
+func NewAdminHandler(uc adminusecase.AdminUsecase) *adminHandler {
+	return &adminHandler{uc: uc}
+}
+
+// @Summary Find User
+// @Description look up a user by email (admin only)
+// @Tags admin
+// @Produce      json
+// @Param email query string true "Email"
+// @Success 200 {object} user.User "Find User Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "User Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /admin/users [get]
+func (h *adminHandler) FindUser(c *gin.Context) {
+	req := new(FindUserReq)
+	if err := c.ShouldBindQuery(req); err != nil {
+		response.Error(c, errs.InvalidInput(err))
+		return
+	}
+
+	data, err := h.uc.FindUserByEmail(c.Request.Context(), req.Email)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.Success(c, "", data)
+}
+
+// @Summary Get User
+// @Description get any user by id (admin only)
+// @Tags admin
+// @Produce      json
+// @Param user_id path int true "User ID"
+// @Success 200 {object} user.User "Get User Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "User Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /admin/users/{user_id} [get]
+func (h *adminHandler) GetUser(c *gin.Context) {
+	id, err := helper.ParseInt64(c.Param(consts.ParamUserID))
+	if err != nil {
+		response.Error(c, errs.ErrInvalidID.Wrap(err))
+		return
+	}
+
+	data, err := h.uc.GetUser(c.Request.Context(), id)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.Success(c, "", data)
+}
+
+// @Summary List User Accounts
+// @Description list the accounts of any user (admin only)
+// @Tags admin
+// @Produce      json
+// @Param user_id path int true "User ID"
+// @Param cursor query string false "Cursor from the previous page's next_cursor"
+// @Param page query int false "Page number (offset mode, ignored when cursor is set)"
+// @Param size query int false "Page size"
+// @Success 200 {object} response.PageResponse{data=[]account.Account} "List Accounts Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "User Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /admin/users/{user_id}/accounts [get]
+func (h *adminHandler) ListUserAccounts(c *gin.Context) {
+	id, err := helper.ParseInt64(c.Param(consts.ParamUserID))
+	if err != nil {
+		response.Error(c, errs.ErrInvalidID.Wrap(err))
+		return
+	}
+
+	page, err := pagination.NewPage(
+		helper.ParseInt(c.Query("page")),
+		helper.ParseInt(c.Query("size")),
+		c.Query("cursor"),
+	)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	data, meta, err := h.uc.ListUserAccounts(c.Request.Context(), id, page)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.SuccessPage(c, "", data, meta)
+}
+
+// @Summary Get Account
+// @Description get any account by id (admin only)
+// @Tags admin
+// @Produce      json
+// @Param account_id path int true "Account ID"
+// @Success 200 {object} account.Account "Get Account Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Account Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /admin/accounts/{account_id} [get]
+func (h *adminHandler) GetAccount(c *gin.Context) {
+	id, err := helper.ParseInt64(c.Param(consts.ParamAccountID))
+	if err != nil {
+		response.Error(c, errs.ErrInvalidID.Wrap(err))
+		return
+	}
+
+	data, err := h.uc.GetAccount(c.Request.Context(), id)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.Success(c, "", data)
+}
+
+// @Summary Change Account Status
+// @Description freeze, unfreeze or close a customer account (admin only). Closing needs a zero balance and is final.
+// @Tags admin
+// @Accept       json
+// @Produce      json
+// @Param account_id path int true "Account ID"
+// @Param request body ChangeStatusReq true "New Status and Reason"
+// @Success 200 {object} account.Account "Change Account Status Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Account Not Found"
+// @Failure 409 {object} response.ErrorResponse "Transition Not Allowed or Balance Not Zero"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /admin/accounts/{account_id}/status [patch]
+func (h *adminHandler) ChangeAccountStatus(c *gin.Context) {
+	id, err := helper.ParseInt64(c.Param(consts.ParamAccountID))
+	if err != nil {
+		response.Error(c, errs.ErrInvalidID.Wrap(err))
+		return
+	}
+
+	req := new(ChangeStatusReq)
+	if err := c.ShouldBindJSON(req); err != nil {
+		response.Error(c, errs.InvalidInput(err))
+		return
+	}
+
+	input := &adminusecase.ChangeStatusParams{
+		AccountID: id,
+		Status:    account.Status(req.Status),
+		Reason:    req.Reason,
+	}
+	data, err := h.uc.ChangeAccountStatus(c.Request.Context(), input)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.Success(c, "", data)
+}
+
+// @Summary List Account Status Changes
+// @Description status history of an account with reasons, newest first (admin only)
+// @Tags admin
+// @Produce      json
+// @Param account_id path int true "Account ID"
+// @Success 200 {object} []account.StatusChange "List Status Changes Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Account Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /admin/accounts/{account_id}/status-changes [get]
+func (h *adminHandler) ListStatusChanges(c *gin.Context) {
+	id, err := helper.ParseInt64(c.Param(consts.ParamAccountID))
+	if err != nil {
+		response.Error(c, errs.ErrInvalidID.Wrap(err))
+		return
+	}
+
+	data, err := h.uc.ListStatusChanges(c.Request.Context(), id)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.Success(c, "", data)
+}
+
+// @Summary Get Transfer Limits
+// @Description limits in effect on an account's outgoing transfers, in minor units; 0 means no limit (admin only)
+// @Tags admin
+// @Produce      json
+// @Param account_id path int true "Account ID"
+// @Success 200 {object} transfer.Limits "Get Transfer Limits Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Account Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /admin/accounts/{account_id}/limits [get]
+func (h *adminHandler) GetTransferLimits(c *gin.Context) {
+	id, err := helper.ParseInt64(c.Param(consts.ParamAccountID))
+	if err != nil {
+		response.Error(c, errs.ErrInvalidID.Wrap(err))
+		return
+	}
+
+	data, err := h.uc.GetTransferLimits(c.Request.Context(), id)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.Success(c, "", data)
+}
+
+// @Summary Set Transfer Limits
+// @Description override a customer account's transfer limits; omitted fields use the currency limit (admin only)
+// @Tags admin
+// @Accept       json
+// @Produce      json
+// @Param account_id path int true "Account ID"
+// @Param request body SetLimitsReq true "Limit Override"
+// @Success 200 {object} transfer.Limits "Set Transfer Limits Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Account Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /admin/accounts/{account_id}/limits [put]
+func (h *adminHandler) SetTransferLimits(c *gin.Context) {
+	id, err := helper.ParseInt64(c.Param(consts.ParamAccountID))
+	if err != nil {
+		response.Error(c, errs.ErrInvalidID.Wrap(err))
+		return
+	}
+
+	req := new(SetLimitsReq)
+	if err := c.ShouldBindJSON(req); err != nil {
+		response.Error(c, errs.InvalidInput(err))
+		return
+	}
+
+	input := &adminusecase.SetLimitsParams{
+		AccountID:   id,
+		MaxAmount:   req.MaxAmount,
+		DailyAmount: req.DailyAmount,
+		DailyCount:  req.DailyCount,
+	}
+	data, err := h.uc.SetTransferLimits(c.Request.Context(), input)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.Success(c, "", data)
+}
+
+// @Summary Set Overdraft Limit
+// @Description set how far below zero a customer account's balance may go, in minor units; 0 removes the overdraft (admin only)
+// @Tags admin
+// @Accept       json
+// @Produce      json
+// @Param account_id path int true "Account ID"
+// @Param request body SetOverdraftReq true "Overdraft Limit"
+// @Success 200 {object} account.Account "Set Overdraft Limit Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Account Not Found"
+// @Failure 409 {object} response.ErrorResponse "Account Closed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /admin/accounts/{account_id}/overdraft [put]
+func (h *adminHandler) SetOverdraftLimit(c *gin.Context) {
+	id, err := helper.ParseInt64(c.Param(consts.ParamAccountID))
+	if err != nil {
+		response.Error(c, errs.ErrInvalidID.Wrap(err))
+		return
+	}
+
+	req := new(SetOverdraftReq)
+	if err := c.ShouldBindJSON(req); err != nil {
+		response.Error(c, errs.InvalidInput(err))
+		return
+	}
+
+	input := &adminusecase.SetOverdraftParams{
+		AccountID: id,
+		Limit:     *req.Limit,
+	}
+	data, err := h.uc.SetOverdraftLimit(c.Request.Context(), input)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.Success(c, "", data)
+}
+
+// @Summary List Account Entries
+// @Description list balance entries of any account (admin only)
+// @Tags admin
+// @Produce      json
+// @Param account_id path int true "Account ID"
+// @Param direction query string false "incoming (credit) or outgoing (debit)"
+// @Param from query string false "From time (RFC3339 or YYYY-MM-DD)"
+// @Param to query string false "To time, inclusive (RFC3339 or YYYY-MM-DD)"
+// @Param min_amount query int false "Minimum absolute amount"
+// @Param max_amount query int false "Maximum absolute amount"
+// @Param cursor query string false "Cursor from the previous page's next_cursor"
+// @Param page query int false "Page number (offset mode, ignored when cursor is set)"
+// @Param size query int false "Page size"
+// @Success 200 {object} response.PageResponse{data=[]entry.Entry} "List Entries Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Account Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /admin/accounts/{account_id}/entries [get]
+func (h *adminHandler) ListEntries(c *gin.Context) {
+	id, err := helper.ParseInt64(c.Param(consts.ParamAccountID))
+	if err != nil {
+		response.Error(c, errs.ErrInvalidID.Wrap(err))
+		return
+	}
+
+	req := new(ListEntriesReq)
+	if err := c.ShouldBindQuery(req); err != nil {
+		response.Error(c, errs.InvalidInput(err))
+		return
+	}
+
+	from, to, err := helper.ParseTimeRange(req.From, req.To)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	page, err := pagination.NewPage(req.Page, req.Size, req.Cursor)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	input := &adminusecase.ListEntriesParams{
+		AccountID: id,
+		Direction: req.Direction,
+		From:      from,
+		To:        to,
+		MinAmount: req.MinAmount,
+		MaxAmount: req.MaxAmount,
+		Page:      page,
+	}
+	data, meta, err := h.uc.ListEntries(c.Request.Context(), input)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.SuccessPage(c, "", data, meta)
+}
+
+// @Summary Get Entry
+// @Description get any entry by id (admin only)
+// @Tags admin
+// @Produce      json
+// @Param entry_id path int true "Entry ID"
+// @Success 200 {object} entry.Entry "Get Entry Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Entry Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /admin/entries/{entry_id} [get]
+func (h *adminHandler) GetEntry(c *gin.Context) {
+	id, err := helper.ParseInt64(c.Param(consts.ParamEntryID))
+	if err != nil {
+		response.Error(c, errs.ErrInvalidID.Wrap(err))
+		return
+	}
+
+	data, err := h.uc.GetEntry(c.Request.Context(), id)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.Success(c, "", data)
+}
+
+// @Summary List Transfers
+// @Description list transfers of any account or user (admin only)
+// @Tags admin
+// @Produce      json
+// @Param user_id query int false "User ID (all accounts of the user)"
+// @Param account_id query int false "Account ID (takes precedence over user_id)"
+// @Param direction query string false "incoming or outgoing"
+// @Param from query string false "From time (RFC3339 or YYYY-MM-DD)"
+// @Param to query string false "To time, inclusive (RFC3339 or YYYY-MM-DD)"
+// @Param min_amount query int false "Minimum amount"
+// @Param max_amount query int false "Maximum amount"
+// @Param cursor query string false "Cursor from the previous page's next_cursor"
+// @Param page query int false "Page number (offset mode, ignored when cursor is set)"
+// @Param size query int false "Page size"
+// @Success 200 {object} response.PageResponse{data=[]transfer.Transfer} "List Transfers Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "User or Account Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /admin/transfers [get]
+func (h *adminHandler) ListTransfers(c *gin.Context) {
+	req := new(ListTransfersReq)
+	if err := c.ShouldBindQuery(req); err != nil {
+		response.Error(c, errs.InvalidInput(err))
+		return
+	}
+
+	from, to, err := helper.ParseTimeRange(req.From, req.To)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	page, err := pagination.NewPage(req.Page, req.Size, req.Cursor)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	input := &adminusecase.ListTransfersParams{
+		OwnerID:   req.UserID,
+		AccountID: req.AccountID,
+		Direction: req.Direction,
+		From:      from,
+		To:        to,
+		MinAmount: req.MinAmount,
+		MaxAmount: req.MaxAmount,
+		Page:      page,
+	}
+	data, meta, err := h.uc.ListTransfers(c.Request.Context(), input)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.SuccessPage(c, "", data, meta)
+}
+
+// @Summary Get Transfer
+// @Description get any transfer by id (admin only)
+// @Tags admin
+// @Produce      json
+// @Param transfer_id path int true "Transfer ID"
+// @Success 200 {object} transfer.Transfer "Get Transfer Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Transfer Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /admin/transfers/{transfer_id} [get]
+func (h *adminHandler) GetTransfer(c *gin.Context) {
+	id, err := helper.ParseInt64(c.Param(consts.ParamTransferID))
+	if err != nil {
+		response.Error(c, errs.ErrInvalidID.Wrap(err))
+		return
+	}
+
+	data, err := h.uc.GetTransfer(c.Request.Context(), id)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.Success(c, "", data)
+}
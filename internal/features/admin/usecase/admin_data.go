@@ -0,0 +1,105 @@
+package adminusecase
+
+import (
+	"strings"
+	"time"
+
+	"github.com/codepnw/simple-bank/internal/features/account"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/codepnw/simple-bank/pkg/utils/pagination"
+)
+
+type ListEntriesParams struct {
+	AccountID int64
+	Direction string
+	From      *time.Time
+	To        *time.Time
+	MinAmount int64
+	MaxAmount int64
+	Page      *pagination.Page
+}
+
+func (p *ListEntriesParams) validate() error {
+	if p.From != nil && p.To != nil && p.From.After(*p.To) {
+		return errs.ErrInvalidTimeRange
+	}
+	if p.MinAmount > 0 && p.MaxAmount > 0 && p.MinAmount > p.MaxAmount {
+		return errs.ErrInvalidAmountRange
+	}
+	return nil
+}
+
+// ListTransfersParams selects transfers of one account, or of every account
+// of OwnerID when AccountID is zero. One of the two is required.
+type ListTransfersParams struct {
+	OwnerID   int64
+	AccountID int64
+	Direction string
+	From      *time.Time
+	To        *time.Time
+	MinAmount int64
+	MaxAmount int64
+	Page      *pagination.Page
+}
+
+func (p *ListTransfersParams) validate() error {
+	if p.OwnerID <= 0 && p.AccountID <= 0 {
+		return errs.ErrFilterScopeRequired
+	}
+	if p.From != nil && p.To != nil && p.From.After(*p.To) {
+		return errs.ErrInvalidTimeRange
+	}
+	if p.MinAmount > 0 && p.MaxAmount > 0 && p.MinAmount > p.MaxAmount {
+		return errs.ErrInvalidAmountRange
+	}
+	return nil
+}
+
+type ChangeStatusParams struct {
+	AccountID int64
+	Status    account.Status
+	Reason    string
+}
+
+func (p *ChangeStatusParams) validate() error {
+	if !p.Status.Valid() {
+		return errs.ErrInvalidAccountStatus
+	}
+	p.Reason = strings.TrimSpace(p.Reason)
+	if p.Reason == "" {
+		return errs.ErrReasonRequired
+	}
+	return nil
+}
+
+// SetLimitsParams overrides an account's transfer limits. A nil field falls
+// back to the currency limit.
+type SetLimitsParams struct {
+	AccountID   int64
+	MaxAmount   *int64
+	DailyAmount *int64
+	DailyCount  *int64
+}
+
+func (p *SetLimitsParams) validate() error {
+	for _, v := range []*int64{p.MaxAmount, p.DailyAmount, p.DailyCount} {
+		if v != nil && *v <= 0 {
+			return errs.ErrInvalidTransferLimit
+		}
+	}
+	return nil
+}
+
+// SetOverdraftParams sets how far below zero an account may go, in minor
+// units. Zero removes the facility.
+type SetOverdraftParams struct {
+	AccountID int64
+	Limit     int64
+}
+
+func (p *SetOverdraftParams) validate() error {
+	if p.Limit < 0 {
+		return errs.ErrInvalidOverdraftLimit
+	}
+	return nil
+}
@@ -0,0 +1,396 @@
+package adminusecase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/codepnw/simple-bank/internal/consts"
+	"github.com/codepnw/simple-bank/internal/features/account"
+	accountrepository "github.com/codepnw/simple-bank/internal/features/account/repository"
+	"github.com/codepnw/simple-bank/internal/features/entry"
+	entryrepository "github.com/codepnw/simple-bank/internal/features/entry/repository"
+	"github.com/codepnw/simple-bank/internal/features/transfer"
+	transferrepository "github.com/codepnw/simple-bank/internal/features/transfer/repository"
+	"github.com/codepnw/simple-bank/internal/features/user"
+	userrepository "github.com/codepnw/simple-bank/internal/features/user/repository"
+	"github.com/codepnw/simple-bank/pkg/auth"
+	"github.com/codepnw/simple-bank/pkg/currency"
+	"github.com/codepnw/simple-bank/pkg/database"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/codepnw/simple-bank/pkg/utils/pagination"
+)
+
+// AdminUsecase is the back-office view: lookups across owners and account
+// status changes. Every method requires the admin role.
+type AdminUsecase interface {
+	GetUser(ctx context.Context, id int64) (*user.User, error)
+	FindUserByEmail(ctx context.Context, email string) (*user.User, error)
+	ListUserAccounts(ctx context.Context, userID int64, page *pagination.Page) ([]*account.Account, *pagination.Meta, error)
+	GetAccount(ctx context.Context, id int64) (*account.Account, error)
+	ChangeAccountStatus(ctx context.Context, input *ChangeStatusParams) (*account.Account, error)
+	ListStatusChanges(ctx context.Context, accountID int64) ([]*account.StatusChange, error)
+	GetTransferLimits(ctx context.Context, accountID int64) (*transfer.Limits, error)
+	SetTransferLimits(ctx context.Context, input *SetLimitsParams) (*transfer.Limits, error)
+	SetOverdraftLimit(ctx context.Context, input *SetOverdraftParams) (*account.Account, error)
+	ListEntries(ctx context.Context, input *ListEntriesParams) ([]*entry.Entry, *pagination.Meta, error)
+	GetEntry(ctx context.Context, id int64) (*entry.Entry, error)
+	ListTransfers(ctx context.Context, input *ListTransfersParams) ([]*transfer.Transfer, *pagination.Meta, error)
+	GetTransfer(ctx context.Context, id int64) (*transfer.Transfer, error)
+}
+
+type adminUsecase struct {
+	userRepo   userrepository.UserRepository
+	accRepo    accountrepository.AccountRepository
+	entRepo    entryrepository.EntryRepository
+	tranRepo   transferrepository.TransferRepository
+	tx         database.TxManager
+	currencies *currency.Registry
+}
+
+func NewAdminUsecase(
+	userRepo userrepository.UserRepository,
+	accRepo accountrepository.AccountRepository,
+	entRepo entryrepository.EntryRepository,
+	tranRepo transferrepository.TransferRepository,
+	tx database.TxManager,
+	currencies *currency.Registry,
+) AdminUsecase {
+	return &adminUsecase{
+		userRepo:   userRepo,
+		accRepo:    accRepo,
+		entRepo:    entRepo,
+		tranRepo:   tranRepo,
+		tx:         tx,
+		currencies: currencies,
+	}
+}
+
+// requireAdmin backs up the route and RPC policies, so the usecase stays
+// safe whichever transport calls it.
+func requireAdmin(ctx context.Context) error {
+	if auth.GetUserID(ctx) == 0 {
+		return errs.ErrNoUserID
+	}
+	if !auth.HasRole(ctx, user.RoleAdmin) {
+		return errs.ErrNoPermission
+	}
+	return nil
+}
+
+func (u *adminUsecase) GetUser(ctx context.Context, id int64) (*user.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return u.userRepo.FindByID(ctx, id)
+}
+
+func (u *adminUsecase) FindUserByEmail(ctx context.Context, email string) (*user.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	// FindByEmail only loads login fields
+	found, err := u.userRepo.FindByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	return u.userRepo.FindByID(ctx, found.ID)
+}
+
+func (u *adminUsecase) ListUserAccounts(ctx context.Context, userID int64, page *pagination.Page) ([]*account.Account, *pagination.Meta, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	if err := requireAdmin(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := u.userRepo.FindByID(ctx, userID); err != nil {
+		return nil, nil, err
+	}
+
+	accounts, err := u.accRepo.List(ctx, userID, page)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	accounts, meta := pagination.Trim(accounts, page, func(a *account.Account) pagination.Cursor {
+		return pagination.Cursor{CreatedAt: a.CreatedAt, ID: a.ID}
+	})
+	u.display(accounts...)
+	return accounts, meta, nil
+}
+
+func (u *adminUsecase) GetAccount(ctx context.Context, id int64) (*account.Account, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	acc, err := u.accRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	u.display(acc)
+	return acc, nil
+}
+
+// ChangeAccountStatus moves a customer account to input.Status and records
+// who did it and why. The account row stays locked throughout, so a close
+// can't race a transfer into a non-zero balance.
+func (u *adminUsecase) ChangeAccountStatus(ctx context.Context, input *ChangeStatusParams) (*account.Account, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := input.validate(); err != nil {
+		return nil, err
+	}
+
+	var updated *account.Account
+	err := u.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
+		acc, err := u.accRepo.FindByIDForUpdate(ctx, tx, input.AccountID)
+		if err != nil {
+			return err
+		}
+		// System accounts back every customer's cash; they always stay active
+		if acc.Type != account.TypeCustomer || !acc.Status.CanBecome(input.Status) {
+			return errs.ErrStatusTransition
+		}
+		if input.Status == account.StatusClosed {
+			if acc.Balance != 0 {
+				return errs.ErrBalanceNotZero
+			}
+			// Holds funded by the overdraft would be stranded
+			if acc.HeldAmount != 0 {
+				return errs.ErrAccountHasHolds
+			}
+		}
+
+		updated, err = u.accRepo.UpdateStatus(ctx, tx, acc.ID, input.Status)
+		if err != nil {
+			return err
+		}
+
+		return u.accRepo.InsertStatusChange(ctx, tx, &account.StatusChange{
+			AccountID: acc.ID,
+			From:      acc.Status,
+			To:        input.Status,
+			Reason:    input.Reason,
+			ChangedBy: auth.GetUserID(ctx),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	u.display(updated)
+	return updated, nil
+}
+
+func (u *adminUsecase) ListStatusChanges(ctx context.Context, accountID int64) ([]*account.StatusChange, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := u.accRepo.FindByID(ctx, accountID); err != nil {
+		return nil, err
+	}
+	return u.accRepo.ListStatusChanges(ctx, accountID)
+}
+
+func (u *adminUsecase) GetTransferLimits(ctx context.Context, accountID int64) (*transfer.Limits, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return u.tranRepo.FindLimits(ctx, accountID)
+}
+
+// SetTransferLimits replaces the account's override and returns the limits
+// now in effect.
+func (u *adminUsecase) SetTransferLimits(ctx context.Context, input *SetLimitsParams) (*transfer.Limits, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := input.validate(); err != nil {
+		return nil, err
+	}
+
+	acc, err := u.accRepo.FindByID(ctx, input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	// Only customer accounts send transfers
+	if acc.Type != account.TypeCustomer {
+		return nil, errs.ErrAccountNotFound
+	}
+
+	err = u.tranRepo.SetLimitOverride(ctx, &transfer.LimitOverride{
+		AccountID:   acc.ID,
+		MaxAmount:   input.MaxAmount,
+		DailyAmount: input.DailyAmount,
+		DailyCount:  input.DailyCount,
+		UpdatedBy:   auth.GetUserID(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u.tranRepo.FindLimits(ctx, acc.ID)
+}
+
+// SetOverdraftLimit changes a customer account's overdraft limit. Closed
+// accounts can't be given one.
+func (u *adminUsecase) SetOverdraftLimit(ctx context.Context, input *SetOverdraftParams) (*account.Account, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := input.validate(); err != nil {
+		return nil, err
+	}
+
+	acc, err := u.accRepo.FindByID(ctx, input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	// System accounts have no floor to move
+	if acc.Type != account.TypeCustomer {
+		return nil, errs.ErrAccountNotFound
+	}
+	if acc.Status == account.StatusClosed {
+		return nil, errs.ErrAccountClosed
+	}
+
+	updated, err := u.accRepo.SetOverdraftLimit(ctx, acc.ID, input.Limit)
+	if err != nil {
+		return nil, err
+	}
+	u.display(updated)
+	return updated, nil
+}
+
+// display fills in the formatted balance of each account.
+func (u *adminUsecase) display(accounts ...*account.Account) {
+	for _, a := range accounts {
+		a.BalanceDisplay = u.currencies.Format(a.Balance, string(a.Currency))
+	}
+}
+
+func (u *adminUsecase) ListEntries(ctx context.Context, input *ListEntriesParams) ([]*entry.Entry, *pagination.Meta, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	if err := requireAdmin(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	if err := input.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := u.accRepo.FindByID(ctx, input.AccountID); err != nil {
+		return nil, nil, err
+	}
+
+	entries, err := u.entRepo.List(ctx, &entry.Filter{
+		AccountID: input.AccountID,
+		Direction: input.Direction,
+		From:      input.From,
+		To:        input.To,
+		MinAmount: input.MinAmount,
+		MaxAmount: input.MaxAmount,
+		Page:      input.Page,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entries, meta := pagination.Trim(entries, input.Page, func(e *entry.Entry) pagination.Cursor {
+		return pagination.Cursor{CreatedAt: e.CreatedAt, ID: e.ID}
+	})
+	return entries, meta, nil
+}
+
+func (u *adminUsecase) GetEntry(ctx context.Context, id int64) (*entry.Entry, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return u.entRepo.FindByID(ctx, id)
+}
+
+func (u *adminUsecase) ListTransfers(ctx context.Context, input *ListTransfersParams) ([]*transfer.Transfer, *pagination.Meta, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	if err := requireAdmin(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	if err := input.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	// An account scope wins over the owner, as in transfer.Filter
+	if input.AccountID > 0 {
+		if _, err := u.accRepo.FindByID(ctx, input.AccountID); err != nil {
+			return nil, nil, err
+		}
+	} else if _, err := u.userRepo.FindByID(ctx, input.OwnerID); err != nil {
+		return nil, nil, err
+	}
+
+	transfers, err := u.tranRepo.List(ctx, &transfer.Filter{
+		OwnerID:   input.OwnerID,
+		AccountID: input.AccountID,
+		Direction: input.Direction,
+		From:      input.From,
+		To:        input.To,
+		MinAmount: input.MinAmount,
+		MaxAmount: input.MaxAmount,
+		Page:      input.Page,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transfers, meta := pagination.Trim(transfers, input.Page, func(t *transfer.Transfer) pagination.Cursor {
+		return pagination.Cursor{CreatedAt: t.CreatedAt, ID: t.ID}
+	})
+	return transfers, meta, nil
+}
+
+func (u *adminUsecase) GetTransfer(ctx context.Context, id int64) (*transfer.Transfer, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return u.tranRepo.FindByID(ctx, id)
+}
@@ -0,0 +1,479 @@
+package adminusecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codepnw/simple-bank/internal/features/account"
+	accountrepository "github.com/codepnw/simple-bank/internal/features/account/repository"
+	adminusecase "github.com/codepnw/simple-bank/internal/features/admin/usecase"
+	entryrepository "github.com/codepnw/simple-bank/internal/features/entry/repository"
+	"github.com/codepnw/simple-bank/internal/features/transfer"
+	transferrepository "github.com/codepnw/simple-bank/internal/features/transfer/repository"
+	"github.com/codepnw/simple-bank/internal/features/user"
+	userrepository "github.com/codepnw/simple-bank/internal/features/user/repository"
+	"github.com/codepnw/simple-bank/internal/mocks"
+	"github.com/codepnw/simple-bank/pkg/auth"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/codepnw/simple-bank/pkg/utils/pagination"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockRepos struct {
+	user     *userrepository.MockUserRepository
+	account  *accountrepository.MockAccountRepository
+	entry    *entryrepository.MockEntryRepository
+	transfer *transferrepository.MockTransferRepository
+}
+
+func TestGetAccount(t *testing.T) {
+	type testCase struct {
+		name        string
+		userID      int64
+		role        user.Role
+		accountID   int64
+		mockFn      func(repos *mockRepos, accountID int64)
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:      "success other owner's account",
+			userID:    1,
+			role:      user.RoleAdmin,
+			accountID: 10,
+			mockFn: func(repos *mockRepos, accountID int64) {
+				// Owned by user 10, not the admin
+				repos.account.EXPECT().FindByID(gomock.Any(), accountID).Return(mocks.MockAccountData(), nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "fail customer role",
+			userID:      10,
+			role:        user.RoleCustomer,
+			accountID:   10,
+			mockFn:      func(repos *mockRepos, accountID int64) {},
+			expectedErr: errs.ErrNoPermission,
+		},
+		{
+			name:        "fail no user id",
+			accountID:   10,
+			mockFn:      func(repos *mockRepos, accountID int64) {},
+			expectedErr: errs.ErrNoUserID,
+		},
+		{
+			name:      "fail not found",
+			userID:    1,
+			role:      user.RoleAdmin,
+			accountID: 99,
+			mockFn: func(repos *mockRepos, accountID int64) {
+				repos.account.EXPECT().FindByID(gomock.Any(), accountID).Return(nil, errs.ErrAccountNotFound).Times(1)
+			},
+			expectedErr: errs.ErrAccountNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, repos := setup(t)
+
+			tc.mockFn(repos, tc.accountID)
+
+			ctx := context.Background()
+			if tc.userID != 0 {
+				ctx = auth.SetUserID(ctx, tc.userID)
+				ctx = auth.SetRole(ctx, tc.role)
+			}
+
+			result, err := uc.GetAccount(ctx, tc.accountID)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, result.BalanceDisplay)
+			}
+		})
+	}
+}
+
+func TestFindUserByEmail(t *testing.T) {
+	type testCase struct {
+		name        string
+		email       string
+		mockFn      func(repos *mockRepos, email string)
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:  "success",
+			email: "mock@example.com",
+			mockFn: func(repos *mockRepos, email string) {
+				u := mocks.MockUserData()
+				repos.user.EXPECT().FindByEmail(gomock.Any(), email).Return(&user.User{ID: u.ID, Email: email}, nil).Times(1)
+
+				repos.user.EXPECT().FindByID(gomock.Any(), u.ID).Return(u, nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:  "fail not found",
+			email: "nobody@example.com",
+			mockFn: func(repos *mockRepos, email string) {
+				repos.user.EXPECT().FindByEmail(gomock.Any(), email).Return(nil, errs.ErrUserNotFound).Times(1)
+			},
+			expectedErr: errs.ErrUserNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, repos := setup(t)
+
+			tc.mockFn(repos, tc.email)
+
+			result, err := uc.FindUserByEmail(adminContext(), tc.email)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, mocks.MockUserData().Username, result.Username)
+			}
+		})
+	}
+}
+
+func TestListTransfers(t *testing.T) {
+	type testCase struct {
+		name        string
+		input       *adminusecase.ListTransfersParams
+		mockFn      func(repos *mockRepos, input *adminusecase.ListTransfersParams)
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:  "success by owner",
+			input: &adminusecase.ListTransfersParams{OwnerID: 10, Page: &pagination.Page{Limit: 5}},
+			mockFn: func(repos *mockRepos, input *adminusecase.ListTransfersParams) {
+				repos.user.EXPECT().FindByID(gomock.Any(), input.OwnerID).Return(mocks.MockUserData(), nil).Times(1)
+
+				filter := &transfer.Filter{OwnerID: 10, Page: input.Page}
+				repos.transfer.EXPECT().List(gomock.Any(), filter).Return([]*transfer.Transfer{{ID: 1}}, nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:  "success by account",
+			input: &adminusecase.ListTransfersParams{AccountID: 10, Page: &pagination.Page{Limit: 5}},
+			mockFn: func(repos *mockRepos, input *adminusecase.ListTransfersParams) {
+				repos.account.EXPECT().FindByID(gomock.Any(), input.AccountID).Return(mocks.MockAccountData(), nil).Times(1)
+
+				filter := &transfer.Filter{AccountID: 10, Page: input.Page}
+				repos.transfer.EXPECT().List(gomock.Any(), filter).Return([]*transfer.Transfer{{ID: 1}}, nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "fail no scope",
+			input:       &adminusecase.ListTransfersParams{Page: &pagination.Page{Limit: 5}},
+			mockFn:      func(repos *mockRepos, input *adminusecase.ListTransfersParams) {},
+			expectedErr: errs.ErrFilterScopeRequired,
+		},
+		{
+			name:  "fail user not found",
+			input: &adminusecase.ListTransfersParams{OwnerID: 99, Page: &pagination.Page{Limit: 5}},
+			mockFn: func(repos *mockRepos, input *adminusecase.ListTransfersParams) {
+				repos.user.EXPECT().FindByID(gomock.Any(), input.OwnerID).Return(nil, errs.ErrUserNotFound).Times(1)
+			},
+			expectedErr: errs.ErrUserNotFound,
+		},
+		{
+			name:  "fail db error",
+			input: &adminusecase.ListTransfersParams{AccountID: 10, Page: &pagination.Page{Limit: 5}},
+			mockFn: func(repos *mockRepos, input *adminusecase.ListTransfersParams) {
+				repos.account.EXPECT().FindByID(gomock.Any(), input.AccountID).Return(mocks.MockAccountData(), nil).Times(1)
+
+				repos.transfer.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, mocks.ErrDatabase).Times(1)
+			},
+			expectedErr: mocks.ErrDatabase,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, repos := setup(t)
+
+			tc.mockFn(repos, tc.input)
+
+			result, _, err := uc.ListTransfers(adminContext(), tc.input)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, result)
+			}
+		})
+	}
+}
+
+func TestChangeAccountStatus(t *testing.T) {
+	type testCase struct {
+		name        string
+		input       *adminusecase.ChangeStatusParams
+		mockFn      func(repos *mockRepos, input *adminusecase.ChangeStatusParams)
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:  "success freeze",
+			input: &adminusecase.ChangeStatusParams{AccountID: 10, Status: account.StatusFrozen, Reason: "suspected fraud"},
+			mockFn: func(repos *mockRepos, input *adminusecase.ChangeStatusParams) {
+				acc := mocks.MockAccountData()
+				acc.Status = account.StatusActive
+				repos.account.EXPECT().FindByIDForUpdate(gomock.Any(), gomock.Any(), input.AccountID).Return(acc, nil).Times(1)
+
+				frozen := mocks.MockAccountData()
+				frozen.Status = account.StatusFrozen
+				repos.account.EXPECT().UpdateStatus(gomock.Any(), gomock.Any(), acc.ID, account.StatusFrozen).Return(frozen, nil).Times(1)
+
+				change := &account.StatusChange{
+					AccountID: acc.ID,
+					From:      account.StatusActive,
+					To:        account.StatusFrozen,
+					Reason:    input.Reason,
+					ChangedBy: 1,
+				}
+				repos.account.EXPECT().InsertStatusChange(gomock.Any(), gomock.Any(), change).Return(nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:  "fail close with balance",
+			input: &adminusecase.ChangeStatusParams{AccountID: 10, Status: account.StatusClosed, Reason: "customer request"},
+			mockFn: func(repos *mockRepos, input *adminusecase.ChangeStatusParams) {
+				acc := mocks.MockAccountData()
+				acc.Status = account.StatusActive
+				repos.account.EXPECT().FindByIDForUpdate(gomock.Any(), gomock.Any(), input.AccountID).Return(acc, nil).Times(1)
+			},
+			expectedErr: errs.ErrBalanceNotZero,
+		},
+		{
+			name:  "fail close with open holds",
+			input: &adminusecase.ChangeStatusParams{AccountID: 10, Status: account.StatusClosed, Reason: "customer request"},
+			mockFn: func(repos *mockRepos, input *adminusecase.ChangeStatusParams) {
+				acc := mocks.MockAccountData()
+				acc.Status = account.StatusActive
+				acc.Balance, acc.HeldAmount, acc.OverdraftLimit = 0, 300, 500
+				repos.account.EXPECT().FindByIDForUpdate(gomock.Any(), gomock.Any(), input.AccountID).Return(acc, nil).Times(1)
+			},
+			expectedErr: errs.ErrAccountHasHolds,
+		},
+		{
+			name:  "fail reopen closed",
+			input: &adminusecase.ChangeStatusParams{AccountID: 10, Status: account.StatusActive, Reason: "reopen"},
+			mockFn: func(repos *mockRepos, input *adminusecase.ChangeStatusParams) {
+				acc := mocks.MockAccountData()
+				acc.Balance = 0
+				acc.Status = account.StatusClosed
+				repos.account.EXPECT().FindByIDForUpdate(gomock.Any(), gomock.Any(), input.AccountID).Return(acc, nil).Times(1)
+			},
+			expectedErr: errs.ErrStatusTransition,
+		},
+		{
+			name:  "fail system account",
+			input: &adminusecase.ChangeStatusParams{AccountID: 1, Status: account.StatusFrozen, Reason: "maintenance"},
+			mockFn: func(repos *mockRepos, input *adminusecase.ChangeStatusParams) {
+				cash := mocks.MockCashAccountData()
+				cash.Status = account.StatusActive
+				repos.account.EXPECT().FindByIDForUpdate(gomock.Any(), gomock.Any(), input.AccountID).Return(cash, nil).Times(1)
+			},
+			expectedErr: errs.ErrStatusTransition,
+		},
+		{
+			name:        "fail invalid status",
+			input:       &adminusecase.ChangeStatusParams{AccountID: 10, Status: "dormant", Reason: "idle"},
+			mockFn:      func(repos *mockRepos, input *adminusecase.ChangeStatusParams) {},
+			expectedErr: errs.ErrInvalidAccountStatus,
+		},
+		{
+			name:        "fail empty reason",
+			input:       &adminusecase.ChangeStatusParams{AccountID: 10, Status: account.StatusFrozen, Reason: "  "},
+			mockFn:      func(repos *mockRepos, input *adminusecase.ChangeStatusParams) {},
+			expectedErr: errs.ErrReasonRequired,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, repos := setup(t)
+
+			tc.mockFn(repos, tc.input)
+
+			result, err := uc.ChangeAccountStatus(adminContext(), tc.input)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.input.Status, result.Status)
+			}
+		})
+	}
+}
+
+func TestSetTransferLimits(t *testing.T) {
+	type testCase struct {
+		name        string
+		input       *adminusecase.SetLimitsParams
+		mockFn      func(repos *mockRepos, input *adminusecase.SetLimitsParams)
+		expectedErr error
+	}
+
+	dailyAmount := int64(50000)
+	zero := int64(0)
+
+	testCases := []testCase{
+		{
+			name:  "success",
+			input: &adminusecase.SetLimitsParams{AccountID: 10, DailyAmount: &dailyAmount},
+			mockFn: func(repos *mockRepos, input *adminusecase.SetLimitsParams) {
+				repos.account.EXPECT().FindByID(gomock.Any(), input.AccountID).Return(mocks.MockAccountData(), nil).Times(1)
+
+				override := &transfer.LimitOverride{AccountID: 10, DailyAmount: &dailyAmount, UpdatedBy: 1}
+				repos.transfer.EXPECT().SetLimitOverride(gomock.Any(), override).Return(nil).Times(1)
+
+				limits := mocks.MockLimitsData()
+				limits.DailyAmount = dailyAmount
+				repos.transfer.EXPECT().FindLimits(gomock.Any(), input.AccountID).Return(limits, nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "fail invalid limit",
+			input:       &adminusecase.SetLimitsParams{AccountID: 10, MaxAmount: &zero},
+			mockFn:      func(repos *mockRepos, input *adminusecase.SetLimitsParams) {},
+			expectedErr: errs.ErrInvalidTransferLimit,
+		},
+		{
+			name:  "fail system account",
+			input: &adminusecase.SetLimitsParams{AccountID: 1, DailyAmount: &dailyAmount},
+			mockFn: func(repos *mockRepos, input *adminusecase.SetLimitsParams) {
+				repos.account.EXPECT().FindByID(gomock.Any(), input.AccountID).Return(mocks.MockCashAccountData(), nil).Times(1)
+			},
+			expectedErr: errs.ErrAccountNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, repos := setup(t)
+
+			tc.mockFn(repos, tc.input)
+
+			result, err := uc.SetTransferLimits(adminContext(), tc.input)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, dailyAmount, result.DailyAmount)
+			}
+		})
+	}
+}
+
+func TestSetOverdraftLimit(t *testing.T) {
+	type testCase struct {
+		name        string
+		input       *adminusecase.SetOverdraftParams
+		mockFn      func(repos *mockRepos, input *adminusecase.SetOverdraftParams)
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:  "success",
+			input: &adminusecase.SetOverdraftParams{AccountID: 10, Limit: 500},
+			mockFn: func(repos *mockRepos, input *adminusecase.SetOverdraftParams) {
+				repos.account.EXPECT().FindByID(gomock.Any(), input.AccountID).Return(mocks.MockAccountData(), nil).Times(1)
+
+				updated := mocks.MockAccountData()
+				updated.OverdraftLimit = input.Limit
+				updated.AvailableBalance += input.Limit
+				repos.account.EXPECT().SetOverdraftLimit(gomock.Any(), input.AccountID, input.Limit).Return(updated, nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "fail negative limit",
+			input:       &adminusecase.SetOverdraftParams{AccountID: 10, Limit: -1},
+			mockFn:      func(repos *mockRepos, input *adminusecase.SetOverdraftParams) {},
+			expectedErr: errs.ErrInvalidOverdraftLimit,
+		},
+		{
+			name:  "fail system account",
+			input: &adminusecase.SetOverdraftParams{AccountID: 1, Limit: 500},
+			mockFn: func(repos *mockRepos, input *adminusecase.SetOverdraftParams) {
+				repos.account.EXPECT().FindByID(gomock.Any(), input.AccountID).Return(mocks.MockCashAccountData(), nil).Times(1)
+			},
+			expectedErr: errs.ErrAccountNotFound,
+		},
+		{
+			name:  "fail account closed",
+			input: &adminusecase.SetOverdraftParams{AccountID: 10, Limit: 500},
+			mockFn: func(repos *mockRepos, input *adminusecase.SetOverdraftParams) {
+				acc := mocks.MockAccountData()
+				acc.Status = account.StatusClosed
+				repos.account.EXPECT().FindByID(gomock.Any(), input.AccountID).Return(acc, nil).Times(1)
+			},
+			expectedErr: errs.ErrAccountClosed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, repos := setup(t)
+
+			tc.mockFn(repos, tc.input)
+
+			result, err := uc.SetOverdraftLimit(adminContext(), tc.input)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.input.Limit, result.OverdraftLimit)
+				assert.Equal(t, int64(1500), result.AvailableBalance)
+			}
+		})
+	}
+}
+
+func adminContext() context.Context {
+	ctx := auth.SetUserID(context.Background(), int64(1))
+	return auth.SetRole(ctx, user.RoleAdmin)
+}
+
+func setup(t *testing.T) (adminusecase.AdminUsecase, *mockRepos) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repos := &mockRepos{
+		user:     userrepository.NewMockUserRepository(ctrl),
+		account:  accountrepository.NewMockAccountRepository(ctrl),
+		entry:    entryrepository.NewMockEntryRepository(ctrl),
+		transfer: transferrepository.NewMockTransferRepository(ctrl),
+	}
+	uc := adminusecase.NewAdminUsecase(repos.user, repos.account, repos.entry, repos.transfer, &mocks.MockTx{}, mocks.MockCurrencies())
+
+	return uc, repos
+}
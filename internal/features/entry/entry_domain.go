@@ -1,10 +1,27 @@
 package entry
 
-import "time"
+import (
+	"time"
+
+	"github.com/codepnw/simple-bank/pkg/utils/pagination"
+)
 
 type Entry struct {
 	ID        int64     `json:"id"`
+	JournalID int64     `json:"journal_id"`
 	AccountID int64     `json:"account_id"`
 	Amount    int64     `json:"amount"`
 	CreatedAt time.Time `json:"created_at"`
 }
+
+// Filter selects entries of one account. Direction "incoming" keeps credits,
+// "outgoing" keeps debits; amount bounds apply to the absolute amount.
+type Filter struct {
+	AccountID int64
+	Direction string
+	From      *time.Time
+	To        *time.Time
+	MinAmount int64
+	MaxAmount int64
+	Page      *pagination.Page
+}
@@ -3,12 +3,24 @@ package entryrepository
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/codepnw/simple-bank/internal/consts"
 	"github.com/codepnw/simple-bank/internal/features/entry"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
 )
 
 //go:generate mockgen -source=entry_repository.go -destination=mock_entry_repository.go -package=entryrepository
 type EntryRepository interface {
+	FindByID(ctx context.Context, id int64) (*entry.Entry, error)
+	List(ctx context.Context, filter *entry.Filter) ([]*entry.Entry, error)
+	BalanceAt(ctx context.Context, accountID int64, at time.Time) (int64, error)
+	Stream(ctx context.Context, accountID int64, from, to time.Time, fn func(e *entry.Entry) error) error
+
+	// Transaction
 	Insert(ctx context.Context, tx *sql.Tx, input *entry.Entry) (*entry.Entry, error)
 }
 
@@ -22,10 +34,10 @@ func NewEntryRepository(db *sql.DB) EntryRepository {
 
 func (r *entryRepository) Insert(ctx context.Context, tx *sql.Tx, input *entry.Entry) (*entry.Entry, error) {
 	query := `
-		INSERT INTO entries (account_id, amount)
-		VALUES ($1, $2) RETURNING id, created_at
+		INSERT INTO entries (journal_id, account_id, amount)
+		VALUES ($1, $2, $3) RETURNING id, created_at
 	`
-	err := tx.QueryRowContext(ctx, query, input.AccountID, input.Amount).Scan(
+	err := tx.QueryRowContext(ctx, query, input.JournalID, input.AccountID, input.Amount).Scan(
 		&input.ID,
 		&input.CreatedAt,
 	)
@@ -34,3 +46,149 @@ func (r *entryRepository) Insert(ctx context.Context, tx *sql.Tx, input *entry.E
 	}
 	return input, nil
 }
+
+func (r *entryRepository) FindByID(ctx context.Context, id int64) (*entry.Entry, error) {
+	query := `
+		SELECT id, journal_id, account_id, amount, created_at
+		FROM entries WHERE id = $1 LIMIT 1
+	`
+	e := new(entry.Entry)
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&e.ID,
+		&e.JournalID,
+		&e.AccountID,
+		&e.Amount,
+		&e.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrEntryNotFound
+		}
+		return nil, err
+	}
+	return e, nil
+}
+
+func (r *entryRepository) List(ctx context.Context, filter *entry.Filter) ([]*entry.Entry, error) {
+	var (
+		conds []string
+		args  []any
+	)
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	conds = append(conds, "account_id = "+arg(filter.AccountID))
+
+	switch filter.Direction {
+	case consts.DirectionIncoming:
+		conds = append(conds, "amount > 0")
+	case consts.DirectionOutgoing:
+		conds = append(conds, "amount < 0")
+	}
+	if filter.From != nil {
+		conds = append(conds, "created_at >= "+arg(*filter.From))
+	}
+	if filter.To != nil {
+		conds = append(conds, "created_at <= "+arg(*filter.To))
+	}
+	if filter.MinAmount > 0 {
+		conds = append(conds, "ABS(amount) >= "+arg(filter.MinAmount))
+	}
+	if filter.MaxAmount > 0 {
+		conds = append(conds, "ABS(amount) <= "+arg(filter.MaxAmount))
+	}
+	// Keyset: rows strictly after the cursor in (created_at DESC, id DESC)
+	if filter.Page.After != nil {
+		conds = append(conds, "(created_at, id) < ("+arg(filter.Page.After.CreatedAt)+", "+arg(filter.Page.After.ID)+")")
+	}
+
+	query := `
+		SELECT id, journal_id, account_id, amount, created_at
+		FROM entries WHERE ` + strings.Join(conds, " AND ") + `
+		ORDER BY created_at DESC, id DESC
+		LIMIT ` + arg(filter.Page.FetchLimit()) + ` OFFSET ` + arg(filter.Page.Offset)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]*entry.Entry, 0)
+
+	for rows.Next() {
+		e := new(entry.Entry)
+		if err = rows.Scan(
+			&e.ID,
+			&e.JournalID,
+			&e.AccountID,
+			&e.Amount,
+			&e.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// BalanceAt is the balance just before at. Seeded balances have no entries, so
+// it is derived backwards from the current one; a single statement reads the
+// balance and the entries from the same snapshot, so a concurrent posting
+// can't land in one and not the other.
+func (r *entryRepository) BalanceAt(ctx context.Context, accountID int64, at time.Time) (int64, error) {
+	query := `
+		SELECT a.balance - COALESCE((
+			SELECT SUM(e.amount) FROM entries e
+			WHERE e.account_id = a.id AND e.created_at >= $2
+		), 0)
+		FROM accounts a WHERE a.id = $1
+	`
+	var balance int64
+	err := r.db.QueryRowContext(ctx, query, accountID, at).Scan(&balance)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, errs.ErrAccountNotFound
+		}
+		return 0, err
+	}
+	return balance, nil
+}
+
+// Stream walks the entries of an account in posting order without loading
+// them all into memory. Returning an error from fn stops the walk.
+func (r *entryRepository) Stream(ctx context.Context, accountID int64, from, to time.Time, fn func(e *entry.Entry) error) error {
+	query := `
+		SELECT id, journal_id, account_id, amount, created_at
+		FROM entries WHERE account_id = $1 AND created_at >= $2 AND created_at <= $3
+		ORDER BY created_at, id
+	`
+	rows, err := r.db.QueryContext(ctx, query, accountID, from, to)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		e := new(entry.Entry)
+		if err = rows.Scan(
+			&e.ID,
+			&e.JournalID,
+			&e.AccountID,
+			&e.Amount,
+			&e.CreatedAt,
+		); err != nil {
+			return err
+		}
+		if err = fn(e); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
@@ -8,6 +8,7 @@ import (
 	context "context"
 	sql "database/sql"
 	reflect "reflect"
+	time "time"
 
 	entry "github.com/codepnw/simple-bank/internal/features/entry"
 	gomock "github.com/golang/mock/gomock"
@@ -36,6 +37,36 @@ func (m *MockEntryRepository) EXPECT() *MockEntryRepositoryMockRecorder {
 	return m.recorder
 }
 
+// BalanceAt mocks base method.
+func (m *MockEntryRepository) BalanceAt(ctx context.Context, accountID int64, at time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalanceAt", ctx, accountID, at)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalanceAt indicates an expected call of BalanceAt.
+func (mr *MockEntryRepositoryMockRecorder) BalanceAt(ctx, accountID, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalanceAt", reflect.TypeOf((*MockEntryRepository)(nil).BalanceAt), ctx, accountID, at)
+}
+
+// FindByID mocks base method.
+func (m *MockEntryRepository) FindByID(ctx context.Context, id int64) (*entry.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*entry.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockEntryRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockEntryRepository)(nil).FindByID), ctx, id)
+}
+
 // Insert mocks base method.
 func (m *MockEntryRepository) Insert(ctx context.Context, tx *sql.Tx, input *entry.Entry) (*entry.Entry, error) {
 	m.ctrl.T.Helper()
@@ -50,3 +81,32 @@ func (mr *MockEntryRepositoryMockRecorder) Insert(ctx, tx, input interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockEntryRepository)(nil).Insert), ctx, tx, input)
 }
+
+// List mocks base method.
+func (m *MockEntryRepository) List(ctx context.Context, filter *entry.Filter) ([]*entry.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter)
+	ret0, _ := ret[0].([]*entry.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockEntryRepositoryMockRecorder) List(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockEntryRepository)(nil).List), ctx, filter)
+}
+
+// Stream mocks base method.
+func (m *MockEntryRepository) Stream(ctx context.Context, accountID int64, from, to time.Time, fn func(*entry.Entry) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stream", ctx, accountID, from, to, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stream indicates an expected call of Stream.
+func (mr *MockEntryRepositoryMockRecorder) Stream(ctx, accountID, from, to, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stream", reflect.TypeOf((*MockEntryRepository)(nil).Stream), ctx, accountID, from, to, fn)
+}
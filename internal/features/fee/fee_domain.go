@@ -0,0 +1,67 @@
+package fee
+
+import "math/big"
+
+// Operation is what a fee is charged on.
+type Operation string
+
+const (
+	OpTransfer   Operation = "transfer"
+	OpWithdrawal Operation = "withdrawal"
+)
+
+// Rule prices one tier of an operation in one currency, in its minor units.
+// The tiers of a schedule are rules with increasing MinAmount; an amount is
+// priced by the highest tier it reaches. A fee is Flat plus RateBps of the
+// amount, then raised to MinFee and capped at MaxFee. Zero means none.
+type Rule struct {
+	ID        int64     `json:"id"`
+	Operation Operation `json:"operation"`
+	Currency  string    `json:"currency"`
+	MinAmount int64     `json:"min_amount"`
+	Flat      int64     `json:"flat"`
+	RateBps   int64     `json:"rate_bps"` // 1 bps = 0.01%
+	MinFee    int64     `json:"min_fee"`
+	MaxFee    int64     `json:"max_fee"`
+}
+
+// Breakdown is how a fee was made up: Flat + Percentage + Adjustment equals
+// Total. Adjustment is positive when the minimum applied and negative when
+// the cap did.
+type Breakdown struct {
+	RuleID     int64  `json:"rule_id,omitempty"`
+	Currency   string `json:"currency"`
+	Flat       int64  `json:"flat"`
+	Percentage int64  `json:"percentage"`
+	Adjustment int64  `json:"adjustment"`
+	Total      int64  `json:"total"`
+}
+
+// Apply prices amount. The percentage is rounded half up to the minor unit,
+// so 0.5 of a satang is charged as one.
+func (r *Rule) Apply(amount int64) *Breakdown {
+	b := &Breakdown{
+		RuleID:     r.ID,
+		Currency:   r.Currency,
+		Flat:       r.Flat,
+		Percentage: percentage(amount, r.RateBps),
+	}
+	total := b.Flat + b.Percentage
+	if r.MinFee > 0 && total < r.MinFee {
+		b.Adjustment = r.MinFee - total
+	}
+	if r.MaxFee > 0 && total > r.MaxFee {
+		b.Adjustment = r.MaxFee - total
+	}
+	b.Total = total + b.Adjustment
+	return b
+}
+
+func percentage(amount, bps int64) int64 {
+	if bps == 0 {
+		return 0
+	}
+	n := new(big.Int).Mul(big.NewInt(amount), big.NewInt(bps))
+	n.Add(n, big.NewInt(5000))
+	return n.Quo(n, big.NewInt(10000)).Int64()
+}
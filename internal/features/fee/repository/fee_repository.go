@@ -0,0 +1,51 @@
+package feerepository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/codepnw/simple-bank/internal/features/fee"
+)
+
+//go:generate mockgen -source=fee_repository.go -destination=mock_fee_repository.go -package=feerepository
+type FeeRepository interface {
+	FindRule(ctx context.Context, op fee.Operation, currency string, amount int64) (*fee.Rule, error)
+}
+
+type feeRepository struct {
+	db *sql.DB
+}
+
+func NewFeeRepository(db *sql.DB) FeeRepository {
+	return &feeRepository{db: db}
+}
+
+// FindRule returns the tier that prices amount. Without one the operation
+// is free, which is returned as an empty rule.
+func (r *feeRepository) FindRule(ctx context.Context, op fee.Operation, currency string, amount int64) (*fee.Rule, error) {
+	query := `
+		SELECT id, operation, currency, min_amount, flat, rate_bps, min_fee, max_fee
+		FROM fee_rules
+		WHERE operation = $1 AND currency = $2 AND min_amount <= $3
+		ORDER BY min_amount DESC LIMIT 1
+	`
+	rule := new(fee.Rule)
+	err := r.db.QueryRowContext(ctx, query, op, currency, amount).Scan(
+		&rule.ID,
+		&rule.Operation,
+		&rule.Currency,
+		&rule.MinAmount,
+		&rule.Flat,
+		&rule.RateBps,
+		&rule.MinFee,
+		&rule.MaxFee,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return &fee.Rule{Operation: op, Currency: currency}, nil
+		}
+		return nil, err
+	}
+	return rule, nil
+}
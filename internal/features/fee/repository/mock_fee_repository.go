@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: fee_repository.go
+
+// Package feerepository is a generated GoMock package.
+package feerepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	fee "github.com/codepnw/simple-bank/internal/features/fee"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockFeeRepository is a mock of FeeRepository interface.
+type MockFeeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeeRepositoryMockRecorder
+}
+
+// MockFeeRepositoryMockRecorder is the mock recorder for MockFeeRepository.
+type MockFeeRepositoryMockRecorder struct {
+	mock *MockFeeRepository
+}
+
+// NewMockFeeRepository creates a new mock instance.
+func NewMockFeeRepository(ctrl *gomock.Controller) *MockFeeRepository {
+	mock := &MockFeeRepository{ctrl: ctrl}
+	mock.recorder = &MockFeeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeeRepository) EXPECT() *MockFeeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindRule mocks base method.
+func (m *MockFeeRepository) FindRule(ctx context.Context, op fee.Operation, currency string, amount int64) (*fee.Rule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRule", ctx, op, currency, amount)
+	ret0, _ := ret[0].(*fee.Rule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRule indicates an expected call of FindRule.
+func (mr *MockFeeRepositoryMockRecorder) FindRule(ctx, op, currency, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRule", reflect.TypeOf((*MockFeeRepository)(nil).FindRule), ctx, op, currency, amount)
+}
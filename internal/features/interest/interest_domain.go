@@ -0,0 +1,72 @@
+package interest
+
+import (
+	"math/big"
+	"time"
+)
+
+const (
+	// DaysPerYear is the day count: a day earns 1/365 of the annual rate,
+	// leap years included (Actual/365 Fixed).
+	DaysPerYear = 365
+	// MicrosPerUnit is how many accrual units make one minor unit.
+	MicrosPerUnit = 1_000_000
+)
+
+// Balance is an account due an accrual: its balance at the end of the day
+// and the rate it earns.
+type Balance struct {
+	AccountID int64
+	Currency  string
+	Balance   int64
+	RateBps   int64
+}
+
+// Accrual is the interest one account earned on one day, in millionths of
+// a minor unit so a day's share of small balances isn't lost to rounding.
+type Accrual struct {
+	AccountID    int64      `json:"account_id"`
+	Date         time.Time  `json:"accrual_date"`
+	Balance      int64      `json:"balance"`
+	RateBps      int64      `json:"rate_bps"`
+	AmountMicros int64      `json:"amount_micros"`
+	PostedAt     *time.Time `json:"posted_at,omitempty"`
+	JournalID    *int64     `json:"journal_id,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// Posting is an account whose unposted accruals are due to be paid.
+type Posting struct {
+	AccountID int64
+	Currency  string
+}
+
+// Day truncates t to the start of its UTC day, the unit of accrual.
+func Day(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
+
+// MonthStart is the first day of t's UTC month.
+func MonthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// Daily is one day's interest on balance, in millionths of a minor unit:
+// balance × rate / 365, rounded down. Balances at or below zero earn
+// nothing.
+func Daily(balance, rateBps int64) int64 {
+	if balance <= 0 || rateBps <= 0 {
+		return 0
+	}
+	// balance × bps / 10_000 / 365 × 1_000_000 = balance × bps × 100 / 365
+	n := new(big.Int).Mul(big.NewInt(balance), big.NewInt(rateBps))
+	n.Mul(n, big.NewInt(MicrosPerUnit/10_000))
+	return n.Quo(n, big.NewInt(DaysPerYear)).Int64()
+}
+
+// Round converts accrued micros to minor units, rounding half up. A total
+// under half a unit rounds to zero and stays accrued for the next posting.
+func Round(micros int64) int64 {
+	return (micros + MicrosPerUnit/2) / MicrosPerUnit
+}
@@ -0,0 +1,157 @@
+package interestrepository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/codepnw/simple-bank/internal/features/interest"
+)
+
+//go:generate mockgen -source=interest_repository.go -destination=mock_interest_repository.go -package=interestrepository
+type InterestRepository interface {
+	ListUnaccrued(ctx context.Context, day time.Time, limit int) ([]*interest.Balance, error)
+	InsertAccrual(ctx context.Context, input *interest.Accrual) error
+
+	// Transaction
+	ClaimPostings(ctx context.Context, tx *sql.Tx, before time.Time, minMicros int64, limit int) ([]*interest.Posting, error)
+	TakeAccruals(ctx context.Context, tx *sql.Tx, accountID int64, before time.Time) (int64, error)
+	SetJournal(ctx context.Context, tx *sql.Tx, accountID, journalID int64) error
+}
+
+type interestRepository struct {
+	db *sql.DB
+}
+
+func NewInterestRepository(db *sql.DB) InterestRepository {
+	return &interestRepository{db: db}
+}
+
+// ListUnaccrued returns open accounts that earn interest and have no accrual
+// for day, with their balance at the end of it: today's balance less every
+// entry made since. Days are passed as YYYY-MM-DD here and below, so the
+// DATE columns never go through the session time zone.
+func (r *interestRepository) ListUnaccrued(ctx context.Context, day time.Time, limit int) ([]*interest.Balance, error) {
+	query := `
+		SELECT a.id, a.currency,
+			a.balance - COALESCE((
+				SELECT SUM(e.amount) FROM entries e
+				WHERE e.account_id = a.id AND e.created_at >= $2
+			), 0),
+			r.rate_bps
+		FROM accounts a
+		JOIN interest_rates r ON r.product = a.product AND r.currency = a.currency
+		WHERE a.type = 'customer' AND a.status <> 'closed' AND r.rate_bps > 0
+			AND a.created_at < $2
+			AND NOT EXISTS (
+				SELECT 1 FROM interest_accruals i
+				WHERE i.account_id = a.id AND i.accrual_date = $1
+			)
+		ORDER BY a.id LIMIT $3
+	`
+	rows, err := r.db.QueryContext(ctx, query, day.Format(time.DateOnly), day.AddDate(0, 0, 1), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	balances := make([]*interest.Balance, 0)
+
+	for rows.Next() {
+		b := new(interest.Balance)
+		if err := rows.Scan(&b.AccountID, &b.Currency, &b.Balance, &b.RateBps); err != nil {
+			return nil, err
+		}
+		balances = append(balances, b)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return balances, nil
+}
+
+// InsertAccrual keeps the first accrual of an account for a day; a repeat is
+// ignored.
+func (r *interestRepository) InsertAccrual(ctx context.Context, input *interest.Accrual) error {
+	query := `
+		INSERT INTO interest_accruals (account_id, accrual_date, balance, rate_bps, amount_micros)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (account_id, accrual_date) DO NOTHING
+	`
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		input.AccountID,
+		input.Date.Format(time.DateOnly),
+		input.Balance,
+		input.RateBps,
+		input.AmountMicros,
+	)
+	return err
+}
+
+// ClaimPostings locks open accounts whose unposted accruals before `before`
+// add up to at least minMicros. Accounts locked by another transaction are
+// skipped and picked up on a later run.
+func (r *interestRepository) ClaimPostings(ctx context.Context, tx *sql.Tx, before time.Time, minMicros int64, limit int) ([]*interest.Posting, error) {
+	query := `
+		SELECT a.id, a.currency FROM accounts a
+		WHERE a.status <> 'closed' AND a.id IN (
+			SELECT account_id FROM interest_accruals
+			WHERE posted_at IS NULL AND accrual_date < $1
+			GROUP BY account_id HAVING SUM(amount_micros) >= $2
+		)
+		ORDER BY a.id LIMIT $3
+		FOR UPDATE SKIP LOCKED
+	`
+	rows, err := tx.QueryContext(ctx, query, before.Format(time.DateOnly), minMicros, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	postings := make([]*interest.Posting, 0)
+
+	for rows.Next() {
+		p := new(interest.Posting)
+		if err := rows.Scan(&p.AccountID, &p.Currency); err != nil {
+			return nil, err
+		}
+		postings = append(postings, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return postings, nil
+}
+
+// TakeAccruals marks the account's unposted accruals before `before` as
+// posted and returns their total in micros.
+func (r *interestRepository) TakeAccruals(ctx context.Context, tx *sql.Tx, accountID int64, before time.Time) (int64, error) {
+	query := `
+		WITH taken AS (
+			UPDATE interest_accruals SET posted_at = NOW()
+			WHERE account_id = $1 AND posted_at IS NULL AND accrual_date < $2
+			RETURNING amount_micros
+		)
+		SELECT COALESCE(SUM(amount_micros), 0) FROM taken
+	`
+	var total int64
+	if err := tx.QueryRowContext(ctx, query, accountID, before.Format(time.DateOnly)).Scan(&total); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// SetJournal links the accruals just taken from the account to the journal
+// that paid them.
+func (r *interestRepository) SetJournal(ctx context.Context, tx *sql.Tx, accountID, journalID int64) error {
+	query := `
+		UPDATE interest_accruals SET journal_id = $2
+		WHERE account_id = $1 AND posted_at IS NOT NULL AND journal_id IS NULL
+	`
+	_, err := tx.ExecContext(ctx, query, accountID, journalID)
+	return err
+}
@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interest_repository.go
+
+// Package interestrepository is a generated GoMock package.
+package interestrepository
+
+import (
+	context "context"
+	sql "database/sql"
+	reflect "reflect"
+	time "time"
+
+	interest "github.com/codepnw/simple-bank/internal/features/interest"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockInterestRepository is a mock of InterestRepository interface.
+type MockInterestRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockInterestRepositoryMockRecorder
+}
+
+// MockInterestRepositoryMockRecorder is the mock recorder for MockInterestRepository.
+type MockInterestRepositoryMockRecorder struct {
+	mock *MockInterestRepository
+}
+
+// NewMockInterestRepository creates a new mock instance.
+func NewMockInterestRepository(ctrl *gomock.Controller) *MockInterestRepository {
+	mock := &MockInterestRepository{ctrl: ctrl}
+	mock.recorder = &MockInterestRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInterestRepository) EXPECT() *MockInterestRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimPostings mocks base method.
+func (m *MockInterestRepository) ClaimPostings(ctx context.Context, tx *sql.Tx, before time.Time, minMicros int64, limit int) ([]*interest.Posting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimPostings", ctx, tx, before, minMicros, limit)
+	ret0, _ := ret[0].([]*interest.Posting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimPostings indicates an expected call of ClaimPostings.
+func (mr *MockInterestRepositoryMockRecorder) ClaimPostings(ctx, tx, before, minMicros, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimPostings", reflect.TypeOf((*MockInterestRepository)(nil).ClaimPostings), ctx, tx, before, minMicros, limit)
+}
+
+// InsertAccrual mocks base method.
+func (m *MockInterestRepository) InsertAccrual(ctx context.Context, input *interest.Accrual) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAccrual", ctx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAccrual indicates an expected call of InsertAccrual.
+func (mr *MockInterestRepositoryMockRecorder) InsertAccrual(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAccrual", reflect.TypeOf((*MockInterestRepository)(nil).InsertAccrual), ctx, input)
+}
+
+// ListUnaccrued mocks base method.
+func (m *MockInterestRepository) ListUnaccrued(ctx context.Context, day time.Time, limit int) ([]*interest.Balance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUnaccrued", ctx, day, limit)
+	ret0, _ := ret[0].([]*interest.Balance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUnaccrued indicates an expected call of ListUnaccrued.
+func (mr *MockInterestRepositoryMockRecorder) ListUnaccrued(ctx, day, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnaccrued", reflect.TypeOf((*MockInterestRepository)(nil).ListUnaccrued), ctx, day, limit)
+}
+
+// SetJournal mocks base method.
+func (m *MockInterestRepository) SetJournal(ctx context.Context, tx *sql.Tx, accountID, journalID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetJournal", ctx, tx, accountID, journalID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetJournal indicates an expected call of SetJournal.
+func (mr *MockInterestRepositoryMockRecorder) SetJournal(ctx, tx, accountID, journalID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetJournal", reflect.TypeOf((*MockInterestRepository)(nil).SetJournal), ctx, tx, accountID, journalID)
+}
+
+// TakeAccruals mocks base method.
+func (m *MockInterestRepository) TakeAccruals(ctx context.Context, tx *sql.Tx, accountID int64, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakeAccruals", ctx, tx, accountID, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TakeAccruals indicates an expected call of TakeAccruals.
+func (mr *MockInterestRepositoryMockRecorder) TakeAccruals(ctx, tx, accountID, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeAccruals", reflect.TypeOf((*MockInterestRepository)(nil).TakeAccruals), ctx, tx, accountID, before)
+}
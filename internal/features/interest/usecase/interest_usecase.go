@@ -0,0 +1,128 @@
+package interestusecase
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/codepnw/simple-bank/internal/features/account"
+	accountrepository "github.com/codepnw/simple-bank/internal/features/account/repository"
+	"github.com/codepnw/simple-bank/internal/features/interest"
+	interestrepository "github.com/codepnw/simple-bank/internal/features/interest/repository"
+	"github.com/codepnw/simple-bank/internal/features/ledger"
+	ledgerusecase "github.com/codepnw/simple-bank/internal/features/ledger/usecase"
+	"github.com/codepnw/simple-bank/pkg/database"
+)
+
+//go:generate mockgen -source=interest_usecase.go -destination=mock_interest_usecase.go -package=interestusecase
+type InterestUsecase interface {
+	// Accrue records day's interest for up to limit accounts that earn it
+	// and have no accrual for that day yet, so running a day twice is a
+	// no-op. It returns how many accounts it accrued.
+	Accrue(ctx context.Context, day time.Time, limit int) (int, error)
+	// Post pays up to limit accounts their unposted accruals dated before
+	// `before`, each as one interest journal from the house interest
+	// account. It returns how many accounts it claimed.
+	Post(ctx context.Context, before time.Time, limit int) (int, error)
+}
+
+type interestUsecase struct {
+	repo    interestrepository.InterestRepository
+	accRepo accountrepository.AccountRepository
+	ledger  ledgerusecase.LedgerUsecase
+	tx      database.TxManager
+}
+
+func NewInterestUsecase(
+	repo interestrepository.InterestRepository,
+	accRepo accountrepository.AccountRepository,
+	ledger ledgerusecase.LedgerUsecase,
+	tx database.TxManager,
+) InterestUsecase {
+	return &interestUsecase{
+		repo:    repo,
+		accRepo: accRepo,
+		ledger:  ledger,
+		tx:      tx,
+	}
+}
+
+// Accrue is a background job, so it runs without the request timeout. Each
+// accrual is its own insert; an account accrued concurrently by another
+// instance is kept as first recorded.
+func (u *interestUsecase) Accrue(ctx context.Context, day time.Time, limit int) (int, error) {
+	day = interest.Day(day)
+
+	balances, err := u.repo.ListUnaccrued(ctx, day, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	// Every account gets a row, even at zero, so it isn't listed again
+	for _, b := range balances {
+		err := u.repo.InsertAccrual(ctx, &interest.Accrual{
+			AccountID:    b.AccountID,
+			Date:         day,
+			Balance:      b.Balance,
+			RateBps:      b.RateBps,
+			AmountMicros: interest.Daily(b.Balance, b.RateBps),
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+	return len(balances), nil
+}
+
+// Post only claims accounts owed at least half a minor unit, which rounds to
+// one or more; smaller totals stay accrued and roll into a later posting.
+// Accruals of a closed account are never paid.
+func (u *interestUsecase) Post(ctx context.Context, before time.Time, limit int) (int, error) {
+	before = interest.Day(before)
+
+	var claimed int
+	err := u.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
+		due, err := u.repo.ClaimPostings(ctx, tx, before, interest.MicrosPerUnit/2, limit)
+		if err != nil {
+			return err
+		}
+		claimed = len(due)
+
+		houses := make(map[string]*account.Account)
+		for _, p := range due {
+			house, ok := houses[p.Currency]
+			if !ok {
+				house, err = u.accRepo.FindSystemAccount(ctx, account.TypeInterest, account.AccountCurrency(p.Currency))
+				if err != nil {
+					return err
+				}
+				houses[p.Currency] = house
+			}
+
+			micros, err := u.repo.TakeAccruals(ctx, tx, p.AccountID, before)
+			if err != nil {
+				return err
+			}
+			amount := interest.Round(micros)
+
+			posted, err := u.ledger.Post(ctx, tx, &ledgerusecase.PostParams{
+				Kind: ledger.KindInterest,
+				Lines: []ledger.Line{
+					{AccountID: house.ID, Currency: p.Currency, Amount: -amount},
+					{AccountID: p.AccountID, Currency: p.Currency, Amount: amount},
+				},
+			})
+			if err != nil {
+				return err
+			}
+			if err = u.repo.SetJournal(ctx, tx, p.AccountID, posted.Journal.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return claimed, nil
+}
@@ -0,0 +1,224 @@
+package interestusecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/codepnw/simple-bank/internal/features/account"
+	accountrepository "github.com/codepnw/simple-bank/internal/features/account/repository"
+	"github.com/codepnw/simple-bank/internal/features/interest"
+	interestrepository "github.com/codepnw/simple-bank/internal/features/interest/repository"
+	interestusecase "github.com/codepnw/simple-bank/internal/features/interest/usecase"
+	"github.com/codepnw/simple-bank/internal/features/ledger"
+	ledgerusecase "github.com/codepnw/simple-bank/internal/features/ledger/usecase"
+	"github.com/codepnw/simple-bank/internal/mocks"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// 2026-10-16, as of mid-afternoon UTC
+var (
+	accrualDay = time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+	runAt      = accrualDay.Add(15*time.Hour + 30*time.Minute)
+	monthStart = time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func TestAccrue(t *testing.T) {
+	type testCase struct {
+		name        string
+		mockFn      func(repo *interestrepository.MockInterestRepository)
+		accrued     int
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name: "success accrues end of day balances",
+			mockFn: func(repo *interestrepository.MockInterestRepository) {
+				repo.EXPECT().ListUnaccrued(gomock.Any(), accrualDay, 10).Return([]*interest.Balance{
+					{AccountID: 10, Currency: "THB", Balance: 1_000_000, RateBps: 150},
+					{AccountID: 11, Currency: "THB", Balance: -500, RateBps: 150},
+					{AccountID: 12, Currency: "USD", Balance: 1, RateBps: 100},
+				}, nil).Times(1)
+
+				gomock.InOrder(
+					// 10,000.00 at 1.50%: 41.0958904... satang, rounded down
+					repo.EXPECT().InsertAccrual(gomock.Any(), &interest.Accrual{AccountID: 10, Date: accrualDay, Balance: 1_000_000, RateBps: 150, AmountMicros: 41_095_890}).Return(nil).Times(1),
+					// Overdrawn: recorded, earns nothing
+					repo.EXPECT().InsertAccrual(gomock.Any(), &interest.Accrual{AccountID: 11, Date: accrualDay, Balance: -500, RateBps: 150, AmountMicros: 0}).Return(nil).Times(1),
+					// 0.01 at 1.00%: 27 millionths of a cent
+					repo.EXPECT().InsertAccrual(gomock.Any(), &interest.Accrual{AccountID: 12, Date: accrualDay, Balance: 1, RateBps: 100, AmountMicros: 27}).Return(nil).Times(1),
+				)
+			},
+			accrued:     3,
+			expectedErr: nil,
+		},
+		{
+			name: "success nothing to accrue",
+			mockFn: func(repo *interestrepository.MockInterestRepository) {
+				repo.EXPECT().ListUnaccrued(gomock.Any(), accrualDay, 10).Return([]*interest.Balance{}, nil).Times(1)
+			},
+			accrued:     0,
+			expectedErr: nil,
+		},
+		{
+			name: "fail list unaccrued",
+			mockFn: func(repo *interestrepository.MockInterestRepository) {
+				repo.EXPECT().ListUnaccrued(gomock.Any(), accrualDay, 10).Return(nil, mocks.ErrDatabase).Times(1)
+			},
+			expectedErr: mocks.ErrDatabase,
+		},
+		{
+			name: "fail insert accrual",
+			mockFn: func(repo *interestrepository.MockInterestRepository) {
+				repo.EXPECT().ListUnaccrued(gomock.Any(), accrualDay, 10).Return([]*interest.Balance{
+					{AccountID: 10, Currency: "THB", Balance: 1_000_000, RateBps: 150},
+				}, nil).Times(1)
+				repo.EXPECT().InsertAccrual(gomock.Any(), gomock.Any()).Return(mocks.ErrDatabase).Times(1)
+			},
+			expectedErr: mocks.ErrDatabase,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, repo, _, _ := setup(t)
+
+			tc.mockFn(repo)
+
+			n, err := uc.Accrue(context.Background(), runAt, 10)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.accrued, n)
+			}
+		})
+	}
+}
+
+func TestPost(t *testing.T) {
+	type testCase struct {
+		name        string
+		mockFn      func(repo *interestrepository.MockInterestRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase)
+		claimed     int
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name: "success pays from the house account of each currency",
+			mockFn: func(repo *interestrepository.MockInterestRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase) {
+				repo.EXPECT().ClaimPostings(gomock.Any(), gomock.Any(), monthStart, int64(interest.MicrosPerUnit/2), 10).Return([]*interest.Posting{
+					{AccountID: 10, Currency: "THB"},
+					{AccountID: 11, Currency: "THB"},
+					{AccountID: 12, Currency: "USD"},
+				}, nil).Times(1)
+
+				mockHouse(accRepo, "THB", 7)
+				mockHouse(accRepo, "USD", 8)
+
+				// Totals round half up to the minor unit
+				mockTake(repo, ledgerUC, 10, "THB", 7, 41_500_000, 42)
+				mockTake(repo, ledgerUC, 11, "THB", 7, 1_234_499_999, 1234)
+				mockTake(repo, ledgerUC, 12, "USD", 8, 500_000, 1)
+			},
+			claimed:     3,
+			expectedErr: nil,
+		},
+		{
+			name: "success nothing due",
+			mockFn: func(repo *interestrepository.MockInterestRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase) {
+				repo.EXPECT().ClaimPostings(gomock.Any(), gomock.Any(), monthStart, gomock.Any(), 10).Return([]*interest.Posting{}, nil).Times(1)
+			},
+			claimed:     0,
+			expectedErr: nil,
+		},
+		{
+			name: "fail claim postings",
+			mockFn: func(repo *interestrepository.MockInterestRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase) {
+				repo.EXPECT().ClaimPostings(gomock.Any(), gomock.Any(), monthStart, gomock.Any(), 10).Return(nil, mocks.ErrDatabase).Times(1)
+			},
+			expectedErr: mocks.ErrDatabase,
+		},
+		{
+			name: "fail house account not found",
+			mockFn: func(repo *interestrepository.MockInterestRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase) {
+				repo.EXPECT().ClaimPostings(gomock.Any(), gomock.Any(), monthStart, gomock.Any(), 10).Return([]*interest.Posting{
+					{AccountID: 10, Currency: "THB"},
+				}, nil).Times(1)
+				accRepo.EXPECT().FindSystemAccount(gomock.Any(), account.TypeInterest, account.AccountCurrency("THB")).Return(nil, errs.ErrSystemAccountNotFound).Times(1)
+			},
+			expectedErr: errs.ErrSystemAccountNotFound,
+		},
+		{
+			name: "fail post journal",
+			mockFn: func(repo *interestrepository.MockInterestRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase) {
+				repo.EXPECT().ClaimPostings(gomock.Any(), gomock.Any(), monthStart, gomock.Any(), 10).Return([]*interest.Posting{
+					{AccountID: 10, Currency: "THB"},
+				}, nil).Times(1)
+				mockHouse(accRepo, "THB", 7)
+				repo.EXPECT().TakeAccruals(gomock.Any(), gomock.Any(), int64(10), monthStart).Return(int64(41_500_000), nil).Times(1)
+				ledgerUC.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, mocks.ErrDatabase).Times(1)
+			},
+			expectedErr: mocks.ErrDatabase,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, repo, accRepo, ledgerUC := setup(t)
+
+			tc.mockFn(repo, accRepo, ledgerUC)
+
+			// Any time in the first day of the month posts everything before it
+			n, err := uc.Post(context.Background(), monthStart.Add(time.Hour), 10)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.claimed, n)
+			}
+		})
+	}
+}
+
+func mockHouse(accRepo *accountrepository.MockAccountRepository, currency string, id int64) {
+	house := mocks.MockCashAccountData()
+	house.ID, house.Type, house.Currency = id, account.TypeInterest, account.AccountCurrency(currency)
+	accRepo.EXPECT().FindSystemAccount(gomock.Any(), account.TypeInterest, house.Currency).Return(house, nil).Times(1)
+}
+
+// mockTake pays accountID the micros it had accrued, rounded to amount, out
+// of houseID.
+func mockTake(repo *interestrepository.MockInterestRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, accountID int64, currency string, houseID, micros, amount int64) {
+	repo.EXPECT().TakeAccruals(gomock.Any(), gomock.Any(), accountID, monthStart).Return(micros, nil).Times(1)
+
+	post := &ledgerusecase.PostParams{
+		Kind: ledger.KindInterest,
+		Lines: []ledger.Line{
+			{AccountID: houseID, Currency: currency, Amount: -amount},
+			{AccountID: accountID, Currency: currency, Amount: amount},
+		},
+	}
+	ledgerUC.EXPECT().Post(gomock.Any(), gomock.Any(), post).Return(mocks.MockPostResult(post, nil), nil).Times(1)
+	repo.EXPECT().SetJournal(gomock.Any(), gomock.Any(), accountID, int64(1)).Return(nil).Times(1)
+}
+
+func setup(t *testing.T) (interestusecase.InterestUsecase, *interestrepository.MockInterestRepository, *accountrepository.MockAccountRepository, *ledgerusecase.MockLedgerUsecase) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := interestrepository.NewMockInterestRepository(ctrl)
+	accRepo := accountrepository.NewMockAccountRepository(ctrl)
+	ledgerUC := ledgerusecase.NewMockLedgerUsecase(ctrl)
+
+	uc := interestusecase.NewInterestUsecase(repo, accRepo, ledgerUC, &mocks.MockTx{})
+	return uc, repo, accRepo, ledgerUC
+}
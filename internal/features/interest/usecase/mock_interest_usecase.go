@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interest_usecase.go
+
+// Package interestusecase is a generated GoMock package.
+package interestusecase
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockInterestUsecase is a mock of InterestUsecase interface.
+type MockInterestUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockInterestUsecaseMockRecorder
+}
+
+// MockInterestUsecaseMockRecorder is the mock recorder for MockInterestUsecase.
+type MockInterestUsecaseMockRecorder struct {
+	mock *MockInterestUsecase
+}
+
+// NewMockInterestUsecase creates a new mock instance.
+func NewMockInterestUsecase(ctrl *gomock.Controller) *MockInterestUsecase {
+	mock := &MockInterestUsecase{ctrl: ctrl}
+	mock.recorder = &MockInterestUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInterestUsecase) EXPECT() *MockInterestUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Accrue mocks base method.
+func (m *MockInterestUsecase) Accrue(ctx context.Context, day time.Time, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accrue", ctx, day, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Accrue indicates an expected call of Accrue.
+func (mr *MockInterestUsecaseMockRecorder) Accrue(ctx, day, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accrue", reflect.TypeOf((*MockInterestUsecase)(nil).Accrue), ctx, day, limit)
+}
+
+// Post mocks base method.
+func (m *MockInterestUsecase) Post(ctx context.Context, before time.Time, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Post", ctx, before, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Post indicates an expected call of Post.
+func (mr *MockInterestUsecaseMockRecorder) Post(ctx, before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Post", reflect.TypeOf((*MockInterestUsecase)(nil).Post), ctx, before, limit)
+}
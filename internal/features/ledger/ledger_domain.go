@@ -0,0 +1,61 @@
+package ledger
+
+import (
+	"time"
+
+	"github.com/codepnw/simple-bank/internal/features/entry"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+)
+
+// JournalKind is the business event a journal records.
+type JournalKind string
+
+const (
+	KindOpening    JournalKind = "opening"
+	KindTransfer   JournalKind = "transfer"
+	KindReversal   JournalKind = "reversal"
+	KindDeposit    JournalKind = "deposit"
+	KindWithdrawal JournalKind = "withdrawal"
+	KindCorrection JournalKind = "correction"
+	KindInterest   JournalKind = "interest"
+)
+
+// Journal is one balanced posting. Its entries sum to zero in each currency,
+// which the database checks again at commit.
+type Journal struct {
+	ID         int64          `json:"id"`
+	Kind       JournalKind    `json:"kind"`
+	TransferID *int64         `json:"transfer_id,omitempty"`
+	CreatedBy  *int64         `json:"created_by,omitempty"`
+	Entries    []*entry.Entry `json:"entries"`
+	CreatedAt  time.Time      `json:"created_at"`
+}
+
+// Line is one leg of a posting: Amount (+ credit, - debit) on AccountID,
+// in the account's currency.
+type Line struct {
+	AccountID int64
+	Currency  string
+	Amount    int64
+}
+
+// CheckBalanced returns ErrUnbalancedJournal unless lines has at least two
+// non-zero legs summing to zero in each currency.
+func CheckBalanced(lines []Line) error {
+	if len(lines) < 2 {
+		return errs.ErrUnbalancedJournal
+	}
+	sums := make(map[string]int64)
+	for _, l := range lines {
+		if l.Amount == 0 {
+			return errs.ErrUnbalancedJournal
+		}
+		sums[l.Currency] += l.Amount
+	}
+	for _, sum := range sums {
+		if sum != 0 {
+			return errs.ErrUnbalancedJournal
+		}
+	}
+	return nil
+}
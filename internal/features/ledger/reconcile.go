@@ -0,0 +1,116 @@
+package ledger
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/codepnw/simple-bank/internal/features/account"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+)
+
+// BalanceDrift is an account whose stored balance differs from the sum of
+// its entries. Diff is Balance - EntriesTotal.
+type BalanceDrift struct {
+	AccountID    int64               `json:"account_id"`
+	Type         account.AccountType `json:"type"`
+	Currency     string              `json:"currency"`
+	Balance      int64               `json:"balance"`
+	EntriesTotal int64               `json:"entries_total"`
+	Diff         int64               `json:"diff"`
+}
+
+// TransferMismatch is a transfer whose journals don't debit the sender by
+// Amount plus Fee or credit the recipient by ToAmount.
+type TransferMismatch struct {
+	TransferID    int64 `json:"transfer_id"`
+	FromAccountID int64 `json:"from_account_id"`
+	ToAccountID   int64 `json:"to_account_id"`
+	Amount        int64 `json:"amount"`
+	ToAmount      int64 `json:"to_amount"`
+	Fee           int64 `json:"fee"`
+	Debited       int64 `json:"debited"`  // sum of sender entries, expected -(Amount + Fee)
+	Credited      int64 `json:"credited"` // sum of recipient entries, expected ToAmount
+}
+
+type Report struct {
+	CheckedAt time.Time           `json:"checked_at"`
+	Balances  []*BalanceDrift     `json:"balances"`
+	Transfers []*TransferMismatch `json:"transfers"`
+}
+
+func (r *Report) Clean() bool {
+	return len(r.Balances) == 0 && len(r.Transfers) == 0
+}
+
+type ReportFormat string
+
+const (
+	ReportJSON ReportFormat = "json"
+	ReportCSV  ReportFormat = "csv"
+)
+
+func ParseReportFormat(format string) (ReportFormat, error) {
+	switch f := ReportFormat(strings.ToLower(format)); f {
+	case "":
+		return ReportJSON, nil
+	case ReportJSON, ReportCSV:
+		return f, nil
+	default:
+		return "", errs.ErrInvalidReportFormat
+	}
+}
+
+// WriteReport writes r as one JSON document, or as CSV with one row per
+// discrepancy: each drifted balance and each transfer leg that disagrees.
+func WriteReport(w io.Writer, f ReportFormat, r *Report) error {
+	if f == ReportCSV {
+		return writeReportCSV(w, r)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+func writeReportCSV(w io.Writer, r *Report) error {
+	c := csv.NewWriter(w)
+	if err := c.Write([]string{"type", "account_id", "currency", "transfer_id", "expected", "actual", "diff"}); err != nil {
+		return err
+	}
+
+	row := func(kind string, accountID int64, currency, transferID string, expected, actual int64) error {
+		return c.Write([]string{
+			kind,
+			strconv.FormatInt(accountID, 10),
+			currency,
+			transferID,
+			strconv.FormatInt(expected, 10),
+			strconv.FormatInt(actual, 10),
+			strconv.FormatInt(actual-expected, 10),
+		})
+	}
+
+	for _, b := range r.Balances {
+		if err := row("balance", b.AccountID, b.Currency, "", b.EntriesTotal, b.Balance); err != nil {
+			return err
+		}
+	}
+	for _, t := range r.Transfers {
+		id := strconv.FormatInt(t.TransferID, 10)
+		if debit := -(t.Amount + t.Fee); t.Debited != debit {
+			if err := row("transfer_debit", t.FromAccountID, "", id, debit, t.Debited); err != nil {
+				return err
+			}
+		}
+		if t.Credited != t.ToAmount {
+			if err := row("transfer_credit", t.ToAccountID, "", id, t.ToAmount, t.Credited); err != nil {
+				return err
+			}
+		}
+	}
+	c.Flush()
+	return c.Error()
+}
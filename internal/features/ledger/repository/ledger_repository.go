@@ -0,0 +1,147 @@
+package ledgerrepository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/codepnw/simple-bank/internal/features/ledger"
+)
+
+//go:generate mockgen -source=ledger_repository.go -destination=mock_ledger_repository.go -package=ledgerrepository
+type LedgerRepository interface {
+	ListBalanceDrifts(ctx context.Context) ([]*ledger.BalanceDrift, error)
+	ListTransferMismatches(ctx context.Context) ([]*ledger.TransferMismatch, error)
+
+	// Transaction
+	InsertJournal(ctx context.Context, tx *sql.Tx, input *ledger.Journal) (*ledger.Journal, error)
+	LockBalanceDrifts(ctx context.Context, tx *sql.Tx) ([]*ledger.BalanceDrift, error)
+}
+
+type ledgerRepository struct {
+	db *sql.DB
+}
+
+func NewLedgerRepository(db *sql.DB) LedgerRepository {
+	return &ledgerRepository{db: db}
+}
+
+// InsertJournal writes the journal header; its entries are inserted
+// separately with the returned ID.
+func (r *ledgerRepository) InsertJournal(ctx context.Context, tx *sql.Tx, input *ledger.Journal) (*ledger.Journal, error) {
+	query := `
+		INSERT INTO journal_transactions (kind, transfer_id, created_by)
+		VALUES ($1, $2, $3) RETURNING id, created_at
+	`
+	err := tx.QueryRowContext(ctx, query, input.Kind, input.TransferID, input.CreatedBy).Scan(
+		&input.ID,
+		&input.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// Balances and entry totals are read in one statement. Posting changes both
+// in one transaction, so an in-flight transfer never shows up as drift.
+const driftQuery = `
+	SELECT a.id, a.type, a.currency, a.balance,
+		COALESCE((SELECT SUM(e.amount) FROM entries e WHERE e.account_id = a.id), 0) AS entries_total
+	FROM accounts a
+`
+
+// ListBalanceDrifts returns the accounts whose balance is not the sum of
+// their entries, in ID order.
+func (r *ledgerRepository) ListBalanceDrifts(ctx context.Context) ([]*ledger.BalanceDrift, error) {
+	query := `
+		SELECT * FROM (` + driftQuery + `) d
+		WHERE d.balance <> d.entries_total
+		ORDER BY d.id
+	`
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return scanDrifts(rows)
+}
+
+// LockBalanceDrifts is ListBalanceDrifts plus every suspense account, with
+// the rows locked in ID order until tx ends.
+func (r *ledgerRepository) LockBalanceDrifts(ctx context.Context, tx *sql.Tx) ([]*ledger.BalanceDrift, error) {
+	query := driftQuery + `
+		WHERE a.type = 'suspense'
+			OR a.balance <> COALESCE((SELECT SUM(e.amount) FROM entries e WHERE e.account_id = a.id), 0)
+		ORDER BY a.id
+		FOR UPDATE OF a
+	`
+	rows, err := tx.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return scanDrifts(rows)
+}
+
+func scanDrifts(rows *sql.Rows) ([]*ledger.BalanceDrift, error) {
+	defer rows.Close()
+
+	var drifts []*ledger.BalanceDrift
+	for rows.Next() {
+		d := new(ledger.BalanceDrift)
+		if err := rows.Scan(
+			&d.AccountID,
+			&d.Type,
+			&d.Currency,
+			&d.Balance,
+			&d.EntriesTotal,
+		); err != nil {
+			return nil, err
+		}
+		d.Diff = d.Balance - d.EntriesTotal
+		drifts = append(drifts, d)
+	}
+	return drifts, rows.Err()
+}
+
+// ListTransferMismatches returns the transfers whose journals don't debit
+// the sender amount plus fee and credit the recipient to_amount. Transfers made before the
+// journal existed are booked in the opening journal and are skipped.
+func (r *ledgerRepository) ListTransferMismatches(ctx context.Context) ([]*ledger.TransferMismatch, error) {
+	query := `
+		SELECT * FROM (
+			SELECT t.id, t.from_account_id, t.to_account_id, t.amount, t.to_amount, t.fee,
+				COALESCE(SUM(e.amount) FILTER (WHERE e.account_id = t.from_account_id), 0) AS debited,
+				COALESCE(SUM(e.amount) FILTER (WHERE e.account_id = t.to_account_id), 0) AS credited
+			FROM transfers t
+			LEFT JOIN journal_transactions j ON j.transfer_id = t.id
+			LEFT JOIN entries e ON e.journal_id = j.id
+			WHERE t.created_at >= (SELECT MIN(created_at) FROM journal_transactions)
+			GROUP BY t.id
+		) m
+		WHERE m.debited <> -(m.amount + m.fee) OR m.credited <> m.to_amount
+		ORDER BY m.id
+	`
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var mismatches []*ledger.TransferMismatch
+	for rows.Next() {
+		m := new(ledger.TransferMismatch)
+		if err := rows.Scan(
+			&m.TransferID,
+			&m.FromAccountID,
+			&m.ToAccountID,
+			&m.Amount,
+			&m.ToAmount,
+			&m.Fee,
+			&m.Debited,
+			&m.Credited,
+		); err != nil {
+			return nil, err
+		}
+		mismatches = append(mismatches, m)
+	}
+	return mismatches, rows.Err()
+}
@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ledger_repository.go
+
+// Package ledgerrepository is a generated GoMock package.
+package ledgerrepository
+
+import (
+	context "context"
+	sql "database/sql"
+	reflect "reflect"
+
+	ledger "github.com/codepnw/simple-bank/internal/features/ledger"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLedgerRepository is a mock of LedgerRepository interface.
+type MockLedgerRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerRepositoryMockRecorder
+}
+
+// MockLedgerRepositoryMockRecorder is the mock recorder for MockLedgerRepository.
+type MockLedgerRepositoryMockRecorder struct {
+	mock *MockLedgerRepository
+}
+
+// NewMockLedgerRepository creates a new mock instance.
+func NewMockLedgerRepository(ctrl *gomock.Controller) *MockLedgerRepository {
+	mock := &MockLedgerRepository{ctrl: ctrl}
+	mock.recorder = &MockLedgerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerRepository) EXPECT() *MockLedgerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// InsertJournal mocks base method.
+func (m *MockLedgerRepository) InsertJournal(ctx context.Context, tx *sql.Tx, input *ledger.Journal) (*ledger.Journal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertJournal", ctx, tx, input)
+	ret0, _ := ret[0].(*ledger.Journal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertJournal indicates an expected call of InsertJournal.
+func (mr *MockLedgerRepositoryMockRecorder) InsertJournal(ctx, tx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertJournal", reflect.TypeOf((*MockLedgerRepository)(nil).InsertJournal), ctx, tx, input)
+}
+
+// ListBalanceDrifts mocks base method.
+func (m *MockLedgerRepository) ListBalanceDrifts(ctx context.Context) ([]*ledger.BalanceDrift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBalanceDrifts", ctx)
+	ret0, _ := ret[0].([]*ledger.BalanceDrift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBalanceDrifts indicates an expected call of ListBalanceDrifts.
+func (mr *MockLedgerRepositoryMockRecorder) ListBalanceDrifts(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBalanceDrifts", reflect.TypeOf((*MockLedgerRepository)(nil).ListBalanceDrifts), ctx)
+}
+
+// ListTransferMismatches mocks base method.
+func (m *MockLedgerRepository) ListTransferMismatches(ctx context.Context) ([]*ledger.TransferMismatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransferMismatches", ctx)
+	ret0, _ := ret[0].([]*ledger.TransferMismatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransferMismatches indicates an expected call of ListTransferMismatches.
+func (mr *MockLedgerRepositoryMockRecorder) ListTransferMismatches(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransferMismatches", reflect.TypeOf((*MockLedgerRepository)(nil).ListTransferMismatches), ctx)
+}
+
+// LockBalanceDrifts mocks base method.
+func (m *MockLedgerRepository) LockBalanceDrifts(ctx context.Context, tx *sql.Tx) ([]*ledger.BalanceDrift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockBalanceDrifts", ctx, tx)
+	ret0, _ := ret[0].([]*ledger.BalanceDrift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockBalanceDrifts indicates an expected call of LockBalanceDrifts.
+func (mr *MockLedgerRepositoryMockRecorder) LockBalanceDrifts(ctx, tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockBalanceDrifts", reflect.TypeOf((*MockLedgerRepository)(nil).LockBalanceDrifts), ctx, tx)
+}
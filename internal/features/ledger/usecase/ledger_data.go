@@ -0,0 +1,23 @@
+package ledgerusecase
+
+import (
+	"github.com/codepnw/simple-bank/internal/features/account"
+	"github.com/codepnw/simple-bank/internal/features/ledger"
+)
+
+// PostParams is one journal to post. TransferID links it to the transfer it
+// settles, if any, and CreatedBy the staff member who posted it by hand.
+// Release frees held funds, by account ID, just before that
+// account's balance moves, so a captured hold can pay for its own transfer.
+type PostParams struct {
+	Kind       ledger.JournalKind
+	TransferID *int64
+	CreatedBy  *int64
+	Lines      []ledger.Line
+	Release    map[int64]int64
+}
+
+type PostResult struct {
+	Journal  *ledger.Journal
+	Accounts map[int64]*account.Account // balances after posting, by ID
+}
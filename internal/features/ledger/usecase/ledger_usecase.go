@@ -0,0 +1,95 @@
+package ledgerusecase
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+
+	"github.com/codepnw/simple-bank/internal/features/account"
+	accountrepository "github.com/codepnw/simple-bank/internal/features/account/repository"
+	"github.com/codepnw/simple-bank/internal/features/entry"
+	entryrepository "github.com/codepnw/simple-bank/internal/features/entry/repository"
+	"github.com/codepnw/simple-bank/internal/features/ledger"
+	ledgerrepository "github.com/codepnw/simple-bank/internal/features/ledger/repository"
+)
+
+//go:generate mockgen -source=ledger_usecase.go -destination=mock_ledger_usecase.go -package=ledgerusecase
+type LedgerUsecase interface {
+	// Post is the single way money moves: it records a balanced journal
+	// inside tx and applies it to the balances. The caller checks business
+	// rules against the returned, locked accounts.
+	Post(ctx context.Context, tx *sql.Tx, input *PostParams) (*PostResult, error)
+}
+
+type ledgerUsecase struct {
+	repo    ledgerrepository.LedgerRepository
+	accRepo accountrepository.AccountRepository
+	entRepo entryrepository.EntryRepository
+}
+
+func NewLedgerUsecase(
+	repo ledgerrepository.LedgerRepository,
+	accRepo accountrepository.AccountRepository,
+	entRepo entryrepository.EntryRepository,
+) LedgerUsecase {
+	return &ledgerUsecase{
+		repo:    repo,
+		accRepo: accRepo,
+		entRepo: entRepo,
+	}
+}
+
+func (u *ledgerUsecase) Post(ctx context.Context, tx *sql.Tx, input *PostParams) (*PostResult, error) {
+	if err := ledger.CheckBalanced(input.Lines); err != nil {
+		return nil, err
+	}
+
+	journal, err := u.repo.InsertJournal(ctx, tx, &ledger.Journal{
+		Kind:       input.Kind,
+		TransferID: input.TransferID,
+		CreatedBy:  input.CreatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Create Entries, in line order
+	var ids []int64
+	net := make(map[int64]int64)
+	for _, l := range input.Lines {
+		e, err := u.entRepo.Insert(ctx, tx, &entry.Entry{
+			JournalID: journal.ID,
+			AccountID: l.AccountID,
+			Amount:    l.Amount,
+		})
+		if err != nil {
+			return nil, err
+		}
+		journal.Entries = append(journal.Entries, e)
+
+		if _, ok := net[l.AccountID]; !ok {
+			ids = append(ids, l.AccountID)
+		}
+		net[l.AccountID] += l.Amount
+	}
+
+	// Update Balance
+	// NOTE: Prevent "Deadlock" lock the accounts in ID order
+	slices.Sort(ids)
+	result := &PostResult{
+		Journal:  journal,
+		Accounts: make(map[int64]*account.Account, len(ids)),
+	}
+	for _, id := range ids {
+		if held := input.Release[id]; held != 0 {
+			if _, err = u.accRepo.AddHeldAmount(ctx, tx, id, -held); err != nil {
+				return nil, err
+			}
+		}
+		result.Accounts[id], err = u.accRepo.AddAccountBalance(ctx, tx, id, net[id])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
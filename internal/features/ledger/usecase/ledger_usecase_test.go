@@ -0,0 +1,253 @@
+package ledgerusecase_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/codepnw/simple-bank/internal/features/account"
+	accountrepository "github.com/codepnw/simple-bank/internal/features/account/repository"
+	"github.com/codepnw/simple-bank/internal/features/entry"
+	entryrepository "github.com/codepnw/simple-bank/internal/features/entry/repository"
+	"github.com/codepnw/simple-bank/internal/features/ledger"
+	ledgerrepository "github.com/codepnw/simple-bank/internal/features/ledger/repository"
+	ledgerusecase "github.com/codepnw/simple-bank/internal/features/ledger/usecase"
+	"github.com/codepnw/simple-bank/internal/mocks"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPost(t *testing.T) {
+	type testCase struct {
+		name        string
+		input       *ledgerusecase.PostParams
+		mockFn      func(repo *ledgerrepository.MockLedgerRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *ledgerusecase.PostParams)
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name: "success locks accounts in ID order",
+			input: &ledgerusecase.PostParams{
+				Kind: ledger.KindTransfer,
+				Lines: []ledger.Line{
+					{AccountID: 3, Currency: "THB", Amount: -100},
+					{AccountID: 2, Currency: "THB", Amount: 100},
+				},
+			},
+			mockFn: func(repo *ledgerrepository.MockLedgerRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *ledgerusecase.PostParams) {
+				mockJournal(repo, input.Kind)
+				entRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), &entry.Entry{JournalID: 1, AccountID: 3, Amount: -100}).DoAndReturn(mockEntry).Times(1)
+				entRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), &entry.Entry{JournalID: 1, AccountID: 2, Amount: 100}).DoAndReturn(mockEntry).Times(1)
+
+				gomock.InOrder(
+					accRepo.EXPECT().AddAccountBalance(gomock.Any(), gomock.Any(), int64(2), int64(100)).Return(mockAccount(2), nil).Times(1),
+					accRepo.EXPECT().AddAccountBalance(gomock.Any(), gomock.Any(), int64(3), int64(-100)).Return(mockAccount(3), nil).Times(1),
+				)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "success cross currency through fx accounts",
+			input: &ledgerusecase.PostParams{
+				Kind: ledger.KindTransfer,
+				Lines: []ledger.Line{
+					{AccountID: 1, Currency: "USD", Amount: -100},
+					{AccountID: 20, Currency: "USD", Amount: 100},
+					{AccountID: 21, Currency: "THB", Amount: -3650},
+					{AccountID: 2, Currency: "THB", Amount: 3650},
+				},
+			},
+			mockFn: func(repo *ledgerrepository.MockLedgerRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *ledgerusecase.PostParams) {
+				mockJournal(repo, input.Kind)
+				entRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(mockEntry).Times(4)
+
+				gomock.InOrder(
+					accRepo.EXPECT().AddAccountBalance(gomock.Any(), gomock.Any(), int64(1), int64(-100)).Return(mockAccount(1), nil).Times(1),
+					accRepo.EXPECT().AddAccountBalance(gomock.Any(), gomock.Any(), int64(2), int64(3650)).Return(mockAccount(2), nil).Times(1),
+					accRepo.EXPECT().AddAccountBalance(gomock.Any(), gomock.Any(), int64(20), int64(100)).Return(mockAccount(20), nil).Times(1),
+					accRepo.EXPECT().AddAccountBalance(gomock.Any(), gomock.Any(), int64(21), int64(-3650)).Return(mockAccount(21), nil).Times(1),
+				)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "success nets lines on the same account",
+			input: &ledgerusecase.PostParams{
+				Kind: ledger.KindTransfer,
+				Lines: []ledger.Line{
+					{AccountID: 1, Currency: "THB", Amount: -100},
+					{AccountID: 2, Currency: "THB", Amount: 100},
+					{AccountID: 1, Currency: "THB", Amount: -5},
+					{AccountID: 9, Currency: "THB", Amount: 5},
+				},
+			},
+			mockFn: func(repo *ledgerrepository.MockLedgerRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *ledgerusecase.PostParams) {
+				mockJournal(repo, input.Kind)
+				entRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(mockEntry).Times(4)
+
+				accRepo.EXPECT().AddAccountBalance(gomock.Any(), gomock.Any(), int64(1), int64(-105)).Return(mockAccount(1), nil).Times(1)
+				accRepo.EXPECT().AddAccountBalance(gomock.Any(), gomock.Any(), int64(2), int64(100)).Return(mockAccount(2), nil).Times(1)
+				accRepo.EXPECT().AddAccountBalance(gomock.Any(), gomock.Any(), int64(9), int64(5)).Return(mockAccount(9), nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "success releases held funds before the debit",
+			input: &ledgerusecase.PostParams{
+				Kind: ledger.KindTransfer,
+				Lines: []ledger.Line{
+					{AccountID: 3, Currency: "THB", Amount: -60},
+					{AccountID: 2, Currency: "THB", Amount: 60},
+				},
+				Release: map[int64]int64{3: 100},
+			},
+			mockFn: func(repo *ledgerrepository.MockLedgerRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *ledgerusecase.PostParams) {
+				mockJournal(repo, input.Kind)
+				entRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(mockEntry).Times(2)
+
+				gomock.InOrder(
+					accRepo.EXPECT().AddAccountBalance(gomock.Any(), gomock.Any(), int64(2), int64(60)).Return(mockAccount(2), nil).Times(1),
+					accRepo.EXPECT().AddHeldAmount(gomock.Any(), gomock.Any(), int64(3), int64(-100)).Return(mockAccount(3), nil).Times(1),
+					accRepo.EXPECT().AddAccountBalance(gomock.Any(), gomock.Any(), int64(3), int64(-60)).Return(mockAccount(3), nil).Times(1),
+				)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "fail unbalanced",
+			input: &ledgerusecase.PostParams{
+				Kind: ledger.KindTransfer,
+				Lines: []ledger.Line{
+					{AccountID: 1, Currency: "THB", Amount: -100},
+					{AccountID: 2, Currency: "THB", Amount: 99},
+				},
+			},
+			mockFn: func(repo *ledgerrepository.MockLedgerRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *ledgerusecase.PostParams) {
+			},
+			expectedErr: errs.ErrUnbalancedJournal,
+		},
+		{
+			name: "fail balanced only across currencies",
+			input: &ledgerusecase.PostParams{
+				Kind: ledger.KindTransfer,
+				Lines: []ledger.Line{
+					{AccountID: 1, Currency: "USD", Amount: -100},
+					{AccountID: 2, Currency: "THB", Amount: 100},
+				},
+			},
+			mockFn: func(repo *ledgerrepository.MockLedgerRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *ledgerusecase.PostParams) {
+			},
+			expectedErr: errs.ErrUnbalancedJournal,
+		},
+		{
+			name: "fail single line",
+			input: &ledgerusecase.PostParams{
+				Kind:  ledger.KindDeposit,
+				Lines: []ledger.Line{{AccountID: 1, Currency: "THB", Amount: 0}},
+			},
+			mockFn: func(repo *ledgerrepository.MockLedgerRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *ledgerusecase.PostParams) {
+			},
+			expectedErr: errs.ErrUnbalancedJournal,
+		},
+		{
+			name: "fail insert journal",
+			input: &ledgerusecase.PostParams{
+				Kind: ledger.KindDeposit,
+				Lines: []ledger.Line{
+					{AccountID: 10, Currency: "THB", Amount: 100},
+					{AccountID: 1, Currency: "THB", Amount: -100},
+				},
+			},
+			mockFn: func(repo *ledgerrepository.MockLedgerRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *ledgerusecase.PostParams) {
+				repo.EXPECT().InsertJournal(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, mocks.ErrDatabase).Times(1)
+			},
+			expectedErr: mocks.ErrDatabase,
+		},
+		{
+			name: "fail insert entry",
+			input: &ledgerusecase.PostParams{
+				Kind: ledger.KindDeposit,
+				Lines: []ledger.Line{
+					{AccountID: 10, Currency: "THB", Amount: 100},
+					{AccountID: 1, Currency: "THB", Amount: -100},
+				},
+			},
+			mockFn: func(repo *ledgerrepository.MockLedgerRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *ledgerusecase.PostParams) {
+				mockJournal(repo, input.Kind)
+				entRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, mocks.ErrDatabase).Times(1)
+			},
+			expectedErr: mocks.ErrDatabase,
+		},
+		{
+			name: "fail add balance",
+			input: &ledgerusecase.PostParams{
+				Kind: ledger.KindDeposit,
+				Lines: []ledger.Line{
+					{AccountID: 10, Currency: "THB", Amount: 100},
+					{AccountID: 1, Currency: "THB", Amount: -100},
+				},
+			},
+			mockFn: func(repo *ledgerrepository.MockLedgerRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *ledgerusecase.PostParams) {
+				mockJournal(repo, input.Kind)
+				entRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(mockEntry).Times(2)
+				accRepo.EXPECT().AddAccountBalance(gomock.Any(), gomock.Any(), int64(1), int64(-100)).Return(nil, mocks.ErrDatabase).Times(1)
+			},
+			expectedErr: mocks.ErrDatabase,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, repo, accRepo, entRepo := setup(t)
+
+			tc.mockFn(repo, accRepo, entRepo, tc.input)
+
+			result, err := uc.Post(context.Background(), nil, tc.input)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, result.Journal.Entries, len(tc.input.Lines))
+				for i, l := range tc.input.Lines {
+					assert.Equal(t, l.AccountID, result.Journal.Entries[i].AccountID)
+					assert.Equal(t, int64(1), result.Journal.Entries[i].JournalID)
+					assert.NotNil(t, result.Accounts[l.AccountID])
+				}
+			}
+		})
+	}
+}
+
+func mockJournal(repo *ledgerrepository.MockLedgerRepository, kind ledger.JournalKind) {
+	repo.EXPECT().InsertJournal(gomock.Any(), gomock.Any(), &ledger.Journal{Kind: kind}).DoAndReturn(func(_ context.Context, _ *sql.Tx, in *ledger.Journal) (*ledger.Journal, error) {
+		in.ID = 1
+		return in, nil
+	}).Times(1)
+}
+
+func mockEntry(_ context.Context, _ *sql.Tx, in *entry.Entry) (*entry.Entry, error) {
+	return in, nil
+}
+
+func mockAccount(id int64) *account.Account {
+	acc := mocks.MockAccountData()
+	acc.ID = id
+	return acc
+}
+
+func setup(t *testing.T) (ledgerusecase.LedgerUsecase, *ledgerrepository.MockLedgerRepository, *accountrepository.MockAccountRepository, *entryrepository.MockEntryRepository) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := ledgerrepository.NewMockLedgerRepository(ctrl)
+	accRepo := accountrepository.NewMockAccountRepository(ctrl)
+	entRepo := entryrepository.NewMockEntryRepository(ctrl)
+
+	uc := ledgerusecase.NewLedgerUsecase(repo, accRepo, entRepo)
+	return uc, repo, accRepo, entRepo
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ledger_usecase.go
+
+// Package ledgerusecase is a generated GoMock package.
+package ledgerusecase
+
+import (
+	context "context"
+	sql "database/sql"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLedgerUsecase is a mock of LedgerUsecase interface.
+type MockLedgerUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerUsecaseMockRecorder
+}
+
+// MockLedgerUsecaseMockRecorder is the mock recorder for MockLedgerUsecase.
+type MockLedgerUsecaseMockRecorder struct {
+	mock *MockLedgerUsecase
+}
+
+// NewMockLedgerUsecase creates a new mock instance.
+func NewMockLedgerUsecase(ctrl *gomock.Controller) *MockLedgerUsecase {
+	mock := &MockLedgerUsecase{ctrl: ctrl}
+	mock.recorder = &MockLedgerUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerUsecase) EXPECT() *MockLedgerUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Post mocks base method.
+func (m *MockLedgerUsecase) Post(ctx context.Context, tx *sql.Tx, input *PostParams) (*PostResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Post", ctx, tx, input)
+	ret0, _ := ret[0].(*PostResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Post indicates an expected call of Post.
+func (mr *MockLedgerUsecaseMockRecorder) Post(ctx, tx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Post", reflect.TypeOf((*MockLedgerUsecase)(nil).Post), ctx, tx, input)
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: reconcile_usecase.go
+
+// Package ledgerusecase is a generated GoMock package.
+package ledgerusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	ledger "github.com/codepnw/simple-bank/internal/features/ledger"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReconcileUsecase is a mock of ReconcileUsecase interface.
+type MockReconcileUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockReconcileUsecaseMockRecorder
+}
+
+// MockReconcileUsecaseMockRecorder is the mock recorder for MockReconcileUsecase.
+type MockReconcileUsecaseMockRecorder struct {
+	mock *MockReconcileUsecase
+}
+
+// NewMockReconcileUsecase creates a new mock instance.
+func NewMockReconcileUsecase(ctrl *gomock.Controller) *MockReconcileUsecase {
+	mock := &MockReconcileUsecase{ctrl: ctrl}
+	mock.recorder = &MockReconcileUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReconcileUsecase) EXPECT() *MockReconcileUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Correct mocks base method.
+func (m *MockReconcileUsecase) Correct(ctx context.Context) ([]*ledger.Journal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Correct", ctx)
+	ret0, _ := ret[0].([]*ledger.Journal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Correct indicates an expected call of Correct.
+func (mr *MockReconcileUsecaseMockRecorder) Correct(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Correct", reflect.TypeOf((*MockReconcileUsecase)(nil).Correct), ctx)
+}
+
+// Reconcile mocks base method.
+func (m *MockReconcileUsecase) Reconcile(ctx context.Context) (*ledger.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconcile", ctx)
+	ret0, _ := ret[0].(*ledger.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockReconcileUsecaseMockRecorder) Reconcile(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockReconcileUsecase)(nil).Reconcile), ctx)
+}
@@ -0,0 +1,180 @@
+package ledgerusecase
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"time"
+
+	"github.com/codepnw/simple-bank/internal/features/account"
+	accountrepository "github.com/codepnw/simple-bank/internal/features/account/repository"
+	"github.com/codepnw/simple-bank/internal/features/entry"
+	entryrepository "github.com/codepnw/simple-bank/internal/features/entry/repository"
+	"github.com/codepnw/simple-bank/internal/features/ledger"
+	ledgerrepository "github.com/codepnw/simple-bank/internal/features/ledger/repository"
+	"github.com/codepnw/simple-bank/internal/features/user"
+	"github.com/codepnw/simple-bank/pkg/auth"
+	"github.com/codepnw/simple-bank/pkg/database"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+)
+
+//go:generate mockgen -source=reconcile_usecase.go -destination=mock_reconcile_usecase.go -package=ledgerusecase
+type ReconcileUsecase interface {
+	// Reconcile recomputes every balance from its entries and checks every
+	// transfer against its journals. It only reads.
+	Reconcile(ctx context.Context) (*ledger.Report, error)
+	// Correct opens one correction journal per currency with drift: each
+	// drifted account gets an entry for its difference, offset on the
+	// currency's suspense account. Admin only.
+	Correct(ctx context.Context) ([]*ledger.Journal, error)
+}
+
+type reconcileUsecase struct {
+	repo    ledgerrepository.LedgerRepository
+	accRepo accountrepository.AccountRepository
+	entRepo entryrepository.EntryRepository
+	tx      database.TxManager
+}
+
+func NewReconcileUsecase(
+	repo ledgerrepository.LedgerRepository,
+	accRepo accountrepository.AccountRepository,
+	entRepo entryrepository.EntryRepository,
+	tx database.TxManager,
+) ReconcileUsecase {
+	return &reconcileUsecase{
+		repo:    repo,
+		accRepo: accRepo,
+		entRepo: entRepo,
+		tx:      tx,
+	}
+}
+
+// Reconcile scans whole tables, so it runs without the request timeout.
+func (u *reconcileUsecase) Reconcile(ctx context.Context) (*ledger.Report, error) {
+	report := &ledger.Report{CheckedAt: time.Now()}
+
+	balances, err := u.repo.ListBalanceDrifts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report.Balances = balances
+
+	transfers, err := u.repo.ListTransferMismatches(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report.Transfers = transfers
+
+	return report, nil
+}
+
+// Correct keeps the stored balances, which customers have already seen, and
+// books the entries they are missing. The suspense account takes the other
+// side; its own balance is set to match its entries.
+func (u *reconcileUsecase) Correct(ctx context.Context) ([]*ledger.Journal, error) {
+	userID := auth.GetUserID(ctx)
+	if userID == 0 {
+		return nil, errs.ErrNoUserID
+	}
+	if !auth.HasRole(ctx, user.RoleAdmin) {
+		return nil, errs.ErrNoPermission
+	}
+
+	var journals []*ledger.Journal
+
+	err := u.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
+		drifts, err := u.repo.LockBalanceDrifts(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		// Group by currency; every drifted account but suspense is a line
+		var currencies []string
+		suspense := make(map[string]*ledger.BalanceDrift)
+		lines := make(map[string][]ledger.Line)
+		for _, d := range drifts {
+			if !slices.Contains(currencies, d.Currency) {
+				currencies = append(currencies, d.Currency)
+			}
+			if d.Type == account.TypeSuspense {
+				suspense[d.Currency] = d
+				continue
+			}
+			lines[d.Currency] = append(lines[d.Currency], ledger.Line{
+				AccountID: d.AccountID,
+				Currency:  d.Currency,
+				Amount:    d.Diff,
+			})
+		}
+		slices.Sort(currencies)
+
+		for _, cur := range currencies {
+			s := suspense[cur]
+			if s == nil {
+				return errs.ErrSystemAccountNotFound
+			}
+
+			var total int64
+			for _, l := range lines[cur] {
+				total += l.Amount
+			}
+
+			if len(lines[cur]) > 0 {
+				journal, err := u.postCorrection(ctx, tx, userID, s, lines[cur], total)
+				if err != nil {
+					return err
+				}
+				journals = append(journals, journal)
+			}
+
+			// Suspense entries move by -total; its balance follows them
+			if delta := -total - s.Diff; delta != 0 {
+				if _, err := u.accRepo.AddAccountBalance(ctx, tx, s.AccountID, delta); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return journals, nil
+}
+
+// postCorrection records entries only: the drifted balances already hold
+// these amounts.
+func (u *reconcileUsecase) postCorrection(ctx context.Context, tx *sql.Tx, userID int64, suspense *ledger.BalanceDrift, lines []ledger.Line, total int64) (*ledger.Journal, error) {
+	if total != 0 {
+		lines = append(lines, ledger.Line{
+			AccountID: suspense.AccountID,
+			Currency:  suspense.Currency,
+			Amount:    -total,
+		})
+	}
+	if err := ledger.CheckBalanced(lines); err != nil {
+		return nil, err
+	}
+
+	journal, err := u.repo.InsertJournal(ctx, tx, &ledger.Journal{
+		Kind:      ledger.KindCorrection,
+		CreatedBy: &userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, l := range lines {
+		e, err := u.entRepo.Insert(ctx, tx, &entry.Entry{
+			JournalID: journal.ID,
+			AccountID: l.AccountID,
+			Amount:    l.Amount,
+		})
+		if err != nil {
+			return nil, err
+		}
+		journal.Entries = append(journal.Entries, e)
+	}
+	return journal, nil
+}
@@ -0,0 +1,266 @@
+package ledgerusecase_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/codepnw/simple-bank/internal/features/account"
+	accountrepository "github.com/codepnw/simple-bank/internal/features/account/repository"
+	"github.com/codepnw/simple-bank/internal/features/entry"
+	entryrepository "github.com/codepnw/simple-bank/internal/features/entry/repository"
+	"github.com/codepnw/simple-bank/internal/features/ledger"
+	ledgerrepository "github.com/codepnw/simple-bank/internal/features/ledger/repository"
+	ledgerusecase "github.com/codepnw/simple-bank/internal/features/ledger/usecase"
+	"github.com/codepnw/simple-bank/internal/features/user"
+	"github.com/codepnw/simple-bank/internal/mocks"
+	"github.com/codepnw/simple-bank/pkg/auth"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const adminID = int64(1)
+
+func TestReconcile(t *testing.T) {
+	type testCase struct {
+		name        string
+		mockFn      func(repo *ledgerrepository.MockLedgerRepository)
+		clean       bool
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name: "success with discrepancies",
+			mockFn: func(repo *ledgerrepository.MockLedgerRepository) {
+				repo.EXPECT().ListBalanceDrifts(gomock.Any()).Return([]*ledger.BalanceDrift{mockDrift(10, account.TypeCustomer, 50)}, nil).Times(1)
+				repo.EXPECT().ListTransferMismatches(gomock.Any()).Return([]*ledger.TransferMismatch{{TransferID: 1, Amount: 100, ToAmount: 100}}, nil).Times(1)
+			},
+			clean:       false,
+			expectedErr: nil,
+		},
+		{
+			name: "success clean",
+			mockFn: func(repo *ledgerrepository.MockLedgerRepository) {
+				repo.EXPECT().ListBalanceDrifts(gomock.Any()).Return(nil, nil).Times(1)
+				repo.EXPECT().ListTransferMismatches(gomock.Any()).Return(nil, nil).Times(1)
+			},
+			clean:       true,
+			expectedErr: nil,
+		},
+		{
+			name: "fail list balance drifts",
+			mockFn: func(repo *ledgerrepository.MockLedgerRepository) {
+				repo.EXPECT().ListBalanceDrifts(gomock.Any()).Return(nil, mocks.ErrDatabase).Times(1)
+			},
+			expectedErr: mocks.ErrDatabase,
+		},
+		{
+			name: "fail list transfer mismatches",
+			mockFn: func(repo *ledgerrepository.MockLedgerRepository) {
+				repo.EXPECT().ListBalanceDrifts(gomock.Any()).Return(nil, nil).Times(1)
+				repo.EXPECT().ListTransferMismatches(gomock.Any()).Return(nil, mocks.ErrDatabase).Times(1)
+			},
+			expectedErr: mocks.ErrDatabase,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, repo, _, _ := setupReconcile(t)
+
+			tc.mockFn(repo)
+
+			report, err := uc.Reconcile(context.Background())
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.clean, report.Clean())
+			}
+		})
+	}
+}
+
+func TestCorrect(t *testing.T) {
+	type testCase struct {
+		name        string
+		userID      int64
+		role        user.Role
+		mockFn      func(repo *ledgerrepository.MockLedgerRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository)
+		journals    int
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:   "success books drift against suspense",
+			userID: adminID,
+			role:   user.RoleAdmin,
+			mockFn: func(repo *ledgerrepository.MockLedgerRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository) {
+				repo.EXPECT().LockBalanceDrifts(gomock.Any(), gomock.Any()).Return([]*ledger.BalanceDrift{
+					mockDrift(5, account.TypeSuspense, 0),
+					mockDrift(10, account.TypeCustomer, 50),
+					mockDrift(12, account.TypeCustomer, -20),
+				}, nil).Times(1)
+
+				mockCorrectionJournal(repo)
+				gomock.InOrder(
+					entRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), &entry.Entry{JournalID: 1, AccountID: 10, Amount: 50}).DoAndReturn(mockEntry).Times(1),
+					entRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), &entry.Entry{JournalID: 1, AccountID: 12, Amount: -20}).DoAndReturn(mockEntry).Times(1),
+					entRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), &entry.Entry{JournalID: 1, AccountID: 5, Amount: -30}).DoAndReturn(mockEntry).Times(1),
+				)
+				accRepo.EXPECT().AddAccountBalance(gomock.Any(), gomock.Any(), int64(5), int64(-30)).Return(mockAccount(5), nil).Times(1)
+			},
+			journals:    1,
+			expectedErr: nil,
+		},
+		{
+			name:   "success drift nets to zero",
+			userID: adminID,
+			role:   user.RoleAdmin,
+			mockFn: func(repo *ledgerrepository.MockLedgerRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository) {
+				repo.EXPECT().LockBalanceDrifts(gomock.Any(), gomock.Any()).Return([]*ledger.BalanceDrift{
+					mockDrift(5, account.TypeSuspense, 0),
+					mockDrift(10, account.TypeCustomer, 5),
+					mockDrift(12, account.TypeCustomer, -5),
+				}, nil).Times(1)
+
+				mockCorrectionJournal(repo)
+				entRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(mockEntry).Times(2)
+			},
+			journals:    1,
+			expectedErr: nil,
+		},
+		{
+			name:   "success suspense drift only",
+			userID: adminID,
+			role:   user.RoleAdmin,
+			mockFn: func(repo *ledgerrepository.MockLedgerRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository) {
+				repo.EXPECT().LockBalanceDrifts(gomock.Any(), gomock.Any()).Return([]*ledger.BalanceDrift{
+					mockDrift(5, account.TypeSuspense, 7),
+				}, nil).Times(1)
+
+				accRepo.EXPECT().AddAccountBalance(gomock.Any(), gomock.Any(), int64(5), int64(-7)).Return(mockAccount(5), nil).Times(1)
+			},
+			journals:    0,
+			expectedErr: nil,
+		},
+		{
+			name:   "success nothing to correct",
+			userID: adminID,
+			role:   user.RoleAdmin,
+			mockFn: func(repo *ledgerrepository.MockLedgerRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository) {
+				repo.EXPECT().LockBalanceDrifts(gomock.Any(), gomock.Any()).Return([]*ledger.BalanceDrift{
+					mockDrift(5, account.TypeSuspense, 0),
+				}, nil).Times(1)
+			},
+			journals:    0,
+			expectedErr: nil,
+		},
+		{
+			name:   "fail no user id",
+			userID: 0,
+			role:   user.RoleAdmin,
+			mockFn: func(repo *ledgerrepository.MockLedgerRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository) {
+			},
+			expectedErr: errs.ErrNoUserID,
+		},
+		{
+			name:   "fail not admin",
+			userID: 10,
+			role:   user.RoleCustomer,
+			mockFn: func(repo *ledgerrepository.MockLedgerRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository) {
+			},
+			expectedErr: errs.ErrNoPermission,
+		},
+		{
+			name:   "fail suspense account not found",
+			userID: adminID,
+			role:   user.RoleAdmin,
+			mockFn: func(repo *ledgerrepository.MockLedgerRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository) {
+				repo.EXPECT().LockBalanceDrifts(gomock.Any(), gomock.Any()).Return([]*ledger.BalanceDrift{
+					mockDrift(10, account.TypeCustomer, 50),
+				}, nil).Times(1)
+			},
+			expectedErr: errs.ErrSystemAccountNotFound,
+		},
+		{
+			name:   "fail lock balance drifts",
+			userID: adminID,
+			role:   user.RoleAdmin,
+			mockFn: func(repo *ledgerrepository.MockLedgerRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository) {
+				repo.EXPECT().LockBalanceDrifts(gomock.Any(), gomock.Any()).Return(nil, mocks.ErrDatabase).Times(1)
+			},
+			expectedErr: mocks.ErrDatabase,
+		},
+		{
+			name:   "fail insert journal",
+			userID: adminID,
+			role:   user.RoleAdmin,
+			mockFn: func(repo *ledgerrepository.MockLedgerRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository) {
+				repo.EXPECT().LockBalanceDrifts(gomock.Any(), gomock.Any()).Return([]*ledger.BalanceDrift{
+					mockDrift(5, account.TypeSuspense, 0),
+					mockDrift(10, account.TypeCustomer, 50),
+				}, nil).Times(1)
+				repo.EXPECT().InsertJournal(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, mocks.ErrDatabase).Times(1)
+			},
+			expectedErr: mocks.ErrDatabase,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, repo, accRepo, entRepo := setupReconcile(t)
+
+			tc.mockFn(repo, accRepo, entRepo)
+
+			ctx := auth.SetUserID(context.Background(), tc.userID)
+			ctx = auth.SetRole(ctx, tc.role)
+
+			journals, err := uc.Correct(ctx)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, journals, tc.journals)
+			}
+		})
+	}
+}
+
+func mockDrift(id int64, accType account.AccountType, diff int64) *ledger.BalanceDrift {
+	return &ledger.BalanceDrift{
+		AccountID:    id,
+		Type:         accType,
+		Currency:     "THB",
+		Balance:      1000 + diff,
+		EntriesTotal: 1000,
+		Diff:         diff,
+	}
+}
+
+func mockCorrectionJournal(repo *ledgerrepository.MockLedgerRepository) {
+	createdBy := adminID
+	repo.EXPECT().InsertJournal(gomock.Any(), gomock.Any(), &ledger.Journal{Kind: ledger.KindCorrection, CreatedBy: &createdBy}).DoAndReturn(func(_ context.Context, _ *sql.Tx, in *ledger.Journal) (*ledger.Journal, error) {
+		in.ID = 1
+		return in, nil
+	}).Times(1)
+}
+
+func setupReconcile(t *testing.T) (ledgerusecase.ReconcileUsecase, *ledgerrepository.MockLedgerRepository, *accountrepository.MockAccountRepository, *entryrepository.MockEntryRepository) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := ledgerrepository.NewMockLedgerRepository(ctrl)
+	accRepo := accountrepository.NewMockAccountRepository(ctrl)
+	entRepo := entryrepository.NewMockEntryRepository(ctrl)
+
+	uc := ledgerusecase.NewReconcileUsecase(repo, accRepo, entRepo, &mocks.MockTx{})
+	return uc, repo, accRepo, entRepo
+}
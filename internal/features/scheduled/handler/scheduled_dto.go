@@ -0,0 +1,25 @@
+package scheduledhandler
+
+import "time"
+
+type CreateScheduledReq struct {
+	FromAccountID int64  `json:"from_account_id" binding:"required,min=1" example:"1"`
+	ToAccountID   int64  `json:"to_account_id" binding:"required,min=1" example:"2"`
+	Amount        int64  `json:"amount" binding:"required,gt=0" example:"1500000"`
+	Currency      string `json:"currency" binding:"required,len=3" example:"THB"`
+	// Cron expression in UTC; empty for a one-off transfer
+	Schedule string `json:"schedule" binding:"max=100" example:"0 2 1 * *"`
+	// One-off: when to send (required). Recurring: earliest start (optional)
+	RunAt *time.Time `json:"run_at" example:"2026-11-01T02:00:00Z"`
+}
+
+type UpdateScheduledReq struct {
+	Amount *int64  `json:"amount" binding:"omitempty,gt=0" example:"1600000"`
+	Status *string `json:"status" binding:"omitempty,oneof=active paused" example:"paused"`
+}
+
+type ListScheduledReq struct {
+	Cursor string `form:"cursor"`
+	Page   int    `form:"page"`
+	Size   int    `form:"size"`
+}
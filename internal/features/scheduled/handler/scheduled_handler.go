@@ -0,0 +1,214 @@
+package scheduledhandler
+
+import (
+	"github.com/codepnw/simple-bank/internal/consts"
+	"github.com/codepnw/simple-bank/internal/features/scheduled"
+	scheduledusecase "github.com/codepnw/simple-bank/internal/features/scheduled/usecase"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/codepnw/simple-bank/pkg/utils/helper"
+	"github.com/codepnw/simple-bank/pkg/utils/pagination"
+	"github.com/codepnw/simple-bank/pkg/utils/response"
+	"github.com/gin-gonic/gin"
+)
+
+type scheduledHandler struct {
+	uc scheduledusecase.ScheduledUsecase
+}
+
+func NewScheduledHandler(uc scheduledusecase.ScheduledUsecase) *scheduledHandler {
+	return &scheduledHandler{uc: uc}
+}
+
+// @Summary Create Scheduled Transfer
+// @Description schedule a one-off transfer (run_at) or a recurring one (cron schedule, UTC)
+// @Tags scheduled-transfers
+// @Accept       json
+// @Produce      json
+// @Param request body CreateScheduledReq true "Scheduled Transfer Data"
+// @Success 201 {object} scheduled.Transfer "Create Scheduled Transfer Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input or Schedule"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Account Not Found"
+// @Failure 409 {object} response.ErrorResponse "Account Frozen or Closed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /transfers/scheduled [post]
+func (h *scheduledHandler) Create(c *gin.Context) {
+	req := new(CreateScheduledReq)
+	if err := c.ShouldBindJSON(req); err != nil {
+		response.Error(c, errs.InvalidInput(err))
+		return
+	}
+
+	input := &scheduledusecase.CreateParams{
+		FromAccountID: req.FromAccountID,
+		ToAccountID:   req.ToAccountID,
+		Amount:        req.Amount,
+		Currency:      req.Currency,
+		Schedule:      req.Schedule,
+		RunAt:         req.RunAt,
+	}
+	data, err := h.uc.Create(c.Request.Context(), input)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.Created(c, "", data)
+}
+
+// @Summary List Scheduled Transfers
+// @Description list the user's scheduled transfers, oldest first
+// @Tags scheduled-transfers
+// @Produce      json
+// @Param cursor query string false "Cursor from the previous page's next_cursor"
+// @Param page query int false "Page number (offset mode, ignored when cursor is set)"
+// @Param size query int false "Page size"
+// @Success 200 {object} response.PageResponse{data=[]scheduled.Transfer} "List Scheduled Transfers Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /transfers/scheduled [get]
+func (h *scheduledHandler) List(c *gin.Context) {
+	req := new(ListScheduledReq)
+	if err := c.ShouldBindQuery(req); err != nil {
+		response.Error(c, errs.InvalidInput(err))
+		return
+	}
+
+	page, err := pagination.NewPage(req.Page, req.Size, req.Cursor)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	data, meta, err := h.uc.List(c.Request.Context(), page)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.SuccessPage(c, "", data, meta)
+}
+
+// @Summary Get Scheduled Transfer
+// @Description get a scheduled transfer by id (owner only)
+// @Tags scheduled-transfers
+// @Produce      json
+// @Param scheduled_id path int true "Scheduled Transfer ID"
+// @Success 200 {object} scheduled.Transfer "Get Scheduled Transfer Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Scheduled Transfer Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /transfers/scheduled/{scheduled_id} [get]
+func (h *scheduledHandler) Get(c *gin.Context) {
+	id, err := helper.ParseInt64(c.Param(consts.ParamScheduledID))
+	if err != nil {
+		response.Error(c, errs.ErrInvalidID.Wrap(err))
+		return
+	}
+
+	data, err := h.uc.Get(c.Request.Context(), id)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.Success(c, "", data)
+}
+
+// @Summary Update Scheduled Transfer
+// @Description change the amount, or pause (paused) and resume (active); occurrences missed while paused are skipped; the amount is locked while the current occurrence is due or retried
+// @Tags scheduled-transfers
+// @Accept       json
+// @Produce      json
+// @Param scheduled_id path int true "Scheduled Transfer ID"
+// @Param request body UpdateScheduledReq true "Fields to Change"
+// @Success 200 {object} scheduled.Transfer "Update Scheduled Transfer Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Scheduled Transfer Not Found"
+// @Failure 409 {object} response.ErrorResponse "Scheduled Transfer Finished or Occurrence Pending"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /transfers/scheduled/{scheduled_id} [patch]
+func (h *scheduledHandler) Update(c *gin.Context) {
+	id, err := helper.ParseInt64(c.Param(consts.ParamScheduledID))
+	if err != nil {
+		response.Error(c, errs.ErrInvalidID.Wrap(err))
+		return
+	}
+
+	req := new(UpdateScheduledReq)
+	if err := c.ShouldBindJSON(req); err != nil {
+		response.Error(c, errs.InvalidInput(err))
+		return
+	}
+
+	input := &scheduledusecase.UpdateParams{ID: id, Amount: req.Amount}
+	if req.Status != nil {
+		status := scheduled.Status(*req.Status)
+		input.Status = &status
+	}
+	data, err := h.uc.Update(c.Request.Context(), input)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.Success(c, "", data)
+}
+
+// @Summary Cancel Scheduled Transfer
+// @Description stop a scheduled transfer for good; its run history is kept
+// @Tags scheduled-transfers
+// @Produce      json
+// @Param scheduled_id path int true "Scheduled Transfer ID"
+// @Success 200 {object} scheduled.Transfer "Cancel Scheduled Transfer Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Scheduled Transfer Not Found"
+// @Failure 409 {object} response.ErrorResponse "Scheduled Transfer Finished"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /transfers/scheduled/{scheduled_id} [delete]
+func (h *scheduledHandler) Cancel(c *gin.Context) {
+	id, err := helper.ParseInt64(c.Param(consts.ParamScheduledID))
+	if err != nil {
+		response.Error(c, errs.ErrInvalidID.Wrap(err))
+		return
+	}
+
+	data, err := h.uc.Cancel(c.Request.Context(), id)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.Success(c, "cancelled", data)
+}
+
+// @Summary List Scheduled Transfer Runs
+// @Description execution attempts of a scheduled transfer with their outcome, newest first
+// @Tags scheduled-transfers
+// @Produce      json
+// @Param scheduled_id path int true "Scheduled Transfer ID"
+// @Success 200 {object} []scheduled.Run "List Runs Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Scheduled Transfer Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /transfers/scheduled/{scheduled_id}/runs [get]
+func (h *scheduledHandler) ListRuns(c *gin.Context) {
+	id, err := helper.ParseInt64(c.Param(consts.ParamScheduledID))
+	if err != nil {
+		response.Error(c, errs.ErrInvalidID.Wrap(err))
+		return
+	}
+
+	data, err := h.uc.ListRuns(c.Request.Context(), id)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.Success(c, "", data)
+}
@@ -0,0 +1,198 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: scheduled_repository.go
+
+// Package scheduledrepository is a generated GoMock package.
+package scheduledrepository
+
+import (
+	context "context"
+	sql "database/sql"
+	reflect "reflect"
+
+	scheduled "github.com/codepnw/simple-bank/internal/features/scheduled"
+	pagination "github.com/codepnw/simple-bank/pkg/utils/pagination"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockScheduledRepository is a mock of ScheduledRepository interface.
+type MockScheduledRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduledRepositoryMockRecorder
+}
+
+// MockScheduledRepositoryMockRecorder is the mock recorder for MockScheduledRepository.
+type MockScheduledRepositoryMockRecorder struct {
+	mock *MockScheduledRepository
+}
+
+// NewMockScheduledRepository creates a new mock instance.
+func NewMockScheduledRepository(ctrl *gomock.Controller) *MockScheduledRepository {
+	mock := &MockScheduledRepository{ctrl: ctrl}
+	mock.recorder = &MockScheduledRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduledRepository) EXPECT() *MockScheduledRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimDue mocks base method.
+func (m *MockScheduledRepository) ClaimDue(ctx context.Context, tx *sql.Tx, limit int) ([]*scheduled.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDue", ctx, tx, limit)
+	ret0, _ := ret[0].([]*scheduled.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDue indicates an expected call of ClaimDue.
+func (mr *MockScheduledRepositoryMockRecorder) ClaimDue(ctx, tx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDue", reflect.TypeOf((*MockScheduledRepository)(nil).ClaimDue), ctx, tx, limit)
+}
+
+// FindByID mocks base method.
+func (m *MockScheduledRepository) FindByID(ctx context.Context, id int64) (*scheduled.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*scheduled.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockScheduledRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockScheduledRepository)(nil).FindByID), ctx, id)
+}
+
+// FindByIDForUpdate mocks base method.
+func (m *MockScheduledRepository) FindByIDForUpdate(ctx context.Context, tx *sql.Tx, id int64) (*scheduled.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDForUpdate", ctx, tx, id)
+	ret0, _ := ret[0].(*scheduled.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDForUpdate indicates an expected call of FindByIDForUpdate.
+func (mr *MockScheduledRepositoryMockRecorder) FindByIDForUpdate(ctx, tx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDForUpdate", reflect.TypeOf((*MockScheduledRepository)(nil).FindByIDForUpdate), ctx, tx, id)
+}
+
+// Insert mocks base method.
+func (m *MockScheduledRepository) Insert(ctx context.Context, input *scheduled.Transfer) (*scheduled.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, input)
+	ret0, _ := ret[0].(*scheduled.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockScheduledRepositoryMockRecorder) Insert(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockScheduledRepository)(nil).Insert), ctx, input)
+}
+
+// InsertRun mocks base method.
+func (m *MockScheduledRepository) InsertRun(ctx context.Context, tx *sql.Tx, input *scheduled.Run) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertRun", ctx, tx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertRun indicates an expected call of InsertRun.
+func (mr *MockScheduledRepositoryMockRecorder) InsertRun(ctx, tx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertRun", reflect.TypeOf((*MockScheduledRepository)(nil).InsertRun), ctx, tx, input)
+}
+
+// List mocks base method.
+func (m *MockScheduledRepository) List(ctx context.Context, ownerID int64, page *pagination.Page) ([]*scheduled.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, ownerID, page)
+	ret0, _ := ret[0].([]*scheduled.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockScheduledRepositoryMockRecorder) List(ctx, ownerID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockScheduledRepository)(nil).List), ctx, ownerID, page)
+}
+
+// ListRuns mocks base method.
+func (m *MockScheduledRepository) ListRuns(ctx context.Context, scheduledID int64) ([]*scheduled.Run, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRuns", ctx, scheduledID)
+	ret0, _ := ret[0].([]*scheduled.Run)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRuns indicates an expected call of ListRuns.
+func (mr *MockScheduledRepositoryMockRecorder) ListRuns(ctx, scheduledID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuns", reflect.TypeOf((*MockScheduledRepository)(nil).ListRuns), ctx, scheduledID)
+}
+
+// Update mocks base method.
+func (m *MockScheduledRepository) Update(ctx context.Context, tx *sql.Tx, input *scheduled.Transfer) (*scheduled.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, tx, input)
+	ret0, _ := ret[0].(*scheduled.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockScheduledRepositoryMockRecorder) Update(ctx, tx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockScheduledRepository)(nil).Update), ctx, tx, input)
+}
+
+// Mockscanner is a mock of scanner interface.
+type Mockscanner struct {
+	ctrl     *gomock.Controller
+	recorder *MockscannerMockRecorder
+}
+
+// MockscannerMockRecorder is the mock recorder for Mockscanner.
+type MockscannerMockRecorder struct {
+	mock *Mockscanner
+}
+
+// NewMockscanner creates a new mock instance.
+func NewMockscanner(ctrl *gomock.Controller) *Mockscanner {
+	mock := &Mockscanner{ctrl: ctrl}
+	mock.recorder = &MockscannerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockscanner) EXPECT() *MockscannerMockRecorder {
+	return m.recorder
+}
+
+// Scan mocks base method.
+func (m *Mockscanner) Scan(dest ...any) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range dest {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Scan", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Scan indicates an expected call of Scan.
+func (mr *MockscannerMockRecorder) Scan(dest ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*Mockscanner)(nil).Scan), dest...)
+}
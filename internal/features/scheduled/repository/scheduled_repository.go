@@ -0,0 +1,232 @@
+package scheduledrepository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/codepnw/simple-bank/internal/features/scheduled"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/codepnw/simple-bank/pkg/utils/pagination"
+)
+
+//go:generate mockgen -source=scheduled_repository.go -destination=mock_scheduled_repository.go -package=scheduledrepository
+type ScheduledRepository interface {
+	Insert(ctx context.Context, input *scheduled.Transfer) (*scheduled.Transfer, error)
+	FindByID(ctx context.Context, id int64) (*scheduled.Transfer, error)
+	List(ctx context.Context, ownerID int64, page *pagination.Page) ([]*scheduled.Transfer, error)
+	ListRuns(ctx context.Context, scheduledID int64) ([]*scheduled.Run, error)
+
+	// Transaction
+	FindByIDForUpdate(ctx context.Context, tx *sql.Tx, id int64) (*scheduled.Transfer, error)
+	ClaimDue(ctx context.Context, tx *sql.Tx, limit int) ([]*scheduled.Transfer, error)
+	Update(ctx context.Context, tx *sql.Tx, input *scheduled.Transfer) (*scheduled.Transfer, error)
+	InsertRun(ctx context.Context, tx *sql.Tx, input *scheduled.Run) error
+}
+
+type scheduledRepository struct {
+	db *sql.DB
+}
+
+func NewScheduledRepository(db *sql.DB) ScheduledRepository {
+	return &scheduledRepository{db: db}
+}
+
+const transferColumns = `
+	id, owner_id, from_account_id, to_account_id, amount, currency, schedule,
+	status, scheduled_for, next_run_at, attempts, created_at, updated_at
+`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanTransfer(row scanner) (*scheduled.Transfer, error) {
+	t := new(scheduled.Transfer)
+	err := row.Scan(
+		&t.ID,
+		&t.OwnerID,
+		&t.FromAccountID,
+		&t.ToAccountID,
+		&t.Amount,
+		&t.Currency,
+		&t.Schedule,
+		&t.Status,
+		&t.ScheduledFor,
+		&t.NextRunAt,
+		&t.Attempts,
+		&t.CreatedAt,
+		&t.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrScheduledTransferNotFound
+		}
+		return nil, err
+	}
+	return t, nil
+}
+
+func (r *scheduledRepository) Insert(ctx context.Context, input *scheduled.Transfer) (*scheduled.Transfer, error) {
+	query := `
+		INSERT INTO scheduled_transfers (owner_id, from_account_id, to_account_id, amount, currency, schedule, scheduled_for, next_run_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING ` + transferColumns
+
+	return scanTransfer(r.db.QueryRowContext(
+		ctx,
+		query,
+		input.OwnerID,
+		input.FromAccountID,
+		input.ToAccountID,
+		input.Amount,
+		input.Currency,
+		input.Schedule,
+		input.ScheduledFor,
+		input.NextRunAt,
+	))
+}
+
+func (r *scheduledRepository) FindByID(ctx context.Context, id int64) (*scheduled.Transfer, error) {
+	query := `SELECT ` + transferColumns + ` FROM scheduled_transfers WHERE id = $1 LIMIT 1`
+	return scanTransfer(r.db.QueryRowContext(ctx, query, id))
+}
+
+func (r *scheduledRepository) List(ctx context.Context, ownerID int64, page *pagination.Page) ([]*scheduled.Transfer, error) {
+	var (
+		rows *sql.Rows
+		err  error
+	)
+	if page.After != nil {
+		query := `
+			SELECT ` + transferColumns + ` FROM scheduled_transfers
+			WHERE owner_id = $1 AND (created_at, id) > ($2, $3)
+			ORDER BY created_at, id LIMIT $4
+		`
+		rows, err = r.db.QueryContext(ctx, query, ownerID, page.After.CreatedAt, page.After.ID, page.FetchLimit())
+	} else {
+		query := `
+			SELECT ` + transferColumns + ` FROM scheduled_transfers
+			WHERE owner_id = $1
+			ORDER BY created_at, id LIMIT $2 OFFSET $3
+		`
+		rows, err = r.db.QueryContext(ctx, query, ownerID, page.FetchLimit(), page.Offset)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return collectTransfers(rows)
+}
+
+// FindByIDForUpdate locks the row until tx ends, waiting for a worker that
+// is executing it.
+func (r *scheduledRepository) FindByIDForUpdate(ctx context.Context, tx *sql.Tx, id int64) (*scheduled.Transfer, error) {
+	query := `SELECT ` + transferColumns + ` FROM scheduled_transfers WHERE id = $1 FOR UPDATE`
+	return scanTransfer(tx.QueryRowContext(ctx, query, id))
+}
+
+// ClaimDue locks up to limit due active transfers, oldest first. Rows held by
+// another worker are skipped, so several instances can run side by side.
+func (r *scheduledRepository) ClaimDue(ctx context.Context, tx *sql.Tx, limit int) ([]*scheduled.Transfer, error) {
+	query := `
+		SELECT ` + transferColumns + ` FROM scheduled_transfers
+		WHERE status = 'active' AND next_run_at <= NOW()
+		ORDER BY next_run_at, id LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	`
+	rows, err := tx.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	return collectTransfers(rows)
+}
+
+func (r *scheduledRepository) Update(ctx context.Context, tx *sql.Tx, input *scheduled.Transfer) (*scheduled.Transfer, error) {
+	query := `
+		UPDATE scheduled_transfers SET
+			amount = $1, status = $2, scheduled_for = $3, next_run_at = $4, attempts = $5, updated_at = NOW()
+		WHERE id = $6 RETURNING ` + transferColumns
+
+	return scanTransfer(tx.QueryRowContext(
+		ctx,
+		query,
+		input.Amount,
+		input.Status,
+		input.ScheduledFor,
+		input.NextRunAt,
+		input.Attempts,
+		input.ID,
+	))
+}
+
+func (r *scheduledRepository) InsertRun(ctx context.Context, tx *sql.Tx, input *scheduled.Run) error {
+	query := `
+		INSERT INTO scheduled_transfer_runs (scheduled_transfer_id, scheduled_for, attempt, status, transfer_id, error_code)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, created_at
+	`
+	return tx.QueryRowContext(
+		ctx,
+		query,
+		input.ScheduledTransferID,
+		input.ScheduledFor,
+		input.Attempt,
+		input.Status,
+		input.TransferID,
+		input.ErrorCode,
+	).Scan(&input.ID, &input.CreatedAt)
+}
+
+func (r *scheduledRepository) ListRuns(ctx context.Context, scheduledID int64) ([]*scheduled.Run, error) {
+	query := `
+		SELECT id, scheduled_transfer_id, scheduled_for, attempt, status, transfer_id, error_code, created_at
+		FROM scheduled_transfer_runs WHERE scheduled_transfer_id = $1
+		ORDER BY created_at DESC, id DESC
+	`
+	rows, err := r.db.QueryContext(ctx, query, scheduledID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	runs := make([]*scheduled.Run, 0)
+
+	for rows.Next() {
+		run := new(scheduled.Run)
+		if err = rows.Scan(
+			&run.ID,
+			&run.ScheduledTransferID,
+			&run.ScheduledFor,
+			&run.Attempt,
+			&run.Status,
+			&run.TransferID,
+			&run.ErrorCode,
+			&run.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		runs = append(runs, run)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return runs, nil
+}
+
+func collectTransfers(rows *sql.Rows) ([]*scheduled.Transfer, error) {
+	defer rows.Close()
+
+	transfers := make([]*scheduled.Transfer, 0)
+
+	for rows.Next() {
+		t, err := scanTransfer(rows)
+		if err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return transfers, nil
+}
@@ -0,0 +1,100 @@
+package scheduled
+
+import (
+	"fmt"
+	"time"
+)
+
+// Status is the lifecycle state of a scheduled transfer. Only active ones are
+// picked up by the worker; completed, cancelled and failed are final.
+type Status string
+
+const (
+	StatusActive    Status = "active"
+	StatusPaused    Status = "paused"
+	StatusCompleted Status = "completed"
+	StatusCancelled Status = "cancelled"
+	StatusFailed    Status = "failed"
+)
+
+// Editable reports whether the owner may still change the transfer.
+func (s Status) Editable() bool {
+	return s == StatusActive || s == StatusPaused
+}
+
+// Transfer is a standing order: a one-off transfer at a set time, or a
+// recurring one when Schedule holds a cron expression (evaluated in UTC).
+type Transfer struct {
+	ID            int64      `json:"id"`
+	OwnerID       int64      `json:"owner_id"`
+	FromAccountID int64      `json:"from_account_id"`
+	ToAccountID   int64      `json:"to_account_id"`
+	Amount        int64      `json:"amount"`
+	Currency      string     `json:"currency"`
+	Schedule      string     `json:"schedule,omitempty" example:"0 9 1 * *"`
+	Status        Status     `json:"status"`
+	ScheduledFor  *time.Time `json:"scheduled_for,omitempty"` // current occurrence
+	NextRunAt     *time.Time `json:"next_run_at,omitempty"`   // later than ScheduledFor while retrying
+	Attempts      int        `json:"attempts"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+func (t *Transfer) Recurring() bool {
+	return t.Schedule != ""
+}
+
+// IdempotencyKey is unique per occurrence, so a retry after a crash replays
+// the transfer instead of sending it twice.
+func (t *Transfer) IdempotencyKey() string {
+	return fmt.Sprintf("scheduled-%d-%d", t.ID, t.ScheduledFor.Unix())
+}
+
+// Pending reports whether the current occurrence fell due by now or is being
+// retried. It may already have been sent under its idempotency key, so its
+// amount can't change until it is resolved.
+func (t *Transfer) Pending(now time.Time) bool {
+	return t.Attempts > 0 || (t.ScheduledFor != nil && !t.ScheduledFor.After(now))
+}
+
+// Reschedule points the transfer at a new occurrence with a clean retry count.
+func (t *Transfer) Reschedule(at time.Time) {
+	t.ScheduledFor = &at
+	t.NextRunAt = &at
+	t.Attempts = 0
+}
+
+// Finish moves the transfer to a final status.
+func (t *Transfer) Finish(status Status) {
+	t.Status = status
+	t.ScheduledFor = nil
+	t.NextRunAt = nil
+}
+
+type RunStatus string
+
+const (
+	RunSucceeded RunStatus = "succeeded"
+	RunFailed    RunStatus = "failed"
+)
+
+// Run records one execution attempt. ErrorCode is the stable error code,
+// e.g. TRANSFER_INSUFFICIENT_FUNDS.
+type Run struct {
+	ID                  int64     `json:"id"`
+	ScheduledTransferID int64     `json:"scheduled_transfer_id"`
+	ScheduledFor        time.Time `json:"scheduled_for"`
+	Attempt             int       `json:"attempt"`
+	Status              RunStatus `json:"status"`
+	TransferID          *int64    `json:"transfer_id,omitempty"`
+	ErrorCode           string    `json:"error_code,omitempty"`
+	CreatedAt           time.Time `json:"created_at"`
+}
+
+// RetryPolicy controls how often a failed occurrence is retried. Attempt n
+// waits n*Delay; after MaxAttempts a recurring transfer skips to its next
+// occurrence and a one-off fails.
+type RetryPolicy struct {
+	MaxAttempts int
+	Delay       time.Duration
+}
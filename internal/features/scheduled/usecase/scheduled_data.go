@@ -0,0 +1,81 @@
+package scheduledusecase
+
+import (
+	"strings"
+	"time"
+
+	"github.com/codepnw/simple-bank/internal/features/scheduled"
+	"github.com/codepnw/simple-bank/pkg/cron"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+)
+
+// CreateParams describes a one-off transfer at RunAt, or a recurring one when
+// Schedule is set. A recurring transfer starts at its first occurrence at or
+// after RunAt (default now).
+type CreateParams struct {
+	FromAccountID int64
+	ToAccountID   int64
+	Amount        int64
+	Currency      string
+	Schedule      string
+	RunAt         *time.Time
+}
+
+// firstRun validates the timing and returns the first occurrence.
+func (p *CreateParams) firstRun(now time.Time) (time.Time, error) {
+	if p.Amount <= 0 {
+		return time.Time{}, errs.ErrInvalidAmount
+	}
+	if p.FromAccountID == p.ToAccountID {
+		return time.Time{}, errs.ErrTransferToSelf
+	}
+
+	p.Schedule = strings.TrimSpace(p.Schedule)
+	if p.Schedule == "" {
+		if p.RunAt == nil {
+			return time.Time{}, errs.ErrRunAtRequired
+		}
+		if !p.RunAt.After(now) {
+			return time.Time{}, errs.ErrRunAtInPast
+		}
+		return p.RunAt.UTC(), nil
+	}
+
+	start := now
+	if p.RunAt != nil && p.RunAt.After(now) {
+		start = *p.RunAt
+	}
+	// At or after start: a start on the minute is itself an occurrence
+	return occurrenceAfter(p.Schedule, start.Add(-time.Second))
+}
+
+// UpdateParams changes the amount or pauses/resumes; nil fields stay as they
+// are.
+type UpdateParams struct {
+	ID     int64
+	Amount *int64
+	Status *scheduled.Status
+}
+
+func (p *UpdateParams) validate() error {
+	if p.Amount != nil && *p.Amount <= 0 {
+		return errs.ErrInvalidAmount
+	}
+	if p.Status != nil && *p.Status != scheduled.StatusActive && *p.Status != scheduled.StatusPaused {
+		return errs.ErrInvalidScheduleStatus
+	}
+	return nil
+}
+
+// occurrenceAfter is the first occurrence of expr strictly after t, in UTC.
+func occurrenceAfter(expr string, t time.Time) (time.Time, error) {
+	sched, err := cron.Parse(expr)
+	if err != nil {
+		return time.Time{}, errs.ErrInvalidSchedule.Wrap(err)
+	}
+	next := sched.Next(t.UTC())
+	if next.IsZero() {
+		return time.Time{}, errs.ErrInvalidSchedule
+	}
+	return next, nil
+}
@@ -0,0 +1,387 @@
+package scheduledusecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/codepnw/simple-bank/internal/consts"
+	"github.com/codepnw/simple-bank/internal/features/account"
+	accountrepository "github.com/codepnw/simple-bank/internal/features/account/repository"
+	"github.com/codepnw/simple-bank/internal/features/scheduled"
+	scheduledrepository "github.com/codepnw/simple-bank/internal/features/scheduled/repository"
+	transferusecase "github.com/codepnw/simple-bank/internal/features/transfer/usecase"
+	"github.com/codepnw/simple-bank/internal/features/user"
+	"github.com/codepnw/simple-bank/pkg/auth"
+	"github.com/codepnw/simple-bank/pkg/currency"
+	"github.com/codepnw/simple-bank/pkg/database"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/codepnw/simple-bank/pkg/utils/pagination"
+)
+
+type ScheduledUsecase interface {
+	Create(ctx context.Context, input *CreateParams) (*scheduled.Transfer, error)
+	Get(ctx context.Context, id int64) (*scheduled.Transfer, error)
+	List(ctx context.Context, page *pagination.Page) ([]*scheduled.Transfer, *pagination.Meta, error)
+	Update(ctx context.Context, input *UpdateParams) (*scheduled.Transfer, error)
+	Cancel(ctx context.Context, id int64) (*scheduled.Transfer, error)
+	ListRuns(ctx context.Context, id int64) ([]*scheduled.Run, error)
+
+	// RunDue executes up to limit due transfers for the background worker and
+	// returns how many it claimed.
+	RunDue(ctx context.Context, limit int) (int, error)
+}
+
+type scheduledUsecase struct {
+	repo       scheduledrepository.ScheduledRepository
+	accRepo    accountrepository.AccountRepository
+	transfers  transferusecase.TransferUsecase
+	tx         database.TxManager
+	currencies *currency.Registry
+	retry      scheduled.RetryPolicy
+}
+
+func NewScheduledUsecase(
+	repo scheduledrepository.ScheduledRepository,
+	accRepo accountrepository.AccountRepository,
+	transfers transferusecase.TransferUsecase,
+	tx database.TxManager,
+	currencies *currency.Registry,
+	retry scheduled.RetryPolicy,
+) ScheduledUsecase {
+	return &scheduledUsecase{
+		repo:       repo,
+		accRepo:    accRepo,
+		transfers:  transfers,
+		tx:         tx,
+		currencies: currencies,
+		retry:      retry,
+	}
+}
+
+func (u *scheduledUsecase) Create(ctx context.Context, input *CreateParams) (*scheduled.Transfer, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	userID := auth.GetUserID(ctx)
+	if userID == 0 {
+		return nil, errs.ErrNoUserID
+	}
+
+	first, err := input.firstRun(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// The transfer itself re-checks all of this when it runs; failing early
+	// saves the customer a standing order that can never succeed
+	cur, err := u.currencies.Lookup(input.Currency)
+	if err != nil {
+		return nil, err
+	}
+	fromAcc, err := u.accRepo.FindByID(ctx, input.FromAccountID)
+	if err != nil {
+		return nil, err
+	}
+	if fromAcc.OwnerID != userID || fromAcc.Type != account.TypeCustomer {
+		return nil, errs.ErrAccountNotFound
+	}
+	if fromAcc.Currency != account.AccountCurrency(cur.Code) {
+		return nil, errs.ErrCurrencyMismatch
+	}
+	if err = fromAcc.CheckActive(); err != nil {
+		return nil, err
+	}
+	toAcc, err := u.accRepo.FindByID(ctx, input.ToAccountID)
+	if err != nil {
+		return nil, err
+	}
+	if toAcc.Type != account.TypeCustomer {
+		return nil, errs.ErrAccountNotFound
+	}
+
+	t := &scheduled.Transfer{
+		OwnerID:       userID,
+		FromAccountID: input.FromAccountID,
+		ToAccountID:   input.ToAccountID,
+		Amount:        input.Amount,
+		Currency:      cur.Code,
+		Schedule:      input.Schedule,
+	}
+	t.Reschedule(first)
+
+	return u.repo.Insert(ctx, t)
+}
+
+func (u *scheduledUsecase) Get(ctx context.Context, id int64) (*scheduled.Transfer, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	return u.findOwned(ctx, id)
+}
+
+func (u *scheduledUsecase) List(ctx context.Context, page *pagination.Page) ([]*scheduled.Transfer, *pagination.Meta, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	userID := auth.GetUserID(ctx)
+	if userID == 0 {
+		return nil, nil, errs.ErrNoUserID
+	}
+
+	transfers, err := u.repo.List(ctx, userID, page)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transfers, meta := pagination.Trim(transfers, page, func(t *scheduled.Transfer) pagination.Cursor {
+		return pagination.Cursor{CreatedAt: t.CreatedAt, ID: t.ID}
+	})
+	return transfers, meta, nil
+}
+
+func (u *scheduledUsecase) Update(ctx context.Context, input *UpdateParams) (*scheduled.Transfer, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	if err := input.validate(); err != nil {
+		return nil, err
+	}
+
+	return u.modify(ctx, input.ID, func(t *scheduled.Transfer) error {
+		now := time.Now()
+
+		if input.Status != nil && *input.Status != t.Status {
+			t.Status = *input.Status
+			if t.Status == scheduled.StatusActive {
+				if err := u.resume(t, now); err != nil {
+					return err
+				}
+			}
+		}
+		if input.Amount != nil && *input.Amount != t.Amount {
+			// The idempotency key doesn't cover the amount, so a retry
+			// with a new one would conflict with a transfer already sent
+			if t.Pending(now) {
+				return errs.ErrScheduleRunPending
+			}
+			t.Amount = *input.Amount
+		}
+		return nil
+	})
+}
+
+func (u *scheduledUsecase) Cancel(ctx context.Context, id int64) (*scheduled.Transfer, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	return u.modify(ctx, id, func(t *scheduled.Transfer) error {
+		t.Finish(scheduled.StatusCancelled)
+		return nil
+	})
+}
+
+func (u *scheduledUsecase) ListRuns(ctx context.Context, id int64) ([]*scheduled.Run, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	if _, err := u.findOwned(ctx, id); err != nil {
+		return nil, err
+	}
+	return u.repo.ListRuns(ctx, id)
+}
+
+// findOwned hides other owners' transfers behind not found.
+func (u *scheduledUsecase) findOwned(ctx context.Context, id int64) (*scheduled.Transfer, error) {
+	userID := auth.GetUserID(ctx)
+	if userID == 0 {
+		return nil, errs.ErrNoUserID
+	}
+
+	t, err := u.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if t.OwnerID != userID {
+		return nil, errs.ErrScheduledTransferNotFound
+	}
+	return t, nil
+}
+
+// modify applies fn to an editable transfer under its row lock, so a change
+// can't interleave with the worker executing it.
+func (u *scheduledUsecase) modify(ctx context.Context, id int64, fn func(t *scheduled.Transfer) error) (*scheduled.Transfer, error) {
+	userID := auth.GetUserID(ctx)
+	if userID == 0 {
+		return nil, errs.ErrNoUserID
+	}
+
+	var updated *scheduled.Transfer
+	err := u.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
+		t, err := u.repo.FindByIDForUpdate(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if t.OwnerID != userID {
+			return errs.ErrScheduledTransferNotFound
+		}
+		if !t.Status.Editable() {
+			return errs.ErrScheduleFinished
+		}
+
+		if err = fn(t); err != nil {
+			return err
+		}
+		updated, err = u.repo.Update(ctx, tx, t)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// resume picks up where a paused transfer left off. Occurrences missed while
+// paused are skipped; a one-off whose time has passed runs right away.
+func (u *scheduledUsecase) resume(t *scheduled.Transfer, now time.Time) error {
+	if t.ScheduledFor != nil && t.ScheduledFor.After(now) {
+		t.Reschedule(*t.ScheduledFor)
+		return nil
+	}
+	if !t.Recurring() {
+		t.Reschedule(now)
+		return nil
+	}
+
+	next, err := occurrenceAfter(t.Schedule, now)
+	if err != nil {
+		return err
+	}
+	t.Reschedule(next)
+	return nil
+}
+
+func (u *scheduledUsecase) RunDue(ctx context.Context, limit int) (int, error) {
+	var claimed int
+
+	// The claimed rows stay locked until every run is recorded. Each transfer
+	// commits in its own transaction; if recording fails, the retry replays
+	// it through the occurrence's idempotency key.
+	err := u.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
+		due, err := u.repo.ClaimDue(ctx, tx, limit)
+		if err != nil {
+			return err
+		}
+		claimed = len(due)
+
+		for _, t := range due {
+			run := u.execute(ctx, t)
+
+			if err = u.repo.InsertRun(ctx, tx, run); err != nil {
+				return err
+			}
+			if _, err = u.repo.Update(ctx, tx, t); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return claimed, nil
+}
+
+// execute sends one occurrence as its owner and moves t to what comes next.
+func (u *scheduledUsecase) execute(ctx context.Context, t *scheduled.Transfer) *scheduled.Run {
+	run := &scheduled.Run{
+		ScheduledTransferID: t.ID,
+		ScheduledFor:        *t.ScheduledFor,
+		Attempt:             t.Attempts + 1,
+	}
+
+	ctx = auth.SetUserID(ctx, t.OwnerID)
+	ctx = auth.SetRole(ctx, user.RoleCustomer)
+
+	result, err := u.transfers.Transfer(ctx, &transferusecase.TransferParams{
+		FromAccountID:  t.FromAccountID,
+		ToAccountID:    t.ToAccountID,
+		Amount:         t.Amount,
+		Currency:       t.Currency,
+		IdempotencyKey: t.IdempotencyKey(),
+	})
+	now := time.Now()
+
+	if err == nil {
+		run.Status = scheduled.RunSucceeded
+		run.TransferID = &result.Transfer.ID
+		u.advance(t, now, scheduled.StatusCompleted)
+		return run
+	}
+
+	e := errs.From(err)
+	if e.HTTPStatus >= http.StatusInternalServerError {
+		log.Printf("scheduled transfer %d failed: %v", t.ID, err)
+	}
+	run.Status = scheduled.RunFailed
+	run.ErrorCode = e.Code
+
+	// The key is per occurrence: a conflict means it was already sent with
+	// other details, so it isn't sent again and the order moves on
+	if errors.Is(err, errs.ErrIdempotencyKeyConflict) {
+		u.advance(t, now, scheduled.StatusCompleted)
+		return run
+	}
+
+	t.Attempts++
+	switch {
+	case permanent(err):
+		t.Finish(scheduled.StatusFailed)
+	case t.Attempts < u.retry.MaxAttempts:
+		retryAt := now.Add(u.retry.Delay * time.Duration(t.Attempts))
+		t.NextRunAt = &retryAt
+	default:
+		u.advance(t, now, scheduled.StatusFailed)
+	}
+	return run
+}
+
+// advance moves a recurring transfer to its next occurrence after now, so a
+// worker outage doesn't fire a backlog of missed ones. A one-off ends with
+// final.
+func (u *scheduledUsecase) advance(t *scheduled.Transfer, now time.Time, final scheduled.Status) {
+	if !t.Recurring() {
+		t.Finish(final)
+		return
+	}
+
+	from := *t.ScheduledFor
+	if now.After(from) {
+		from = now
+	}
+	next, err := occurrenceAfter(t.Schedule, from)
+	if err != nil {
+		// The schedule was validated at creation; nothing left to run
+		t.Finish(scheduled.StatusCompleted)
+		return
+	}
+	t.Reschedule(next)
+}
+
+// permanent errors won't go away by retrying.
+func permanent(err error) bool {
+	for _, target := range []error{
+		errs.ErrAccountNotFound,
+		errs.ErrAccountClosed,
+		errs.ErrCurrencyMismatch,
+		errs.ErrInvalidCurrency,
+	} {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
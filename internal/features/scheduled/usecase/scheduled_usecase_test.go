@@ -0,0 +1,410 @@
+package scheduledusecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/codepnw/simple-bank/internal/features/account"
+	accountrepository "github.com/codepnw/simple-bank/internal/features/account/repository"
+	"github.com/codepnw/simple-bank/internal/features/scheduled"
+	scheduledrepository "github.com/codepnw/simple-bank/internal/features/scheduled/repository"
+	scheduledusecase "github.com/codepnw/simple-bank/internal/features/scheduled/usecase"
+	"github.com/codepnw/simple-bank/internal/features/transfer"
+	transferusecase "github.com/codepnw/simple-bank/internal/features/transfer/usecase"
+	"github.com/codepnw/simple-bank/internal/mocks"
+	"github.com/codepnw/simple-bank/pkg/auth"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateScheduled(t *testing.T) {
+	future := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	type testCase struct {
+		name        string
+		input       *scheduledusecase.CreateParams
+		mockFn      func(repo *scheduledrepository.MockScheduledRepository, accRepo *accountrepository.MockAccountRepository)
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:  "success one-off",
+			input: &scheduledusecase.CreateParams{FromAccountID: 10, ToAccountID: 20, Amount: 100, Currency: "THB", RunAt: &future},
+			mockFn: func(repo *scheduledrepository.MockScheduledRepository, accRepo *accountrepository.MockAccountRepository) {
+				accRepo.EXPECT().FindByID(gomock.Any(), int64(10)).Return(mocks.MockAccountData(), nil).Times(1)
+				toAcc := mocks.MockAccountData()
+				toAcc.ID, toAcc.OwnerID = 20, 20
+				accRepo.EXPECT().FindByID(gomock.Any(), int64(20)).Return(toAcc, nil).Times(1)
+
+				repo.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, in *scheduled.Transfer) (*scheduled.Transfer, error) {
+					assert.True(t, in.NextRunAt.Equal(future))
+					assert.False(t, in.Recurring())
+					return in, nil
+				}).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:  "success recurring",
+			input: &scheduledusecase.CreateParams{FromAccountID: 10, ToAccountID: 20, Amount: 100, Currency: "THB", Schedule: "0 9 1 * *"},
+			mockFn: func(repo *scheduledrepository.MockScheduledRepository, accRepo *accountrepository.MockAccountRepository) {
+				accRepo.EXPECT().FindByID(gomock.Any(), int64(10)).Return(mocks.MockAccountData(), nil).Times(1)
+				accRepo.EXPECT().FindByID(gomock.Any(), int64(20)).Return(mocks.MockAccountData(), nil).Times(1)
+
+				repo.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, in *scheduled.Transfer) (*scheduled.Transfer, error) {
+					next := in.NextRunAt.UTC()
+					assert.Equal(t, 1, next.Day())
+					assert.Equal(t, 9, next.Hour())
+					return in, nil
+				}).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:  "fail run_at required",
+			input: &scheduledusecase.CreateParams{FromAccountID: 10, ToAccountID: 20, Amount: 100, Currency: "THB"},
+			mockFn: func(repo *scheduledrepository.MockScheduledRepository, accRepo *accountrepository.MockAccountRepository) {
+			},
+			expectedErr: errs.ErrRunAtRequired,
+		},
+		{
+			name:  "fail run_at in past",
+			input: &scheduledusecase.CreateParams{FromAccountID: 10, ToAccountID: 20, Amount: 100, Currency: "THB", RunAt: &past},
+			mockFn: func(repo *scheduledrepository.MockScheduledRepository, accRepo *accountrepository.MockAccountRepository) {
+			},
+			expectedErr: errs.ErrRunAtInPast,
+		},
+		{
+			name:  "fail invalid schedule",
+			input: &scheduledusecase.CreateParams{FromAccountID: 10, ToAccountID: 20, Amount: 100, Currency: "THB", Schedule: "61 * * * *"},
+			mockFn: func(repo *scheduledrepository.MockScheduledRepository, accRepo *accountrepository.MockAccountRepository) {
+			},
+			expectedErr: errs.ErrInvalidSchedule,
+		},
+		{
+			name:  "fail not owner",
+			input: &scheduledusecase.CreateParams{FromAccountID: 10, ToAccountID: 20, Amount: 100, Currency: "THB", RunAt: &future},
+			mockFn: func(repo *scheduledrepository.MockScheduledRepository, accRepo *accountrepository.MockAccountRepository) {
+				fromAcc := mocks.MockAccountData()
+				fromAcc.OwnerID = 99
+				accRepo.EXPECT().FindByID(gomock.Any(), int64(10)).Return(fromAcc, nil).Times(1)
+			},
+			expectedErr: errs.ErrAccountNotFound,
+		},
+		{
+			name:  "fail account frozen",
+			input: &scheduledusecase.CreateParams{FromAccountID: 10, ToAccountID: 20, Amount: 100, Currency: "THB", RunAt: &future},
+			mockFn: func(repo *scheduledrepository.MockScheduledRepository, accRepo *accountrepository.MockAccountRepository) {
+				fromAcc := mocks.MockAccountData()
+				fromAcc.Status = account.StatusFrozen
+				accRepo.EXPECT().FindByID(gomock.Any(), int64(10)).Return(fromAcc, nil).Times(1)
+			},
+			expectedErr: errs.ErrAccountFrozen,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, repo, accRepo, _ := setup(t)
+
+			tc.mockFn(repo, accRepo)
+
+			ctx := auth.SetUserID(context.Background(), 10)
+			result, err := uc.Create(ctx, tc.input)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, result)
+			}
+		})
+	}
+}
+
+func TestRunDue(t *testing.T) {
+	type testCase struct {
+		name   string
+		job    func() *scheduled.Transfer
+		mockFn func(transfers *transferusecase.MockTransferUsecase)
+		check  func(t *testing.T, job *scheduled.Transfer, run *scheduled.Run)
+	}
+
+	testCases := []testCase{
+		{
+			name: "success one-off completes",
+			job:  func() *scheduled.Transfer { return mockJob("") },
+			mockFn: func(transfers *transferusecase.MockTransferUsecase) {
+				transfers.EXPECT().Transfer(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, in *transferusecase.TransferParams) (*transferusecase.TransferResult, error) {
+					assert.Equal(t, int64(10), auth.GetUserID(ctx))
+					assert.NotEmpty(t, in.IdempotencyKey)
+					return &transferusecase.TransferResult{Transfer: &transfer.Transfer{ID: 77}}, nil
+				}).Times(1)
+			},
+			check: func(t *testing.T, job *scheduled.Transfer, run *scheduled.Run) {
+				assert.Equal(t, scheduled.RunSucceeded, run.Status)
+				assert.Equal(t, int64(77), *run.TransferID)
+				assert.Equal(t, scheduled.StatusCompleted, job.Status)
+				assert.Nil(t, job.NextRunAt)
+			},
+		},
+		{
+			name: "success recurring advances",
+			job:  func() *scheduled.Transfer { return mockJob("0 9 * * *") },
+			mockFn: func(transfers *transferusecase.MockTransferUsecase) {
+				transfers.EXPECT().Transfer(gomock.Any(), gomock.Any()).Return(&transferusecase.TransferResult{Transfer: &transfer.Transfer{ID: 77}}, nil).Times(1)
+			},
+			check: func(t *testing.T, job *scheduled.Transfer, run *scheduled.Run) {
+				assert.Equal(t, scheduled.StatusActive, job.Status)
+				assert.True(t, job.NextRunAt.After(time.Now()))
+				assert.Equal(t, 9, job.NextRunAt.Hour())
+				assert.Zero(t, job.Attempts)
+			},
+		},
+		{
+			name: "fail insufficient funds retries",
+			job:  func() *scheduled.Transfer { return mockJob("") },
+			mockFn: func(transfers *transferusecase.MockTransferUsecase) {
+				transfers.EXPECT().Transfer(gomock.Any(), gomock.Any()).Return(nil, errs.ErrMoneyNotEnough).Times(1)
+			},
+			check: func(t *testing.T, job *scheduled.Transfer, run *scheduled.Run) {
+				assert.Equal(t, scheduled.RunFailed, run.Status)
+				assert.Equal(t, errs.ErrMoneyNotEnough.Code, run.ErrorCode)
+				assert.Equal(t, scheduled.StatusActive, job.Status)
+				assert.Equal(t, 1, job.Attempts)
+				assert.True(t, job.NextRunAt.After(*job.ScheduledFor))
+			},
+		},
+		{
+			name: "fail last attempt one-off fails",
+			job: func() *scheduled.Transfer {
+				job := mockJob("")
+				job.Attempts = 2
+				return job
+			},
+			mockFn: func(transfers *transferusecase.MockTransferUsecase) {
+				transfers.EXPECT().Transfer(gomock.Any(), gomock.Any()).Return(nil, errs.ErrMoneyNotEnough).Times(1)
+			},
+			check: func(t *testing.T, job *scheduled.Transfer, run *scheduled.Run) {
+				assert.Equal(t, 3, run.Attempt)
+				assert.Equal(t, scheduled.StatusFailed, job.Status)
+			},
+		},
+		{
+			name: "fail last attempt recurring skips occurrence",
+			job: func() *scheduled.Transfer {
+				job := mockJob("0 9 * * *")
+				job.Attempts = 2
+				return job
+			},
+			mockFn: func(transfers *transferusecase.MockTransferUsecase) {
+				transfers.EXPECT().Transfer(gomock.Any(), gomock.Any()).Return(nil, errs.ErrMoneyNotEnough).Times(1)
+			},
+			check: func(t *testing.T, job *scheduled.Transfer, run *scheduled.Run) {
+				assert.Equal(t, scheduled.StatusActive, job.Status)
+				assert.Zero(t, job.Attempts)
+				assert.True(t, job.NextRunAt.After(time.Now()))
+			},
+		},
+		{
+			name: "fail key conflict moves on to the next occurrence",
+			job:  func() *scheduled.Transfer { return mockJob("0 9 * * *") },
+			mockFn: func(transfers *transferusecase.MockTransferUsecase) {
+				transfers.EXPECT().Transfer(gomock.Any(), gomock.Any()).Return(nil, errs.ErrIdempotencyKeyConflict).Times(1)
+			},
+			check: func(t *testing.T, job *scheduled.Transfer, run *scheduled.Run) {
+				assert.Equal(t, errs.ErrIdempotencyKeyConflict.Code, run.ErrorCode)
+				assert.Equal(t, scheduled.StatusActive, job.Status)
+				assert.Zero(t, job.Attempts)
+				assert.True(t, job.NextRunAt.After(time.Now()))
+			},
+		},
+		{
+			name: "fail account closed is permanent",
+			job:  func() *scheduled.Transfer { return mockJob("0 9 * * *") },
+			mockFn: func(transfers *transferusecase.MockTransferUsecase) {
+				transfers.EXPECT().Transfer(gomock.Any(), gomock.Any()).Return(nil, errs.ErrAccountClosed).Times(1)
+			},
+			check: func(t *testing.T, job *scheduled.Transfer, run *scheduled.Run) {
+				assert.Equal(t, scheduled.StatusFailed, job.Status)
+				assert.Nil(t, job.NextRunAt)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, repo, _, transfers := setup(t)
+
+			job := tc.job()
+			var run *scheduled.Run
+
+			repo.EXPECT().ClaimDue(gomock.Any(), gomock.Any(), 10).Return([]*scheduled.Transfer{job}, nil).Times(1)
+			tc.mockFn(transfers)
+			repo.EXPECT().InsertRun(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ any, in *scheduled.Run) error {
+				run = in
+				return nil
+			}).Times(1)
+			repo.EXPECT().Update(gomock.Any(), gomock.Any(), job).Return(job, nil).Times(1)
+
+			n, err := uc.RunDue(context.Background(), 10)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 1, n)
+			tc.check(t, job, run)
+		})
+	}
+}
+
+func TestUpdateScheduled(t *testing.T) {
+	paused := scheduled.StatusPaused
+	active := scheduled.StatusActive
+	completed := scheduled.StatusCompleted
+	amount := int64(250)
+
+	type testCase struct {
+		name        string
+		input       *scheduledusecase.UpdateParams
+		job         func() *scheduled.Transfer
+		check       func(t *testing.T, result *scheduled.Transfer)
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:  "success pause",
+			input: &scheduledusecase.UpdateParams{ID: 1, Status: &paused},
+			job:   func() *scheduled.Transfer { return mockJob("0 9 * * *") },
+			check: func(t *testing.T, result *scheduled.Transfer) {
+				assert.Equal(t, scheduled.StatusPaused, result.Status)
+			},
+		},
+		{
+			name:  "success resume skips missed occurrences",
+			input: &scheduledusecase.UpdateParams{ID: 1, Status: &active},
+			job: func() *scheduled.Transfer {
+				job := mockJob("0 9 * * *")
+				job.Status = scheduled.StatusPaused
+				return job
+			},
+			check: func(t *testing.T, result *scheduled.Transfer) {
+				assert.Equal(t, scheduled.StatusActive, result.Status)
+				assert.True(t, result.NextRunAt.After(time.Now()))
+			},
+		},
+		{
+			name:  "success amount of an upcoming occurrence",
+			input: &scheduledusecase.UpdateParams{ID: 1, Amount: &amount},
+			job: func() *scheduled.Transfer {
+				job := mockJob("0 9 * * *")
+				job.Reschedule(time.Now().Add(time.Hour))
+				return job
+			},
+			check: func(t *testing.T, result *scheduled.Transfer) {
+				assert.Equal(t, amount, result.Amount)
+			},
+		},
+		{
+			name:        "fail amount while occurrence due",
+			input:       &scheduledusecase.UpdateParams{ID: 1, Amount: &amount},
+			job:         func() *scheduled.Transfer { return mockJob("0 9 * * *") },
+			expectedErr: errs.ErrScheduleRunPending,
+		},
+		{
+			name:  "fail amount while occurrence retried",
+			input: &scheduledusecase.UpdateParams{ID: 1, Amount: &amount},
+			job: func() *scheduled.Transfer {
+				job := mockJob("0 9 * * *")
+				retryAt := time.Now().Add(time.Minute)
+				job.NextRunAt, job.Attempts = &retryAt, 1
+				return job
+			},
+			expectedErr: errs.ErrScheduleRunPending,
+		},
+		{
+			name:        "fail invalid status",
+			input:       &scheduledusecase.UpdateParams{ID: 1, Status: &completed},
+			expectedErr: errs.ErrInvalidScheduleStatus,
+		},
+		{
+			name:  "fail not owner",
+			input: &scheduledusecase.UpdateParams{ID: 1, Status: &paused},
+			job: func() *scheduled.Transfer {
+				job := mockJob("")
+				job.OwnerID = 99
+				return job
+			},
+			expectedErr: errs.ErrScheduledTransferNotFound,
+		},
+		{
+			name:  "fail finished",
+			input: &scheduledusecase.UpdateParams{ID: 1, Status: &paused},
+			job: func() *scheduled.Transfer {
+				job := mockJob("")
+				job.Finish(scheduled.StatusCompleted)
+				return job
+			},
+			expectedErr: errs.ErrScheduleFinished,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, repo, _, _ := setup(t)
+
+			if tc.job != nil {
+				repo.EXPECT().FindByIDForUpdate(gomock.Any(), gomock.Any(), tc.input.ID).Return(tc.job(), nil).Times(1)
+			}
+			if tc.expectedErr == nil {
+				repo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ any, in *scheduled.Transfer) (*scheduled.Transfer, error) {
+					return in, nil
+				}).Times(1)
+			}
+
+			ctx := auth.SetUserID(context.Background(), 10)
+			result, err := uc.Update(ctx, tc.input)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				tc.check(t, result)
+			}
+		})
+	}
+}
+
+// mockJob is a transfer of account 10 (owner 10) that fell due a minute ago.
+func mockJob(schedule string) *scheduled.Transfer {
+	job := &scheduled.Transfer{
+		ID:            1,
+		OwnerID:       10,
+		FromAccountID: 10,
+		ToAccountID:   20,
+		Amount:        100,
+		Currency:      "THB",
+		Schedule:      schedule,
+		Status:        scheduled.StatusActive,
+	}
+	job.Reschedule(time.Now().Add(-time.Minute).UTC())
+	return job
+}
+
+func setup(t *testing.T) (scheduledusecase.ScheduledUsecase, *scheduledrepository.MockScheduledRepository, *accountrepository.MockAccountRepository, *transferusecase.MockTransferUsecase) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := scheduledrepository.NewMockScheduledRepository(ctrl)
+	accRepo := accountrepository.NewMockAccountRepository(ctrl)
+	transfers := transferusecase.NewMockTransferUsecase(ctrl)
+	retry := scheduled.RetryPolicy{MaxAttempts: 3, Delay: time.Minute}
+	uc := scheduledusecase.NewScheduledUsecase(repo, accRepo, transfers, &mocks.MockTx{}, mocks.MockCurrencies(), retry)
+
+	return uc, repo, accRepo, transfers
+}
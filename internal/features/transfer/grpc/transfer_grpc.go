@@ -3,11 +3,12 @@ package transfergrpc
 import (
 	"context"
 
+	"github.com/codepnw/simple-bank/internal/consts"
+	accountgrpc "github.com/codepnw/simple-bank/internal/features/account/grpc"
+	"github.com/codepnw/simple-bank/internal/features/fee"
 	transferusecase "github.com/codepnw/simple-bank/internal/features/transfer/usecase"
 	pb "github.com/codepnw/simple-bank/pb/proto"
-	"github.com/codepnw/simple-bank/pkg/utils/errs"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -22,26 +23,16 @@ func NewTransferServer(uc transferusecase.TransferUsecase) *TransferServer {
 
 func (s *TransferServer) CreateTransfer(ctx context.Context, req *pb.CreateTransferRequest) (*pb.CreateTransferResponse, error) {
 	input := &transferusecase.TransferParams{
-		FromAccountID: req.GetFromAccountId(),
-		ToAccountID:   req.GetToAccountId(),
-		Amount:        req.GetAmount(),
-		Currency:      req.GetCurrency(),
+		FromAccountID:  req.GetFromAccountId(),
+		ToAccountID:    req.GetToAccountId(),
+		Amount:         req.GetAmount(),
+		Currency:       req.GetCurrency(),
+		IdempotencyKey: idempotencyKey(ctx, req),
 	}
 
 	data, err := s.uc.Transfer(ctx, input)
 	if err != nil {
-		switch err {
-		case errs.ErrAccountNotFound:
-			return nil, status.Error(codes.NotFound, err.Error())
-		case errs.ErrTransferToSelf:
-			return nil, status.Error(codes.InvalidArgument, err.Error())
-		case errs.ErrCurrencyMismatch:
-			return nil, status.Error(codes.InvalidArgument, err.Error())
-		case errs.ErrMoneyNotEnough:
-			return nil, status.Error(codes.InvalidArgument, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, err
 	}
 
 	resp := &pb.CreateTransferResponse{
@@ -50,32 +41,96 @@ func (s *TransferServer) CreateTransfer(ctx context.Context, req *pb.CreateTrans
 			FromAccountId: data.Transfer.FromAccountID,
 			ToAccountId:   data.Transfer.ToAccountID,
 			Amount:        data.Transfer.Amount,
+			ToAmount:      data.Transfer.ToAmount,
+			ExchangeRate:  data.Transfer.ExchangeRate.String(),
+			Fee:           data.Transfer.Fee,
+			ReversalOf:    data.Transfer.ReversalOf,
+			ReversedBy:    data.Transfer.ReversedBy,
 			CreatedAt:     timestamppb.New(data.Transfer.CreatedAt),
 		},
-		FromAccount: &pb.Account{
-			Id:       data.FromAccount.ID,
-			OwnerId:  data.FromAccount.OwnerID,
-			Balance:  data.FromAccount.Balance,
-			Currency: string(data.FromAccount.Currency),
-		},
-		ToAccount: &pb.Account{
-			Id:       data.ToAccount.ID,
-			OwnerId:  data.ToAccount.OwnerID,
-			Balance:  data.ToAccount.Balance,
-			Currency: string(data.ToAccount.Currency),
-		},
+		FromAccount: accountgrpc.ToPbAccount(data.FromAccount),
+		ToAccount:   accountgrpc.ToPbAccount(data.ToAccount),
 		FromEntry: &pb.Entry{
 			Id:        data.FromEntry.ID,
+			JournalId: data.FromEntry.JournalID,
 			AccountId: data.FromEntry.AccountID,
 			Amount:    data.FromEntry.Amount,
-			CreatedAt: timestamppb.New(data.Transfer.CreatedAt),
+			CreatedAt: timestamppb.New(data.FromEntry.CreatedAt),
 		},
 		ToEntry: &pb.Entry{
 			Id:        data.ToEntry.ID,
+			JournalId: data.ToEntry.JournalID,
 			AccountId: data.ToEntry.AccountID,
 			Amount:    data.ToEntry.Amount,
-			CreatedAt: timestamppb.New(data.Transfer.CreatedAt),
+			CreatedAt: timestamppb.New(data.ToEntry.CreatedAt),
 		},
+		Fee: toPbFee(data.Fee),
+	}
+	if data.FeeEntry != nil {
+		resp.FeeEntry = &pb.Entry{
+			Id:        data.FeeEntry.ID,
+			JournalId: data.FeeEntry.JournalID,
+			AccountId: data.FeeEntry.AccountID,
+			Amount:    data.FeeEntry.Amount,
+			CreatedAt: timestamppb.New(data.FeeEntry.CreatedAt),
+		}
+	}
+	return resp, nil
+}
+
+func (s *TransferServer) QuoteTransfer(ctx context.Context, req *pb.QuoteTransferRequest) (*pb.QuoteTransferResponse, error) {
+	data, err := s.uc.Quote(ctx, &transferusecase.TransferParams{
+		FromAccountID: req.GetFromAccountId(),
+		ToAccountID:   req.GetToAccountId(),
+		Amount:        req.GetAmount(),
+		Currency:      req.GetCurrency(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.QuoteTransferResponse{
+		FromAccountId: data.FromAccountID,
+		ToAccountId:   data.ToAccountID,
+		Amount:        data.Amount,
+		Currency:      data.Currency,
+		Fee:           toPbFee(data.Fee),
+		TotalDebit:    data.TotalDebit,
+		ToAmount:      data.ToAmount,
+		ToCurrency:    data.ToCurrency,
+		ExchangeRate:  data.ExchangeRate.String(),
 	}
 	return resp, nil
 }
+
+func toPbFee(f *fee.Breakdown) *pb.Fee {
+	if f == nil {
+		return nil
+	}
+	return &pb.Fee{
+		RuleId:     f.RuleID,
+		Currency:   f.Currency,
+		Flat:       f.Flat,
+		Percentage: f.Percentage,
+		Adjustment: f.Adjustment,
+		Total:      f.Total,
+	}
+}
+
+// idempotencyKey prefers the request field and falls back to the
+// "idempotency-key" metadata sent by clients that set it per call.
+func idempotencyKey(ctx context.Context, req *pb.CreateTransferRequest) string {
+	if key := req.GetIdempotencyKey(); key != "" {
+		return key
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(consts.MetadataIdempotencyKey)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
@@ -0,0 +1,179 @@
+package transferhandler
+
+import (
+	"github.com/codepnw/simple-bank/internal/consts"
+	transferusecase "github.com/codepnw/simple-bank/internal/features/transfer/usecase"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/codepnw/simple-bank/pkg/utils/helper"
+	"github.com/codepnw/simple-bank/pkg/utils/pagination"
+	"github.com/codepnw/simple-bank/pkg/utils/response"
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Authorize Hold
+// @Description reserve funds on the user's account for a later transfer; the hold lowers the available balance until it is captured, voided or expires (HOLD_TTL)
+// @Tags holds
+// @Accept       json
+// @Produce      json
+// @Param request body HoldReq true "Hold Data"
+// @Success 201 {object} transfer.Hold "Authorize Hold Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input, Insufficient Funds or Transfer Limit Exceeded"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Account Not Found"
+// @Failure 409 {object} response.ErrorResponse "Account Frozen or Closed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /transfers/holds [post]
+func (h *transferHandler) AuthorizeHold(c *gin.Context) {
+	req := new(HoldReq)
+	if err := c.ShouldBindJSON(req); err != nil {
+		response.Error(c, errs.InvalidInput(err))
+		return
+	}
+
+	data, err := h.uc.Authorize(c.Request.Context(), &transferusecase.HoldParams{
+		FromAccountID: req.FromAccountID,
+		ToAccountID:   req.ToAccountID,
+		Amount:        req.Amount,
+		Currency:      req.Currency,
+	})
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.Created(c, "hold authorized", data)
+}
+
+// @Summary Capture Hold
+// @Description turn a hold into a transfer to its destination (the recipient or an admin); amount defaults to the whole hold and any remainder is released
+// @Tags holds
+// @Accept       json
+// @Produce      json
+// @Param hold_id path int true "Hold ID"
+// @Param request body CaptureReq false "Partial Amount"
+// @Success 201 {object} transferusecase.CaptureResult "Capture Hold Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input or Transfer Limit Exceeded"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Only the Recipient Can Capture"
+// @Failure 404 {object} response.ErrorResponse "Hold Not Found"
+// @Failure 409 {object} response.ErrorResponse "Hold Not Authorized or Expired"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /transfers/holds/{hold_id}/capture [post]
+func (h *transferHandler) CaptureHold(c *gin.Context) {
+	id, err := helper.ParseInt64(c.Param(consts.ParamHoldID))
+	if err != nil {
+		response.Error(c, errs.ErrInvalidID.Wrap(err))
+		return
+	}
+
+	req := new(CaptureReq)
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(req); err != nil {
+			response.Error(c, errs.InvalidInput(err))
+			return
+		}
+	}
+
+	data, err := h.uc.Capture(c.Request.Context(), &transferusecase.CaptureParams{
+		HoldID: id,
+		Amount: req.Amount,
+	})
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.Created(c, "hold captured", data)
+}
+
+// @Summary Void Hold
+// @Description release a hold without moving money (the recipient or an admin; the payer waits for expiry)
+// @Tags holds
+// @Produce      json
+// @Param hold_id path int true "Hold ID"
+// @Success 200 {object} transfer.Hold "Void Hold Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Only the Recipient Can Void"
+// @Failure 404 {object} response.ErrorResponse "Hold Not Found"
+// @Failure 409 {object} response.ErrorResponse "Hold Not Authorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /transfers/holds/{hold_id}/void [post]
+func (h *transferHandler) VoidHold(c *gin.Context) {
+	id, err := helper.ParseInt64(c.Param(consts.ParamHoldID))
+	if err != nil {
+		response.Error(c, errs.ErrInvalidID.Wrap(err))
+		return
+	}
+
+	data, err := h.uc.Void(c.Request.Context(), id)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.Success(c, "hold voided", data)
+}
+
+// @Summary Get Hold
+// @Description get a hold by id (either side of the hold)
+// @Tags holds
+// @Produce      json
+// @Param hold_id path int true "Hold ID"
+// @Success 200 {object} transfer.Hold "Get Hold Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Hold Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /transfers/holds/{hold_id} [get]
+func (h *transferHandler) GetHold(c *gin.Context) {
+	id, err := helper.ParseInt64(c.Param(consts.ParamHoldID))
+	if err != nil {
+		response.Error(c, errs.ErrInvalidID.Wrap(err))
+		return
+	}
+
+	data, err := h.uc.GetHold(c.Request.Context(), id)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.Success(c, "", data)
+}
+
+// @Summary List Holds
+// @Description list the holds placed on one of the user's accounts, newest first
+// @Tags holds
+// @Produce      json
+// @Param account_id query int true "Account ID"
+// @Param cursor query string false "Cursor from the previous page's next_cursor"
+// @Param page query int false "Page number (offset mode, ignored when cursor is set)"
+// @Param size query int false "Page size"
+// @Success 200 {object} response.PageResponse{data=[]transfer.Hold} "List Holds Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Account Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /transfers/holds [get]
+func (h *transferHandler) ListHolds(c *gin.Context) {
+	req := new(ListHoldsReq)
+	if err := c.ShouldBindQuery(req); err != nil {
+		response.Error(c, errs.InvalidInput(err))
+		return
+	}
+
+	page, err := pagination.NewPage(req.Page, req.Size, req.Cursor)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	data, meta, err := h.uc.ListHolds(c.Request.Context(), req.AccountID, page)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.SuccessPage(c, "", data, meta)
+}
@@ -4,5 +4,41 @@ type TransferReq struct {
 	FromAccountID int64  `json:"from_account_id" binding:"required,min=1" example:"1"`
 	ToAccountID   int64  `json:"to_account_id" binding:"required,min=1" example:"2"`
 	Amount        int64  `json:"amount" binding:"required,gt=0" example:"10"`
-	Currency      string `json:"currency" binding:"required,oneof=THB USD" example:"THB"`
+	Currency      string `json:"currency" binding:"required,len=3" example:"THB"`
+}
+
+// ReverseReq is optional; without an amount the whole transfer is reversed.
+type ReverseReq struct {
+	Amount int64 `json:"amount" binding:"omitempty,gt=0" example:"5"`
+}
+
+type ListTransfersReq struct {
+	AccountID int64  `form:"account_id" binding:"omitempty,min=1"`
+	Direction string `form:"direction" binding:"omitempty,oneof=incoming outgoing"`
+	From      string `form:"from"`
+	To        string `form:"to"`
+	MinAmount int64  `form:"min_amount" binding:"omitempty,min=1"`
+	MaxAmount int64  `form:"max_amount" binding:"omitempty,min=1"`
+	Cursor    string `form:"cursor"`
+	Page      int    `form:"page"`
+	Size      int    `form:"size"`
+}
+
+type HoldReq struct {
+	FromAccountID int64  `json:"from_account_id" binding:"required,min=1" example:"1"`
+	ToAccountID   int64  `json:"to_account_id" binding:"required,min=1" example:"2"`
+	Amount        int64  `json:"amount" binding:"required,gt=0" example:"10"`
+	Currency      string `json:"currency" binding:"required,len=3" example:"THB"`
+}
+
+// CaptureReq is optional; without an amount the whole hold is captured.
+type CaptureReq struct {
+	Amount int64 `json:"amount" binding:"omitempty,gt=0" example:"8"`
+}
+
+type ListHoldsReq struct {
+	AccountID int64  `form:"account_id" binding:"required,min=1"`
+	Cursor    string `form:"cursor"`
+	Page      int    `form:"page"`
+	Size      int    `form:"size"`
 }
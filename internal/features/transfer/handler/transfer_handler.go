@@ -1,8 +1,11 @@
 package transferhandler
 
 import (
+	"github.com/codepnw/simple-bank/internal/consts"
 	transferusecase "github.com/codepnw/simple-bank/internal/features/transfer/usecase"
 	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/codepnw/simple-bank/pkg/utils/helper"
+	"github.com/codepnw/simple-bank/pkg/utils/pagination"
 	"github.com/codepnw/simple-bank/pkg/utils/response"
 	"github.com/gin-gonic/gin"
 )
@@ -20,45 +23,193 @@ func NewTransferHandler(uc transferusecase.TransferUsecase) *transferHandler {
 // @Tags transfers
 // @Accept       json
 // @Produce      json
+// @Param Idempotency-Key header string false "Unique key to safely retry the request"
 // @Param request body TransferReq true "Create Transfer Data"
 // @Success 201 {object} transferusecase.TransferResult "Create Transfer Successfully"
-// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input or Transfer Limit Exceeded"
 // @Failure 404 {object} response.ErrorResponse "Account Not Found"
+// @Failure 409 {object} response.ErrorResponse "Idempotency Key Conflict"
 // @Failure 500 {object} response.ErrorResponse "Internal Server Error"
 // @Security     BearerAuth
 // @Router /transfers [post]
 func (h *transferHandler) CreateTransfer(c *gin.Context) {
 	req := new(TransferReq)
 	if err := c.ShouldBindJSON(req); err != nil {
-		response.BadRequest(c, err.Error())
+		response.Error(c, errs.InvalidInput(err))
 		return
 	}
 
 	input := &transferusecase.TransferParams{
+		FromAccountID:  req.FromAccountID,
+		ToAccountID:    req.ToAccountID,
+		Amount:         req.Amount,
+		Currency:       req.Currency,
+		IdempotencyKey: c.GetHeader(consts.HeaderIdempotencyKey),
+	}
+	result, err := h.uc.Transfer(c.Request.Context(), input)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.Created(c, "transfer success", result)
+}
+
+// @Summary Quote Transfer
+// @Description price a transfer before making it: the fee, the total debited from the sender and the amount the recipient gets
+// @Tags transfers
+// @Accept       json
+// @Produce      json
+// @Param request body TransferReq true "Transfer Data"
+// @Success 200 {object} transferusecase.QuoteResult "Quote Transfer Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Account Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /transfers/quote [post]
+func (h *transferHandler) QuoteTransfer(c *gin.Context) {
+	req := new(TransferReq)
+	if err := c.ShouldBindJSON(req); err != nil {
+		response.Error(c, errs.InvalidInput(err))
+		return
+	}
+
+	data, err := h.uc.Quote(c.Request.Context(), &transferusecase.TransferParams{
 		FromAccountID: req.FromAccountID,
 		ToAccountID:   req.ToAccountID,
 		Amount:        req.Amount,
 		Currency:      req.Currency,
+	})
+	if err != nil {
+		response.Error(c, err)
+		return
 	}
-	result, err := h.uc.Transfer(c.Request.Context(), input)
+	response.Success(c, "", data)
+}
+
+// @Summary Reverse Transfer
+// @Description send a transfer back to its sender as a linked compensating transfer (recipient or admin); amount is in the recipient's currency and defaults to the full amount received. A transfer can be reversed once.
+// @Tags transfers
+// @Accept       json
+// @Produce      json
+// @Param id path int true "Transfer ID"
+// @Param request body ReverseReq false "Partial Amount"
+// @Success 201 {object} transferusecase.TransferResult "Reverse Transfer Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input or Insufficient Funds"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Only the Recipient or an Admin can Reverse"
+// @Failure 404 {object} response.ErrorResponse "Transfer Not Found"
+// @Failure 409 {object} response.ErrorResponse "Already Reversed, a Reversal, or Account Frozen or Closed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /transfers/{id}/reverse [post]
+func (h *transferHandler) ReverseTransfer(c *gin.Context) {
+	id, err := helper.ParseInt64(c.Param(consts.ParamTransferID))
 	if err != nil {
-		switch err {
-		case errs.ErrAccountNotFound:
-			response.NotFound(c, err.Error())
-			return
-		case errs.ErrTransferToSelf:
-			response.BadRequest(c, err.Error())
-			return
-		case errs.ErrCurrencyMismatch:
-			response.BadRequest(c, err.Error())
-			return
-		case errs.ErrMoneyNotEnough:
-			response.BadRequest(c, err.Error())
-			return
-		default:
-			response.InternalServerError(c, err)
+		response.Error(c, errs.ErrInvalidID.Wrap(err))
+		return
+	}
+
+	req := new(ReverseReq)
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(req); err != nil {
+			response.Error(c, errs.InvalidInput(err))
 			return
 		}
 	}
-	response.Created(c, "transfer success", result)
+
+	result, err := h.uc.Reverse(c.Request.Context(), &transferusecase.ReverseParams{
+		TransferID: id,
+		Amount:     req.Amount,
+	})
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.Created(c, "transfer reversed", result)
+}
+
+// @Summary Get Transfer
+// @Description get transfer by id (sender or recipient only)
+// @Tags transfers
+// @Accept       json
+// @Produce      json
+// @Param id path int true "Transfer ID"
+// @Success 200 {object} transfer.Transfer "Get Transfer Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Transfer Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /transfers/{id} [get]
+func (h *transferHandler) GetTransfer(c *gin.Context) {
+	id, err := helper.ParseInt64(c.Param(consts.ParamTransferID))
+	if err != nil {
+		response.Error(c, errs.ErrInvalidID.Wrap(err))
+		return
+	}
+
+	data, err := h.uc.GetTransfer(c.Request.Context(), id)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.Success(c, "", data)
+}
+
+// @Summary List Transfers
+// @Description list transfers of the user's accounts
+// @Tags transfers
+// @Accept       json
+// @Produce      json
+// @Param account_id query int false "Account ID (default: all user accounts)"
+// @Param direction query string false "incoming or outgoing"
+// @Param from query string false "From time (RFC3339 or YYYY-MM-DD)"
+// @Param to query string false "To time, inclusive (RFC3339 or YYYY-MM-DD)"
+// @Param min_amount query int false "Minimum amount"
+// @Param max_amount query int false "Maximum amount"
+// @Param cursor query string false "Cursor from the previous page's next_cursor"
+// @Param page query int false "Page number (offset mode, ignored when cursor is set)"
+// @Param size query int false "Page size"
+// @Success 200 {object} response.PageResponse{data=[]transfer.Transfer} "List Transfers Successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Account Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /transfers [get]
+func (h *transferHandler) ListTransfers(c *gin.Context) {
+	req := new(ListTransfersReq)
+	if err := c.ShouldBindQuery(req); err != nil {
+		response.Error(c, errs.InvalidInput(err))
+		return
+	}
+
+	from, to, err := helper.ParseTimeRange(req.From, req.To)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	page, err := pagination.NewPage(req.Page, req.Size, req.Cursor)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	input := &transferusecase.ListTransfersParams{
+		AccountID: req.AccountID,
+		Direction: req.Direction,
+		From:      from,
+		To:        to,
+		MinAmount: req.MinAmount,
+		MaxAmount: req.MaxAmount,
+		Page:      page,
+	}
+	data, meta, err := h.uc.ListTransfers(c.Request.Context(), input)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.SuccessPage(c, "", data, meta)
 }
@@ -0,0 +1,152 @@
+package transferrepository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/codepnw/simple-bank/internal/features/transfer"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/codepnw/simple-bank/pkg/utils/pagination"
+)
+
+const holdColumns = `
+	id, account_id, to_account_id, amount, fee, currency, status, captured_amount,
+	transfer_id, expires_at, created_at, updated_at
+`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanHold(row scanner) (*transfer.Hold, error) {
+	h := new(transfer.Hold)
+	err := row.Scan(
+		&h.ID,
+		&h.AccountID,
+		&h.ToAccountID,
+		&h.Amount,
+		&h.Fee,
+		&h.Currency,
+		&h.Status,
+		&h.CapturedAmount,
+		&h.TransferID,
+		&h.ExpiresAt,
+		&h.CreatedAt,
+		&h.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrHoldNotFound
+		}
+		return nil, err
+	}
+	return h, nil
+}
+
+func (r *transferRepository) InsertHold(ctx context.Context, tx *sql.Tx, input *transfer.Hold) (*transfer.Hold, error) {
+	query := `
+		INSERT INTO holds (account_id, to_account_id, amount, fee, currency, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING ` + holdColumns
+
+	return scanHold(tx.QueryRowContext(
+		ctx,
+		query,
+		input.AccountID,
+		input.ToAccountID,
+		input.Amount,
+		input.Fee,
+		input.Currency,
+		input.ExpiresAt,
+	))
+}
+
+func (r *transferRepository) FindHold(ctx context.Context, id int64) (*transfer.Hold, error) {
+	query := `SELECT ` + holdColumns + ` FROM holds WHERE id = $1 LIMIT 1`
+	return scanHold(r.db.QueryRowContext(ctx, query, id))
+}
+
+// ListHolds returns the holds placed on an account, newest first.
+func (r *transferRepository) ListHolds(ctx context.Context, accountID int64, page *pagination.Page) ([]*transfer.Hold, error) {
+	var (
+		rows *sql.Rows
+		err  error
+	)
+	if page.After != nil {
+		query := `
+			SELECT ` + holdColumns + ` FROM holds
+			WHERE account_id = $1 AND (created_at, id) < ($2, $3)
+			ORDER BY created_at DESC, id DESC LIMIT $4
+		`
+		rows, err = r.db.QueryContext(ctx, query, accountID, page.After.CreatedAt, page.After.ID, page.FetchLimit())
+	} else {
+		query := `
+			SELECT ` + holdColumns + ` FROM holds
+			WHERE account_id = $1
+			ORDER BY created_at DESC, id DESC LIMIT $2 OFFSET $3
+		`
+		rows, err = r.db.QueryContext(ctx, query, accountID, page.FetchLimit(), page.Offset)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return collectHolds(rows)
+}
+
+// FindHoldForUpdate locks the hold until tx ends, so it is captured, voided
+// or expired only once.
+func (r *transferRepository) FindHoldForUpdate(ctx context.Context, tx *sql.Tx, id int64) (*transfer.Hold, error) {
+	query := `SELECT ` + holdColumns + ` FROM holds WHERE id = $1 FOR UPDATE`
+	return scanHold(tx.QueryRowContext(ctx, query, id))
+}
+
+func (r *transferRepository) UpdateHold(ctx context.Context, tx *sql.Tx, input *transfer.Hold) (*transfer.Hold, error) {
+	query := `
+		UPDATE holds SET status = $1, captured_amount = $2, transfer_id = $3, updated_at = NOW()
+		WHERE id = $4 RETURNING ` + holdColumns
+
+	return scanHold(tx.QueryRowContext(
+		ctx,
+		query,
+		input.Status,
+		input.CapturedAmount,
+		input.TransferID,
+		input.ID,
+	))
+}
+
+// ClaimExpiredHolds locks up to limit open holds past their expiry. Rows held
+// by another worker or a capture in flight are skipped. They come ordered by
+// account, the same order transfers lock accounts in.
+func (r *transferRepository) ClaimExpiredHolds(ctx context.Context, tx *sql.Tx, limit int) ([]*transfer.Hold, error) {
+	query := `
+		SELECT ` + holdColumns + ` FROM holds
+		WHERE status = 'authorized' AND expires_at <= NOW()
+		ORDER BY account_id, id LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	`
+	rows, err := tx.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	return collectHolds(rows)
+}
+
+func collectHolds(rows *sql.Rows) ([]*transfer.Hold, error) {
+	defer rows.Close()
+
+	holds := make([]*transfer.Hold, 0)
+
+	for rows.Next() {
+		h, err := scanHold(rows)
+		if err != nil {
+			return nil, err
+		}
+		holds = append(holds, h)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return holds, nil
+}
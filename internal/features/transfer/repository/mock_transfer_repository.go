@@ -8,8 +8,10 @@ import (
 	context "context"
 	sql "database/sql"
 	reflect "reflect"
+	time "time"
 
 	transfer "github.com/codepnw/simple-bank/internal/features/transfer"
+	pagination "github.com/codepnw/simple-bank/pkg/utils/pagination"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -36,6 +38,96 @@ func (m *MockTransferRepository) EXPECT() *MockTransferRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ClaimExpiredHolds mocks base method.
+func (m *MockTransferRepository) ClaimExpiredHolds(ctx context.Context, tx *sql.Tx, limit int) ([]*transfer.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimExpiredHolds", ctx, tx, limit)
+	ret0, _ := ret[0].([]*transfer.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimExpiredHolds indicates an expected call of ClaimExpiredHolds.
+func (mr *MockTransferRepositoryMockRecorder) ClaimExpiredHolds(ctx, tx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimExpiredHolds", reflect.TypeOf((*MockTransferRepository)(nil).ClaimExpiredHolds), ctx, tx, limit)
+}
+
+// FindByID mocks base method.
+func (m *MockTransferRepository) FindByID(ctx context.Context, id int64) (*transfer.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*transfer.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockTransferRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockTransferRepository)(nil).FindByID), ctx, id)
+}
+
+// FindHold mocks base method.
+func (m *MockTransferRepository) FindHold(ctx context.Context, id int64) (*transfer.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindHold", ctx, id)
+	ret0, _ := ret[0].(*transfer.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindHold indicates an expected call of FindHold.
+func (mr *MockTransferRepositoryMockRecorder) FindHold(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindHold", reflect.TypeOf((*MockTransferRepository)(nil).FindHold), ctx, id)
+}
+
+// FindHoldForUpdate mocks base method.
+func (m *MockTransferRepository) FindHoldForUpdate(ctx context.Context, tx *sql.Tx, id int64) (*transfer.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindHoldForUpdate", ctx, tx, id)
+	ret0, _ := ret[0].(*transfer.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindHoldForUpdate indicates an expected call of FindHoldForUpdate.
+func (mr *MockTransferRepositoryMockRecorder) FindHoldForUpdate(ctx, tx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindHoldForUpdate", reflect.TypeOf((*MockTransferRepository)(nil).FindHoldForUpdate), ctx, tx, id)
+}
+
+// FindIdempotencyKey mocks base method.
+func (m *MockTransferRepository) FindIdempotencyKey(ctx context.Context, userID int64, key string) (*transfer.IdempotencyKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindIdempotencyKey", ctx, userID, key)
+	ret0, _ := ret[0].(*transfer.IdempotencyKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindIdempotencyKey indicates an expected call of FindIdempotencyKey.
+func (mr *MockTransferRepositoryMockRecorder) FindIdempotencyKey(ctx, userID, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindIdempotencyKey", reflect.TypeOf((*MockTransferRepository)(nil).FindIdempotencyKey), ctx, userID, key)
+}
+
+// FindLimits mocks base method.
+func (m *MockTransferRepository) FindLimits(ctx context.Context, accountID int64) (*transfer.Limits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLimits", ctx, accountID)
+	ret0, _ := ret[0].(*transfer.Limits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLimits indicates an expected call of FindLimits.
+func (mr *MockTransferRepositoryMockRecorder) FindLimits(ctx, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLimits", reflect.TypeOf((*MockTransferRepository)(nil).FindLimits), ctx, accountID)
+}
+
 // Insert mocks base method.
 func (m *MockTransferRepository) Insert(ctx context.Context, tx *sql.Tx, input *transfer.Transfer) (*transfer.Transfer, error) {
 	m.ctrl.T.Helper()
@@ -50,3 +142,106 @@ func (mr *MockTransferRepositoryMockRecorder) Insert(ctx, tx, input interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockTransferRepository)(nil).Insert), ctx, tx, input)
 }
+
+// InsertHold mocks base method.
+func (m *MockTransferRepository) InsertHold(ctx context.Context, tx *sql.Tx, input *transfer.Hold) (*transfer.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertHold", ctx, tx, input)
+	ret0, _ := ret[0].(*transfer.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertHold indicates an expected call of InsertHold.
+func (mr *MockTransferRepositoryMockRecorder) InsertHold(ctx, tx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertHold", reflect.TypeOf((*MockTransferRepository)(nil).InsertHold), ctx, tx, input)
+}
+
+// InsertIdempotencyKey mocks base method.
+func (m *MockTransferRepository) InsertIdempotencyKey(ctx context.Context, tx *sql.Tx, input *transfer.IdempotencyKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIdempotencyKey", ctx, tx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertIdempotencyKey indicates an expected call of InsertIdempotencyKey.
+func (mr *MockTransferRepositoryMockRecorder) InsertIdempotencyKey(ctx, tx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIdempotencyKey", reflect.TypeOf((*MockTransferRepository)(nil).InsertIdempotencyKey), ctx, tx, input)
+}
+
+// List mocks base method.
+func (m *MockTransferRepository) List(ctx context.Context, filter *transfer.Filter) ([]*transfer.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter)
+	ret0, _ := ret[0].([]*transfer.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockTransferRepositoryMockRecorder) List(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTransferRepository)(nil).List), ctx, filter)
+}
+
+// ListHolds mocks base method.
+func (m *MockTransferRepository) ListHolds(ctx context.Context, accountID int64, page *pagination.Page) ([]*transfer.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHolds", ctx, accountID, page)
+	ret0, _ := ret[0].([]*transfer.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHolds indicates an expected call of ListHolds.
+func (mr *MockTransferRepositoryMockRecorder) ListHolds(ctx, accountID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHolds", reflect.TypeOf((*MockTransferRepository)(nil).ListHolds), ctx, accountID, page)
+}
+
+// SetLimitOverride mocks base method.
+func (m *MockTransferRepository) SetLimitOverride(ctx context.Context, input *transfer.LimitOverride) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLimitOverride", ctx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLimitOverride indicates an expected call of SetLimitOverride.
+func (mr *MockTransferRepositoryMockRecorder) SetLimitOverride(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLimitOverride", reflect.TypeOf((*MockTransferRepository)(nil).SetLimitOverride), ctx, input)
+}
+
+// SumOutgoing mocks base method.
+func (m *MockTransferRepository) SumOutgoing(ctx context.Context, tx *sql.Tx, accountID int64, since time.Time) (*transfer.Usage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumOutgoing", ctx, tx, accountID, since)
+	ret0, _ := ret[0].(*transfer.Usage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumOutgoing indicates an expected call of SumOutgoing.
+func (mr *MockTransferRepositoryMockRecorder) SumOutgoing(ctx, tx, accountID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumOutgoing", reflect.TypeOf((*MockTransferRepository)(nil).SumOutgoing), ctx, tx, accountID, since)
+}
+
+// UpdateHold mocks base method.
+func (m *MockTransferRepository) UpdateHold(ctx context.Context, tx *sql.Tx, input *transfer.Hold) (*transfer.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHold", ctx, tx, input)
+	ret0, _ := ret[0].(*transfer.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateHold indicates an expected call of UpdateHold.
+func (mr *MockTransferRepositoryMockRecorder) UpdateHold(ctx, tx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHold", reflect.TypeOf((*MockTransferRepository)(nil).UpdateHold), ctx, tx, input)
+}
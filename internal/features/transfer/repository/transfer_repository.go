@@ -3,13 +3,36 @@ package transferrepository
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/codepnw/simple-bank/internal/consts"
 	"github.com/codepnw/simple-bank/internal/features/transfer"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/codepnw/simple-bank/pkg/utils/pagination"
+	"github.com/lib/pq"
 )
 
 //go:generate mockgen -source=transfer_repository.go -destination=mock_transfer_repository.go -package=transferrepository
 type TransferRepository interface {
+	FindByID(ctx context.Context, id int64) (*transfer.Transfer, error)
+	List(ctx context.Context, filter *transfer.Filter) ([]*transfer.Transfer, error)
+	FindIdempotencyKey(ctx context.Context, userID int64, key string) (*transfer.IdempotencyKey, error)
+	FindLimits(ctx context.Context, accountID int64) (*transfer.Limits, error)
+	SetLimitOverride(ctx context.Context, input *transfer.LimitOverride) error
+	FindHold(ctx context.Context, id int64) (*transfer.Hold, error)
+	ListHolds(ctx context.Context, accountID int64, page *pagination.Page) ([]*transfer.Hold, error)
+
+	// Transaction
 	Insert(ctx context.Context, tx *sql.Tx, input *transfer.Transfer) (*transfer.Transfer, error)
+	InsertIdempotencyKey(ctx context.Context, tx *sql.Tx, input *transfer.IdempotencyKey) error
+	SumOutgoing(ctx context.Context, tx *sql.Tx, accountID int64, since time.Time) (*transfer.Usage, error)
+	InsertHold(ctx context.Context, tx *sql.Tx, input *transfer.Hold) (*transfer.Hold, error)
+	FindHoldForUpdate(ctx context.Context, tx *sql.Tx, id int64) (*transfer.Hold, error)
+	UpdateHold(ctx context.Context, tx *sql.Tx, input *transfer.Hold) (*transfer.Hold, error)
+	ClaimExpiredHolds(ctx context.Context, tx *sql.Tx, limit int) ([]*transfer.Hold, error)
 }
 
 type transferRepository struct {
@@ -20,17 +43,262 @@ func NewTransferRepository(db *sql.DB) TransferRepository {
 	return &transferRepository{db: db}
 }
 
+// transferColumns selects a transfer aliased as t, with the ID of its
+// reversal if there is one.
+const transferColumns = `
+	id, from_account_id, to_account_id, amount, to_amount, exchange_rate, fee, reversal_of,
+	(SELECT r.id FROM transfers r WHERE r.reversal_of = t.id) AS reversed_by, created_at
+`
+
 func (r *transferRepository) Insert(ctx context.Context, tx *sql.Tx, input *transfer.Transfer) (*transfer.Transfer, error) {
 	query := `
-		INSERT INTO transfers (from_account_id, to_account_id, amount)
-		VALUES ($1, $2, $3) RETURNING id, created_at
+		INSERT INTO transfers (from_account_id, to_account_id, amount, to_amount, exchange_rate, fee, reversal_of)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, created_at
 	`
-	err := tx.QueryRowContext(ctx, query, input.FromAccountID, input.ToAccountID, input.Amount).Scan(
+	err := tx.QueryRowContext(
+		ctx,
+		query,
+		input.FromAccountID,
+		input.ToAccountID,
+		input.Amount,
+		input.ToAmount,
+		input.ExchangeRate,
+		input.Fee,
+		input.ReversalOf,
+	).Scan(
 		&input.ID,
 		&input.CreatedAt,
 	)
 	if err != nil {
+		// A concurrent reversal of the same transfer committed first
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Constraint == "idx_transfers_reversal_of" {
+			return nil, errs.ErrTransferAlreadyReversed
+		}
 		return nil, err
 	}
 	return input, nil
 }
+
+func (r *transferRepository) FindByID(ctx context.Context, id int64) (*transfer.Transfer, error) {
+	query := `
+		SELECT ` + transferColumns + `
+		FROM transfers t WHERE id = $1 LIMIT 1
+	`
+	t := new(transfer.Transfer)
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&t.ID,
+		&t.FromAccountID,
+		&t.ToAccountID,
+		&t.Amount,
+		&t.ToAmount,
+		&t.ExchangeRate,
+		&t.Fee,
+		&t.ReversalOf,
+		&t.ReversedBy,
+		&t.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrTransferNotFound
+		}
+		return nil, err
+	}
+	return t, nil
+}
+
+func (r *transferRepository) List(ctx context.Context, filter *transfer.Filter) ([]*transfer.Transfer, error) {
+	var (
+		conds []string
+		args  []any
+	)
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	// Account scope: filtering each side on its own column lets Postgres use
+	// idx_transfers_from and idx_transfers_to
+	var scope string
+	if filter.AccountID > 0 {
+		scope = "= " + arg(filter.AccountID)
+	} else {
+		scope = "IN (SELECT id FROM accounts WHERE owner_id = " + arg(filter.OwnerID) + ")"
+	}
+	switch filter.Direction {
+	case consts.DirectionOutgoing:
+		conds = append(conds, "from_account_id "+scope)
+	case consts.DirectionIncoming:
+		conds = append(conds, "to_account_id "+scope)
+	default:
+		conds = append(conds, "(from_account_id "+scope+" OR to_account_id "+scope+")")
+	}
+
+	if filter.From != nil {
+		conds = append(conds, "created_at >= "+arg(*filter.From))
+	}
+	if filter.To != nil {
+		conds = append(conds, "created_at <= "+arg(*filter.To))
+	}
+	if filter.MinAmount > 0 {
+		conds = append(conds, "amount >= "+arg(filter.MinAmount))
+	}
+	if filter.MaxAmount > 0 {
+		conds = append(conds, "amount <= "+arg(filter.MaxAmount))
+	}
+	// Keyset: rows strictly after the cursor in (created_at DESC, id DESC)
+	if filter.Page.After != nil {
+		conds = append(conds, "(created_at, id) < ("+arg(filter.Page.After.CreatedAt)+", "+arg(filter.Page.After.ID)+")")
+	}
+
+	query := `
+		SELECT ` + transferColumns + `
+		FROM transfers t WHERE ` + strings.Join(conds, " AND ") + `
+		ORDER BY created_at DESC, id DESC
+		LIMIT ` + arg(filter.Page.FetchLimit()) + ` OFFSET ` + arg(filter.Page.Offset)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	transfers := make([]*transfer.Transfer, 0)
+
+	for rows.Next() {
+		t := new(transfer.Transfer)
+		if err = rows.Scan(
+			&t.ID,
+			&t.FromAccountID,
+			&t.ToAccountID,
+			&t.Amount,
+			&t.ToAmount,
+			&t.ExchangeRate,
+			&t.Fee,
+			&t.ReversalOf,
+			&t.ReversedBy,
+			&t.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, t)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return transfers, nil
+}
+
+func (r *transferRepository) FindIdempotencyKey(ctx context.Context, userID int64, key string) (*transfer.IdempotencyKey, error) {
+	query := `
+		SELECT user_id, idempotency_key, request_hash, transfer_id, response, created_at
+		FROM transfer_idempotency_keys WHERE user_id = $1 AND idempotency_key = $2 LIMIT 1
+	`
+	k := new(transfer.IdempotencyKey)
+	err := r.db.QueryRowContext(ctx, query, userID, key).Scan(
+		&k.UserID,
+		&k.Key,
+		&k.RequestHash,
+		&k.TransferID,
+		&k.Response,
+		&k.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrIdempotencyKeyNotFound
+		}
+		return nil, err
+	}
+	return k, nil
+}
+
+func (r *transferRepository) InsertIdempotencyKey(ctx context.Context, tx *sql.Tx, input *transfer.IdempotencyKey) error {
+	query := `
+		INSERT INTO transfer_idempotency_keys (user_id, idempotency_key, request_hash, transfer_id, response)
+		VALUES ($1, $2, $3, $4, $5) RETURNING created_at
+	`
+	err := tx.QueryRowContext(
+		ctx,
+		query,
+		input.UserID,
+		input.Key,
+		input.RequestHash,
+		input.TransferID,
+		input.Response,
+	).Scan(&input.CreatedAt)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "unique_violation" {
+			return errs.ErrIdempotencyKeyExists
+		}
+		return err
+	}
+	return nil
+}
+
+// FindLimits returns the effective limits of an account: its override where
+// set, else its currency's.
+func (r *transferRepository) FindLimits(ctx context.Context, accountID int64) (*transfer.Limits, error) {
+	query := `
+		SELECT a.id, a.currency,
+			COALESCE(o.max_amount, d.max_amount, 0),
+			COALESCE(o.daily_amount, d.daily_amount, 0),
+			COALESCE(o.daily_count, d.daily_count, 0)
+		FROM accounts a
+		LEFT JOIN transfer_limits d ON d.currency = a.currency
+		LEFT JOIN account_transfer_limits o ON o.account_id = a.id
+		WHERE a.id = $1 LIMIT 1
+	`
+	l := new(transfer.Limits)
+	err := r.db.QueryRowContext(ctx, query, accountID).Scan(
+		&l.AccountID,
+		&l.Currency,
+		&l.MaxAmount,
+		&l.DailyAmount,
+		&l.DailyCount,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrAccountNotFound
+		}
+		return nil, err
+	}
+	return l, nil
+}
+
+func (r *transferRepository) SetLimitOverride(ctx context.Context, input *transfer.LimitOverride) error {
+	query := `
+		INSERT INTO account_transfer_limits (account_id, max_amount, daily_amount, daily_count, updated_by)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (account_id) DO UPDATE SET
+			max_amount = EXCLUDED.max_amount,
+			daily_amount = EXCLUDED.daily_amount,
+			daily_count = EXCLUDED.daily_count,
+			updated_by = EXCLUDED.updated_by,
+			updated_at = NOW()
+	`
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		input.AccountID,
+		input.MaxAmount,
+		input.DailyAmount,
+		input.DailyCount,
+		input.UpdatedBy,
+	)
+	return err
+}
+
+// SumOutgoing totals the account's transfers sent since the given time,
+// leaving out reversals. Call it after the account row is locked so
+// concurrent transfers are counted.
+func (r *transferRepository) SumOutgoing(ctx context.Context, tx *sql.Tx, accountID int64, since time.Time) (*transfer.Usage, error) {
+	query := `
+		SELECT COALESCE(SUM(amount), 0), COUNT(*)
+		FROM transfers WHERE from_account_id = $1 AND created_at > $2 AND reversal_of IS NULL
+	`
+	u := new(transfer.Usage)
+	if err := tx.QueryRowContext(ctx, query, accountID, since).Scan(&u.Amount, &u.Count); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
@@ -1,11 +1,190 @@
 package transfer
 
-import "time"
+import (
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/codepnw/simple-bank/pkg/fx"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/codepnw/simple-bank/pkg/utils/pagination"
+)
 
 type Transfer struct {
 	ID            int64     `json:"id"`
 	FromAccountID int64     `json:"from_account_id"`
 	ToAccountID   int64     `json:"to_account_id"`
-	Amount        int64     `json:"amount"`
+	Amount        int64     `json:"amount"`    // source currency
+	ToAmount      int64     `json:"to_amount"` // destination currency
+	ExchangeRate  fx.Rate   `json:"exchange_rate" swaggertype:"number" example:"36.5"`
+	Fee           int64     `json:"fee"`                   // charged on top of Amount, source currency
+	ReversalOf    *int64    `json:"reversal_of,omitempty"` // transfer this one reverses
+	ReversedBy    *int64    `json:"reversed_by,omitempty"` // reversal of this transfer
 	CreatedAt     time.Time `json:"created_at"`
 }
+
+// CheckReversible returns why the transfer can't be reversed, or nil when it
+// can. Each transfer is reversed at most once, in full or in part.
+func (t *Transfer) CheckReversible() error {
+	if t.ReversalOf != nil {
+		return errs.ErrTransferNotReversible
+	}
+	if t.ReversedBy != nil {
+		return errs.ErrTransferAlreadyReversed
+	}
+	return nil
+}
+
+// Refund is what the original sender gets back when amount of ToAmount is
+// returned, at the original rate so a reversal carries no FX gain or loss.
+// A full reversal refunds Amount exactly; a partial one rounds down.
+func (t *Transfer) Refund(amount int64) int64 {
+	if amount == t.ToAmount {
+		return t.Amount
+	}
+	n := new(big.Int).Mul(big.NewInt(amount), big.NewInt(t.Amount))
+	return n.Quo(n, big.NewInt(t.ToAmount)).Int64()
+}
+
+type IdempotencyKey struct {
+	UserID      int64
+	Key         string
+	RequestHash string
+	TransferID  int64
+	Response    []byte
+	CreatedAt   time.Time
+}
+
+// Filter selects transfers touching AccountID, or every account of OwnerID
+// when AccountID is zero. Zero values mean "no filter".
+type Filter struct {
+	OwnerID   int64
+	AccountID int64
+	Direction string
+	From      *time.Time
+	To        *time.Time
+	MinAmount int64
+	MaxAmount int64
+	Page      *pagination.Page
+}
+
+// Limit names reported in TRANSFER_LIMIT_EXCEEDED metadata.
+const (
+	LimitMaxAmount   = "max_amount"
+	LimitDailyAmount = "daily_amount"
+	LimitDailyCount  = "daily_count"
+)
+
+// Limits are the velocity controls on an account's outgoing transfers, in
+// the account's minor units. Zero means no limit.
+type Limits struct {
+	AccountID   int64  `json:"account_id"`
+	Currency    string `json:"currency"`
+	MaxAmount   int64  `json:"max_amount"`   // per transfer
+	DailyAmount int64  `json:"daily_amount"` // rolling 24h total
+	DailyCount  int64  `json:"daily_count"`  // rolling 24h transfers
+}
+
+// LimitOverride replaces the currency limits of one account. A nil field
+// falls back to the currency limit.
+type LimitOverride struct {
+	AccountID   int64
+	MaxAmount   *int64
+	DailyAmount *int64
+	DailyCount  *int64
+	UpdatedBy   int64
+}
+
+// Usage totals an account's outgoing transfers within the rolling window.
+type Usage struct {
+	Amount int64
+	Count  int64
+}
+
+// CheckAmount enforces the per-transfer maximum.
+func (l *Limits) CheckAmount(amount int64) error {
+	if l.MaxAmount > 0 && amount > l.MaxAmount {
+		return l.exceeded(LimitMaxAmount, l.MaxAmount, l.MaxAmount)
+	}
+	return nil
+}
+
+// CheckUsage enforces the rolling totals. used already includes the
+// transfer of amount being made.
+func (l *Limits) CheckUsage(amount int64, used *Usage) error {
+	if l.DailyCount > 0 && used.Count > l.DailyCount {
+		return l.exceeded(LimitDailyCount, l.DailyCount, l.DailyCount-(used.Count-1))
+	}
+	if l.DailyAmount > 0 && used.Amount > l.DailyAmount {
+		return l.exceeded(LimitDailyAmount, l.DailyAmount, l.DailyAmount-(used.Amount-amount))
+	}
+	return nil
+}
+
+func (l *Limits) exceeded(name string, limit, remaining int64) error {
+	return errs.ErrTransferLimitExceeded.WithMetadata(map[string]string{
+		"limit":       name,
+		"limit_value": strconv.FormatInt(limit, 10),
+		// A lowered limit can leave usage above it
+		"remaining": strconv.FormatInt(max(remaining, 0), 10),
+		"currency":  l.Currency,
+	})
+}
+
+// HoldStatus is the lifecycle state of a hold. Only authorized holds reserve
+// funds; the others are final.
+type HoldStatus string
+
+const (
+	HoldAuthorized HoldStatus = "authorized"
+	HoldCaptured   HoldStatus = "captured"
+	HoldVoided     HoldStatus = "voided"
+	HoldExpired    HoldStatus = "expired"
+)
+
+// Hold reserves Amount and its transfer Fee on AccountID for a later
+// transfer to ToAccountID. It lowers the account's available balance until it
+// is captured into a transfer, voided or expires.
+type Hold struct {
+	ID             int64      `json:"id"`
+	AccountID      int64      `json:"account_id"`
+	ToAccountID    int64      `json:"to_account_id"`
+	Amount         int64      `json:"amount"` // source currency
+	Fee            int64      `json:"fee"`    // priced at authorize, the most a capture charges
+	Currency       string     `json:"currency"`
+	Status         HoldStatus `json:"status"`
+	CapturedAmount int64      `json:"captured_amount"`
+	TransferID     *int64     `json:"transfer_id,omitempty"` // set on capture
+	ExpiresAt      time.Time  `json:"expires_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// Reserved is what the hold keeps out of the available balance.
+func (h *Hold) Reserved() int64 {
+	return h.Amount + h.Fee
+}
+
+// CheckCapturable returns why the hold can't be captured at now, or nil when
+// it can.
+func (h *Hold) CheckCapturable(now time.Time) error {
+	if h.Status != HoldAuthorized {
+		return errs.ErrHoldNotAuthorized
+	}
+	if !now.Before(h.ExpiresAt) {
+		return errs.ErrHoldExpired
+	}
+	return nil
+}
+
+// CaptureAmount resolves the amount to capture; zero captures the whole hold.
+// Whatever isn't captured is released.
+func (h *Hold) CaptureAmount(amount int64) (int64, error) {
+	if amount == 0 {
+		return h.Amount, nil
+	}
+	if amount < 0 || amount > h.Amount {
+		return 0, errs.ErrInvalidCaptureAmount
+	}
+	return amount, nil
+}
@@ -0,0 +1,356 @@
+package transferusecase
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/codepnw/simple-bank/internal/consts"
+	"github.com/codepnw/simple-bank/internal/features/account"
+	"github.com/codepnw/simple-bank/internal/features/fee"
+	"github.com/codepnw/simple-bank/internal/features/transfer"
+	"github.com/codepnw/simple-bank/internal/features/user"
+	"github.com/codepnw/simple-bank/pkg/auth"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/codepnw/simple-bank/pkg/utils/pagination"
+)
+
+// Authorize reserves funds on the caller's account: the amount and the
+// transfer fee it is priced at now, the most capture will charge. The reservation
+// lowers the available balance at once. Holds, transfers and withdrawals all check
+// the available balance again under the account's row lock, so between
+// them they can't spend funds another hold has reserved.
+func (u *transferUsecase) Authorize(ctx context.Context, input *HoldParams) (*transfer.Hold, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	userID := auth.GetUserID(ctx)
+	if userID == 0 {
+		return nil, errs.ErrNoUserID
+	}
+	if input.Amount <= 0 {
+		return nil, errs.ErrInvalidAmount
+	}
+	if input.FromAccountID == input.ToAccountID {
+		return nil, errs.ErrTransferToSelf
+	}
+
+	fromAcc, err := u.accRepo.FindByID(ctx, input.FromAccountID)
+	if err != nil {
+		return nil, err
+	}
+	// Check Owner: system accounts have no balance floor, so never a source
+	if fromAcc.OwnerID != userID || fromAcc.Type != account.TypeCustomer {
+		return nil, errs.ErrAccountNotFound
+	}
+	if err = fromAcc.CheckActive(); err != nil {
+		return nil, err
+	}
+	// Check Currency
+	fromCurr, err := u.currencies.Lookup(input.Currency)
+	if err != nil {
+		return nil, err
+	}
+	if fromAcc.Currency != account.AccountCurrency(fromCurr.Code) {
+		return nil, errs.ErrCurrencyMismatch
+	}
+
+	toAcc, err := u.accRepo.FindByID(ctx, input.ToAccountID)
+	if err != nil {
+		return nil, err
+	}
+	if toAcc.Type != account.TypeCustomer {
+		return nil, errs.ErrAccountNotFound
+	}
+	if err = toAcc.CheckActive(); err != nil {
+		return nil, err
+	}
+	// Fail now rather than at capture when the pair has no rate
+	if _, _, err = u.convert(ctx, input.Amount, fromCurr, string(toAcc.Currency)); err != nil {
+		return nil, err
+	}
+	// Fee: priced like a transfer, so a hold is no way around it
+	rule, err := u.feeRepo.FindRule(ctx, fee.OpTransfer, fromCurr.Code, input.Amount)
+	if err != nil {
+		return nil, err
+	}
+	fees := rule.Apply(input.Amount)
+	// Check Balance
+	if fromAcc.AvailableBalance < input.Amount+fees.Total {
+		return nil, errs.ErrMoneyNotEnough
+	}
+	// Check Limits: the rolling totals apply when the hold is captured
+	limits, err := u.tranRepo.FindLimits(ctx, fromAcc.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err = limits.CheckAmount(input.Amount); err != nil {
+		return nil, err
+	}
+
+	var hold *transfer.Hold
+	err = u.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
+		acc, err := u.accRepo.AddHeldAmount(ctx, tx, fromAcc.ID, input.Amount+fees.Total)
+		if err != nil {
+			return err
+		}
+		if err = acc.CheckActive(); err != nil {
+			return err
+		}
+		if acc.AvailableBalance < 0 {
+			return errs.ErrMoneyNotEnough
+		}
+
+		hold, err = u.tranRepo.InsertHold(ctx, tx, &transfer.Hold{
+			AccountID:   fromAcc.ID,
+			ToAccountID: toAcc.ID,
+			Amount:      input.Amount,
+			Fee:         fees.Total,
+			Currency:    fromCurr.Code,
+			ExpiresAt:   time.Now().Add(u.holdTTL),
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return hold, nil
+}
+
+// Capture turns a hold into a regular transfer of all or part of it. The fee
+// is priced on the captured amount, capped at the one reserved at authorize.
+// The whole hold is released, so an uncaptured remainder and unused fee are
+// available again.
+func (u *transferUsecase) Capture(ctx context.Context, input *CaptureParams) (*CaptureResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	hold, toAcc, err := u.settleHold(ctx, input.HoldID)
+	if err != nil {
+		return nil, err
+	}
+	if err = hold.CheckCapturable(time.Now()); err != nil {
+		return nil, err
+	}
+	amount, err := hold.CaptureAmount(input.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	fromCurr, err := u.currencies.Lookup(hold.Currency)
+	if err != nil {
+		return nil, err
+	}
+	rate, toAmount, err := u.convert(ctx, amount, fromCurr, string(toAcc.Currency))
+	if err != nil {
+		return nil, err
+	}
+	rule, err := u.feeRepo.FindRule(ctx, fee.OpTransfer, fromCurr.Code, amount)
+	if err != nil {
+		return nil, err
+	}
+	// The reservation is all the payer agreed to
+	charged := min(rule.Apply(amount).Total, hold.Fee)
+
+	limits, err := u.tranRepo.FindLimits(ctx, hold.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(CaptureResult)
+	err = u.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
+		// Re-check under the hold's lock: a void or expiry may have landed
+		h, err := u.tranRepo.FindHoldForUpdate(ctx, tx, hold.ID)
+		if err != nil {
+			return err
+		}
+		if err = h.CheckCapturable(time.Now()); err != nil {
+			return err
+		}
+
+		// The whole hold is released before the debit, in the ledger's lock
+		// order, so the debit may spend the funds it reserved
+		posted, err := u.post(ctx, tx, &transfer.Transfer{
+			FromAccountID: h.AccountID,
+			ToAccountID:   h.ToAccountID,
+			Amount:        amount,
+			ToAmount:      toAmount,
+			ExchangeRate:  rate,
+			Fee:           charged,
+		}, account.AccountCurrency(h.Currency), toAcc.Currency, h.Reserved())
+		if err != nil {
+			return err
+		}
+		result.TransferResult = *posted
+
+		if err = result.FromAccount.CheckActive(); err != nil {
+			return err
+		}
+		if err = result.ToAccount.CheckActive(); err != nil {
+			return err
+		}
+		used, err := u.tranRepo.SumOutgoing(ctx, tx, h.AccountID, time.Now().Add(-consts.TransferLimitWindow))
+		if err != nil {
+			return err
+		}
+		if err = limits.CheckUsage(amount, used); err != nil {
+			return err
+		}
+		u.display(result.FromAccount, result.ToAccount)
+
+		h.Status = transfer.HoldCaptured
+		h.CapturedAmount = amount
+		h.TransferID = &result.Transfer.ID
+		result.Hold, err = u.tranRepo.UpdateHold(ctx, tx, h)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Void releases a hold without moving money. Like capture it is up to the
+// recipient; the payer gets the funds back only when the hold expires. An
+// expired hold the worker hasn't released yet can still be voided.
+func (u *transferUsecase) Void(ctx context.Context, id int64) (*transfer.Hold, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	if _, _, err := u.settleHold(ctx, id); err != nil {
+		return nil, err
+	}
+
+	var hold *transfer.Hold
+	err := u.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
+		var err error
+		hold, err = u.release(ctx, tx, id, transfer.HoldVoided)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return hold, nil
+}
+
+func (u *transferUsecase) GetHold(ctx context.Context, id int64) (*transfer.Hold, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	hold, _, err := u.findHold(ctx, id)
+	return hold, err
+}
+
+func (u *transferUsecase) ListHolds(ctx context.Context, accountID int64, page *pagination.Page) ([]*transfer.Hold, *pagination.Meta, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	userID := auth.GetUserID(ctx)
+	if userID == 0 {
+		return nil, nil, errs.ErrNoUserID
+	}
+
+	// Check Owner
+	acc, err := u.accRepo.FindByID(ctx, accountID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if acc.OwnerID != userID {
+		return nil, nil, errs.ErrAccountNotFound
+	}
+
+	holds, err := u.tranRepo.ListHolds(ctx, accountID, page)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	holds, meta := pagination.Trim(holds, page, func(h *transfer.Hold) pagination.Cursor {
+		return pagination.Cursor{CreatedAt: h.CreatedAt, ID: h.ID}
+	})
+	return holds, meta, nil
+}
+
+func (u *transferUsecase) ExpireHolds(ctx context.Context, limit int) (int, error) {
+	var claimed int
+
+	err := u.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
+		expired, err := u.tranRepo.ClaimExpiredHolds(ctx, tx, limit)
+		if err != nil {
+			return err
+		}
+		claimed = len(expired)
+
+		for _, h := range expired {
+			if _, err = u.accRepo.AddHeldAmount(ctx, tx, h.AccountID, -h.Reserved()); err != nil {
+				return err
+			}
+			h.Status = transfer.HoldExpired
+			if _, err = u.tranRepo.UpdateHold(ctx, tx, h); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return claimed, nil
+}
+
+// findHold returns a hold the caller may see: either side of it, or an admin.
+// The destination account is returned for pricing a capture.
+func (u *transferUsecase) findHold(ctx context.Context, id int64) (*transfer.Hold, *account.Account, error) {
+	userID := auth.GetUserID(ctx)
+	if userID == 0 {
+		return nil, nil, errs.ErrNoUserID
+	}
+
+	hold, err := u.tranRepo.FindHold(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	fromAcc, err := u.accRepo.FindByID(ctx, hold.AccountID)
+	if err != nil {
+		return nil, nil, err
+	}
+	toAcc, err := u.accRepo.FindByID(ctx, hold.ToAccountID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if fromAcc.OwnerID != userID && toAcc.OwnerID != userID && !auth.HasRole(ctx, user.RoleAdmin) {
+		return nil, nil, errs.ErrHoldNotFound
+	}
+	return hold, toAcc, nil
+}
+
+// settleHold returns a hold the caller may capture or void: only the
+// recipient, or an admin. A hold guarantees the funds to the recipient, so
+// the payer can't take them back early.
+func (u *transferUsecase) settleHold(ctx context.Context, id int64) (*transfer.Hold, *account.Account, error) {
+	hold, toAcc, err := u.findHold(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if toAcc.OwnerID != auth.GetUserID(ctx) && !auth.HasRole(ctx, user.RoleAdmin) {
+		return nil, nil, errs.ErrNoPermission
+	}
+	return hold, toAcc, nil
+}
+
+// release locks an authorized hold, gives its funds back and moves it to
+// status.
+func (u *transferUsecase) release(ctx context.Context, tx *sql.Tx, id int64, status transfer.HoldStatus) (*transfer.Hold, error) {
+	h, err := u.tranRepo.FindHoldForUpdate(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if h.Status != transfer.HoldAuthorized {
+		return nil, errs.ErrHoldNotAuthorized
+	}
+
+	if _, err = u.accRepo.AddHeldAmount(ctx, tx, h.AccountID, -h.Reserved()); err != nil {
+		return nil, err
+	}
+	h.Status = status
+	return u.tranRepo.UpdateHold(ctx, tx, h)
+}
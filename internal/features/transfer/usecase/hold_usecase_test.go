@@ -0,0 +1,458 @@
+package transferusecase_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/codepnw/simple-bank/internal/features/account"
+	accountrepository "github.com/codepnw/simple-bank/internal/features/account/repository"
+	"github.com/codepnw/simple-bank/internal/features/fee"
+	"github.com/codepnw/simple-bank/internal/features/ledger"
+	ledgerusecase "github.com/codepnw/simple-bank/internal/features/ledger/usecase"
+	"github.com/codepnw/simple-bank/internal/features/transfer"
+	transferrepository "github.com/codepnw/simple-bank/internal/features/transfer/repository"
+	transferusecase "github.com/codepnw/simple-bank/internal/features/transfer/usecase"
+	"github.com/codepnw/simple-bank/internal/features/user"
+	"github.com/codepnw/simple-bank/internal/mocks"
+	"github.com/codepnw/simple-bank/pkg/auth"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthorizeHold(t *testing.T) {
+	type testCase struct {
+		name        string
+		rule        *fee.Rule
+		input       *transferusecase.HoldParams
+		mockFn      func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, input *transferusecase.HoldParams)
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:  "success",
+			input: &transferusecase.HoldParams{FromAccountID: 1, ToAccountID: 2, Amount: 100, Currency: "THB"},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, input *transferusecase.HoldParams) {
+				mockHoldAccounts(accRepo, input)
+				tranRepo.EXPECT().FindLimits(gomock.Any(), input.FromAccountID).Return(mocks.MockLimitsData(), nil).Times(1)
+
+				held := mocks.MockAccountData()
+				held.HeldAmount, held.AvailableBalance = 100, 900
+				accRepo.EXPECT().AddHeldAmount(gomock.Any(), gomock.Any(), input.FromAccountID, input.Amount).Return(held, nil).Times(1)
+
+				tranRepo.EXPECT().InsertHold(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ *sql.Tx, in *transfer.Hold) (*transfer.Hold, error) {
+					assert.True(t, in.ExpiresAt.After(time.Now().Add(59*time.Minute)))
+					in.ID, in.Status = 1, transfer.HoldAuthorized
+					return in, nil
+				}).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:  "success fee reserved with the hold",
+			rule:  &fee.Rule{Currency: "THB", Flat: 1, RateBps: 100},
+			input: &transferusecase.HoldParams{FromAccountID: 1, ToAccountID: 2, Amount: 100, Currency: "THB"},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, input *transferusecase.HoldParams) {
+				mockHoldAccounts(accRepo, input)
+				tranRepo.EXPECT().FindLimits(gomock.Any(), input.FromAccountID).Return(mocks.MockLimitsData(), nil).Times(1)
+
+				// 1 flat + 1% of 100
+				held := mocks.MockAccountData()
+				held.HeldAmount, held.AvailableBalance = 102, 898
+				accRepo.EXPECT().AddHeldAmount(gomock.Any(), gomock.Any(), input.FromAccountID, int64(102)).Return(held, nil).Times(1)
+
+				tranRepo.EXPECT().InsertHold(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ *sql.Tx, in *transfer.Hold) (*transfer.Hold, error) {
+					assert.Equal(t, int64(2), in.Fee)
+					in.ID, in.Status = 1, transfer.HoldAuthorized
+					return in, nil
+				}).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:  "fail balance does not cover fee",
+			rule:  &fee.Rule{Currency: "THB", Flat: 1, RateBps: 100},
+			input: &transferusecase.HoldParams{FromAccountID: 1, ToAccountID: 2, Amount: 100, Currency: "THB"},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, input *transferusecase.HoldParams) {
+				fromAcc, _ := mockHoldAccounts(accRepo, input)
+				fromAcc.Balance, fromAcc.AvailableBalance = 101, 101
+			},
+			expectedErr: errs.ErrMoneyNotEnough,
+		},
+		{
+			name:  "fail funds already held",
+			input: &transferusecase.HoldParams{FromAccountID: 1, ToAccountID: 2, Amount: 100, Currency: "THB"},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, input *transferusecase.HoldParams) {
+				fromAcc, _ := mockHoldAccounts(accRepo, input)
+				fromAcc.HeldAmount, fromAcc.AvailableBalance = 950, 50
+			},
+			expectedErr: errs.ErrMoneyNotEnough,
+		},
+		{
+			name:  "fail concurrent hold took the funds",
+			input: &transferusecase.HoldParams{FromAccountID: 1, ToAccountID: 2, Amount: 100, Currency: "THB"},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, input *transferusecase.HoldParams) {
+				mockHoldAccounts(accRepo, input)
+				tranRepo.EXPECT().FindLimits(gomock.Any(), input.FromAccountID).Return(mocks.MockLimitsData(), nil).Times(1)
+
+				held := mocks.MockAccountData()
+				held.HeldAmount, held.AvailableBalance = 1050, -50
+				accRepo.EXPECT().AddHeldAmount(gomock.Any(), gomock.Any(), input.FromAccountID, input.Amount).Return(held, nil).Times(1)
+			},
+			expectedErr: errs.ErrMoneyNotEnough,
+		},
+		{
+			name:  "fail over max amount",
+			input: &transferusecase.HoldParams{FromAccountID: 1, ToAccountID: 2, Amount: 1001, Currency: "THB"},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, input *transferusecase.HoldParams) {
+				fromAcc, _ := mockHoldAccounts(accRepo, input)
+				fromAcc.Balance, fromAcc.AvailableBalance = 5000, 5000
+				tranRepo.EXPECT().FindLimits(gomock.Any(), input.FromAccountID).Return(mocks.MockLimitsData(), nil).Times(1)
+			},
+			expectedErr: errs.ErrTransferLimitExceeded,
+		},
+		{
+			name:  "fail not owner",
+			input: &transferusecase.HoldParams{FromAccountID: 1, ToAccountID: 2, Amount: 100, Currency: "THB"},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, input *transferusecase.HoldParams) {
+				fromAcc := mocks.MockAccountData()
+				fromAcc.OwnerID = 100
+				accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(fromAcc, nil).Times(1)
+			},
+			expectedErr: errs.ErrAccountNotFound,
+		},
+		{
+			name:  "fail system account source",
+			input: &transferusecase.HoldParams{FromAccountID: 1, ToAccountID: 2, Amount: 100, Currency: "THB"},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, input *transferusecase.HoldParams) {
+				fromAcc := mocks.MockCashAccountData()
+				fromAcc.OwnerID = 10
+				accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(fromAcc, nil).Times(1)
+			},
+			expectedErr: errs.ErrAccountNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rule := tc.rule
+			if rule == nil {
+				rule = &fee.Rule{}
+			}
+			uc, tranRepo, accRepo, _ := setupWithFee(t, rule)
+
+			tc.mockFn(tranRepo, accRepo, tc.input)
+
+			ctx := auth.SetUserID(context.Background(), int64(10))
+			result, err := uc.Authorize(ctx, tc.input)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, transfer.HoldAuthorized, result.Status)
+			}
+		})
+	}
+}
+
+func TestCaptureHold(t *testing.T) {
+	type testCase struct {
+		name        string
+		rule        *fee.Rule
+		userID      int64
+		input       *transferusecase.CaptureParams
+		hold        func() *transfer.Hold
+		mockFn      func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, hold *transfer.Hold)
+		fee         int64
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:  "success full amount",
+			input: &transferusecase.CaptureParams{HoldID: 1},
+			hold:  mockHold,
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, hold *transfer.Hold) {
+				mockCapture(tranRepo, accRepo, ledgerUC, hold, 100, 0)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:  "success partial releases the rest",
+			input: &transferusecase.CaptureParams{HoldID: 1, Amount: 60},
+			hold:  mockHold,
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, hold *transfer.Hold) {
+				mockCapture(tranRepo, accRepo, ledgerUC, hold, 60, 0)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:  "success full amount charges the fee held",
+			rule:  &fee.Rule{Currency: "THB", RateBps: 200},
+			input: &transferusecase.CaptureParams{HoldID: 1},
+			hold:  mockHoldWithFee,
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, hold *transfer.Hold) {
+				mockCapture(tranRepo, accRepo, ledgerUC, hold, 100, 2)
+			},
+			fee:         2,
+			expectedErr: nil,
+		},
+		{
+			name:  "success partial reprices the fee",
+			rule:  &fee.Rule{Currency: "THB", RateBps: 200},
+			input: &transferusecase.CaptureParams{HoldID: 1, Amount: 40},
+			hold:  mockHoldWithFee,
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, hold *transfer.Hold) {
+				// 2% of 40 = 0.8, rounded up; the other 1 is released
+				mockCapture(tranRepo, accRepo, ledgerUC, hold, 40, 1)
+			},
+			fee:         1,
+			expectedErr: nil,
+		},
+		{
+			name:  "success fee capped at the one held",
+			rule:  &fee.Rule{Currency: "THB", RateBps: 500},
+			input: &transferusecase.CaptureParams{HoldID: 1, Amount: 60},
+			hold:  mockHoldWithFee,
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, hold *transfer.Hold) {
+				// Repriced since authorize: 5% of 60 = 3
+				mockCapture(tranRepo, accRepo, ledgerUC, hold, 60, 2)
+			},
+			fee:         2,
+			expectedErr: nil,
+		},
+		{
+			name:   "success admin",
+			userID: 1,
+			input:  &transferusecase.CaptureParams{HoldID: 1},
+			hold:   mockHold,
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, hold *transfer.Hold) {
+				mockCapture(tranRepo, accRepo, ledgerUC, hold, 100, 0)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:   "fail payer cannot capture",
+			userID: 10,
+			input:  &transferusecase.CaptureParams{HoldID: 1},
+			hold:   mockHold,
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, hold *transfer.Hold) {
+			},
+			expectedErr: errs.ErrNoPermission,
+		},
+		{
+			name:  "fail expired",
+			input: &transferusecase.CaptureParams{HoldID: 1},
+			hold: func() *transfer.Hold {
+				h := mockHold()
+				h.ExpiresAt = time.Now().Add(-time.Minute)
+				return h
+			},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, hold *transfer.Hold) {
+			},
+			expectedErr: errs.ErrHoldExpired,
+		},
+		{
+			name:  "fail amount above hold",
+			input: &transferusecase.CaptureParams{HoldID: 1, Amount: 101},
+			hold:  mockHold,
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, hold *transfer.Hold) {
+			},
+			expectedErr: errs.ErrInvalidCaptureAmount,
+		},
+		{
+			name:  "fail voided concurrently",
+			input: &transferusecase.CaptureParams{HoldID: 1},
+			hold:  mockHold,
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, hold *transfer.Hold) {
+				tranRepo.EXPECT().FindLimits(gomock.Any(), hold.AccountID).Return(mocks.MockLimitsData(), nil).Times(1)
+
+				voided := mockHold()
+				voided.Status = transfer.HoldVoided
+				tranRepo.EXPECT().FindHoldForUpdate(gomock.Any(), gomock.Any(), hold.ID).Return(voided, nil).Times(1)
+			},
+			expectedErr: errs.ErrHoldNotAuthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rule := tc.rule
+			if rule == nil {
+				rule = &fee.Rule{}
+			}
+			uc, tranRepo, accRepo, ledgerUC := setupWithFee(t, rule)
+
+			hold := tc.hold()
+			mockFindHold(tranRepo, accRepo, hold)
+			tc.mockFn(tranRepo, accRepo, ledgerUC, hold)
+
+			ctx := holdCtx(tc.userID)
+			result, err := uc.Capture(ctx, tc.input)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, transfer.HoldCaptured, result.Hold.Status)
+				assert.Equal(t, result.Transfer.Amount, result.Hold.CapturedAmount)
+				assert.Equal(t, tc.fee, result.Transfer.Fee)
+				assert.Equal(t, result.Transfer.ID, *result.Hold.TransferID)
+			}
+		})
+	}
+}
+
+func TestVoidHold(t *testing.T) {
+	type testCase struct {
+		name        string
+		status      transfer.HoldStatus
+		userID      int64
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{name: "success recipient", status: transfer.HoldAuthorized, userID: 100},
+		{name: "success admin", status: transfer.HoldAuthorized, userID: 1},
+		{name: "fail already captured", status: transfer.HoldCaptured, userID: 100, expectedErr: errs.ErrHoldNotAuthorized},
+		{name: "fail payer cannot void", status: transfer.HoldAuthorized, userID: 10, expectedErr: errs.ErrNoPermission},
+		{name: "fail not either side", status: transfer.HoldAuthorized, userID: 99, expectedErr: errs.ErrHoldNotFound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, tranRepo, accRepo, _ := setup(t)
+
+			hold := mockHold()
+			hold.Status = tc.status
+			mockFindHold(tranRepo, accRepo, hold)
+
+			if tc.userID == 100 || tc.userID == 1 {
+				tranRepo.EXPECT().FindHoldForUpdate(gomock.Any(), gomock.Any(), hold.ID).Return(hold, nil).Times(1)
+			}
+			if tc.expectedErr == nil {
+				accRepo.EXPECT().AddHeldAmount(gomock.Any(), gomock.Any(), hold.AccountID, -hold.Amount).Return(mocks.MockAccountData(), nil).Times(1)
+				tranRepo.EXPECT().UpdateHold(gomock.Any(), gomock.Any(), hold).Return(hold, nil).Times(1)
+			}
+
+			result, err := uc.Void(holdCtx(tc.userID), hold.ID)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, transfer.HoldVoided, result.Status)
+			}
+		})
+	}
+}
+
+func TestExpireHolds(t *testing.T) {
+	uc, tranRepo, accRepo, _ := setup(t)
+
+	h1, h2 := mockHold(), mockHold()
+	h2.ID, h2.Amount = 2, 40
+	tranRepo.EXPECT().ClaimExpiredHolds(gomock.Any(), gomock.Any(), 10).Return([]*transfer.Hold{h1, h2}, nil).Times(1)
+
+	for _, h := range []*transfer.Hold{h1, h2} {
+		accRepo.EXPECT().AddHeldAmount(gomock.Any(), gomock.Any(), h.AccountID, -h.Amount).Return(mocks.MockAccountData(), nil).Times(1)
+		tranRepo.EXPECT().UpdateHold(gomock.Any(), gomock.Any(), h).Return(h, nil).Times(1)
+	}
+
+	n, err := uc.ExpireHolds(context.Background(), 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, transfer.HoldExpired, h1.Status)
+	assert.Equal(t, transfer.HoldExpired, h2.Status)
+}
+
+// holdCtx signs in as userID, by default the recipient of mockHold. User 1
+// is an admin.
+func holdCtx(userID int64) context.Context {
+	if userID == 0 {
+		userID = 100
+	}
+	ctx := auth.SetUserID(context.Background(), userID)
+	if userID == 1 {
+		return auth.SetRole(ctx, user.RoleAdmin)
+	}
+	return auth.SetRole(ctx, user.RoleCustomer)
+}
+
+// mockHold is 100 THB held on account 1 (owner 10) for account 2 (owner 100).
+func mockHold() *transfer.Hold {
+	return &transfer.Hold{
+		ID:          1,
+		AccountID:   1,
+		ToAccountID: 2,
+		Amount:      100,
+		Currency:    "THB",
+		Status:      transfer.HoldAuthorized,
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}
+}
+
+// mockHoldWithFee is mockHold with the 2% fee priced at authorize reserved.
+func mockHoldWithFee() *transfer.Hold {
+	h := mockHold()
+	h.Fee = 2
+	return h
+}
+
+func mockHoldAccounts(accRepo *accountrepository.MockAccountRepository, input *transferusecase.HoldParams) (fromAcc, toAcc *account.Account) {
+	fromAcc = mocks.MockAccountData()
+	fromAcc.ID = input.FromAccountID
+	accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(fromAcc, nil).Times(1)
+
+	toAcc = mocks.MockAccountData()
+	toAcc.ID, toAcc.OwnerID = input.ToAccountID, 100
+	accRepo.EXPECT().FindByID(gomock.Any(), input.ToAccountID).Return(toAcc, nil).Times(1)
+	return fromAcc, toAcc
+}
+
+func mockFindHold(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, hold *transfer.Hold) {
+	tranRepo.EXPECT().FindHold(gomock.Any(), hold.ID).Return(hold, nil).Times(1)
+	mockHoldAccounts(accRepo, &transferusecase.HoldParams{FromAccountID: hold.AccountID, ToAccountID: hold.ToAccountID})
+}
+
+// mockCapture expects amount to move from account 1 to 2, plus charged to the
+// fees account, with the whole hold released as it is debited.
+func mockCapture(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, hold *transfer.Hold, amount, charged int64) {
+	tranRepo.EXPECT().FindLimits(gomock.Any(), hold.AccountID).Return(mocks.MockLimitsData(), nil).Times(1)
+	tranRepo.EXPECT().FindHoldForUpdate(gomock.Any(), gomock.Any(), hold.ID).Return(hold, nil).Times(1)
+
+	tranRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ *sql.Tx, in *transfer.Transfer) (*transfer.Transfer, error) {
+		in.ID = 9
+		return in, nil
+	}).Times(1)
+	lines := []ledger.Line{
+		{AccountID: 1, Currency: "THB", Amount: -amount},
+		{AccountID: 2, Currency: "THB", Amount: amount},
+	}
+	if charged > 0 {
+		feeAcc := mocks.MockCashAccountData()
+		feeAcc.ID, feeAcc.Type = 3, account.TypeFees
+		accRepo.EXPECT().FindSystemAccount(gomock.Any(), account.TypeFees, account.AccountCurrency("THB")).Return(feeAcc, nil).Times(1)
+		lines = append(lines,
+			ledger.Line{AccountID: 1, Currency: "THB", Amount: -charged},
+			ledger.Line{AccountID: feeAcc.ID, Currency: "THB", Amount: charged},
+		)
+	}
+	transferID := int64(9)
+	mockPost(ledgerUC, &ledgerusecase.PostParams{
+		Kind:       ledger.KindTransfer,
+		TransferID: &transferID,
+		Lines:      lines,
+		Release:    map[int64]int64{hold.AccountID: hold.Amount + hold.Fee},
+	}, nil)
+
+	tranRepo.EXPECT().SumOutgoing(gomock.Any(), gomock.Any(), hold.AccountID, gomock.Any()).Return(&transfer.Usage{Amount: amount, Count: 1}, nil).Times(1)
+	tranRepo.EXPECT().UpdateHold(gomock.Any(), gomock.Any(), hold).DoAndReturn(func(_ context.Context, _ *sql.Tx, in *transfer.Hold) (*transfer.Hold, error) {
+		return in, nil
+	}).Times(1)
+}
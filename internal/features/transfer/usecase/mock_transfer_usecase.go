@@ -0,0 +1,204 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: transfer_usecase.go
+
+// Package transferusecase is a generated GoMock package.
+package transferusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	transfer "github.com/codepnw/simple-bank/internal/features/transfer"
+	pagination "github.com/codepnw/simple-bank/pkg/utils/pagination"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTransferUsecase is a mock of TransferUsecase interface.
+type MockTransferUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferUsecaseMockRecorder
+}
+
+// MockTransferUsecaseMockRecorder is the mock recorder for MockTransferUsecase.
+type MockTransferUsecaseMockRecorder struct {
+	mock *MockTransferUsecase
+}
+
+// NewMockTransferUsecase creates a new mock instance.
+func NewMockTransferUsecase(ctrl *gomock.Controller) *MockTransferUsecase {
+	mock := &MockTransferUsecase{ctrl: ctrl}
+	mock.recorder = &MockTransferUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferUsecase) EXPECT() *MockTransferUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method.
+func (m *MockTransferUsecase) Authorize(ctx context.Context, input *HoldParams) (*transfer.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", ctx, input)
+	ret0, _ := ret[0].(*transfer.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockTransferUsecaseMockRecorder) Authorize(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockTransferUsecase)(nil).Authorize), ctx, input)
+}
+
+// Capture mocks base method.
+func (m *MockTransferUsecase) Capture(ctx context.Context, input *CaptureParams) (*CaptureResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Capture", ctx, input)
+	ret0, _ := ret[0].(*CaptureResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Capture indicates an expected call of Capture.
+func (mr *MockTransferUsecaseMockRecorder) Capture(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Capture", reflect.TypeOf((*MockTransferUsecase)(nil).Capture), ctx, input)
+}
+
+// ExpireHolds mocks base method.
+func (m *MockTransferUsecase) ExpireHolds(ctx context.Context, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireHolds", ctx, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireHolds indicates an expected call of ExpireHolds.
+func (mr *MockTransferUsecaseMockRecorder) ExpireHolds(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireHolds", reflect.TypeOf((*MockTransferUsecase)(nil).ExpireHolds), ctx, limit)
+}
+
+// GetHold mocks base method.
+func (m *MockTransferUsecase) GetHold(ctx context.Context, id int64) (*transfer.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHold", ctx, id)
+	ret0, _ := ret[0].(*transfer.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHold indicates an expected call of GetHold.
+func (mr *MockTransferUsecaseMockRecorder) GetHold(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHold", reflect.TypeOf((*MockTransferUsecase)(nil).GetHold), ctx, id)
+}
+
+// GetTransfer mocks base method.
+func (m *MockTransferUsecase) GetTransfer(ctx context.Context, id int64) (*transfer.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransfer", ctx, id)
+	ret0, _ := ret[0].(*transfer.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransfer indicates an expected call of GetTransfer.
+func (mr *MockTransferUsecaseMockRecorder) GetTransfer(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfer", reflect.TypeOf((*MockTransferUsecase)(nil).GetTransfer), ctx, id)
+}
+
+// ListHolds mocks base method.
+func (m *MockTransferUsecase) ListHolds(ctx context.Context, accountID int64, page *pagination.Page) ([]*transfer.Hold, *pagination.Meta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHolds", ctx, accountID, page)
+	ret0, _ := ret[0].([]*transfer.Hold)
+	ret1, _ := ret[1].(*pagination.Meta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListHolds indicates an expected call of ListHolds.
+func (mr *MockTransferUsecaseMockRecorder) ListHolds(ctx, accountID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHolds", reflect.TypeOf((*MockTransferUsecase)(nil).ListHolds), ctx, accountID, page)
+}
+
+// ListTransfers mocks base method.
+func (m *MockTransferUsecase) ListTransfers(ctx context.Context, input *ListTransfersParams) ([]*transfer.Transfer, *pagination.Meta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransfers", ctx, input)
+	ret0, _ := ret[0].([]*transfer.Transfer)
+	ret1, _ := ret[1].(*pagination.Meta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListTransfers indicates an expected call of ListTransfers.
+func (mr *MockTransferUsecaseMockRecorder) ListTransfers(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfers", reflect.TypeOf((*MockTransferUsecase)(nil).ListTransfers), ctx, input)
+}
+
+// Quote mocks base method.
+func (m *MockTransferUsecase) Quote(ctx context.Context, input *TransferParams) (*QuoteResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quote", ctx, input)
+	ret0, _ := ret[0].(*QuoteResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Quote indicates an expected call of Quote.
+func (mr *MockTransferUsecaseMockRecorder) Quote(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quote", reflect.TypeOf((*MockTransferUsecase)(nil).Quote), ctx, input)
+}
+
+// Reverse mocks base method.
+func (m *MockTransferUsecase) Reverse(ctx context.Context, input *ReverseParams) (*TransferResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reverse", ctx, input)
+	ret0, _ := ret[0].(*TransferResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reverse indicates an expected call of Reverse.
+func (mr *MockTransferUsecaseMockRecorder) Reverse(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reverse", reflect.TypeOf((*MockTransferUsecase)(nil).Reverse), ctx, input)
+}
+
+// Transfer mocks base method.
+func (m *MockTransferUsecase) Transfer(ctx context.Context, input *TransferParams) (*TransferResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transfer", ctx, input)
+	ret0, _ := ret[0].(*TransferResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Transfer indicates an expected call of Transfer.
+func (mr *MockTransferUsecaseMockRecorder) Transfer(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockTransferUsecase)(nil).Transfer), ctx, input)
+}
+
+// Void mocks base method.
+func (m *MockTransferUsecase) Void(ctx context.Context, id int64) (*transfer.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Void", ctx, id)
+	ret0, _ := ret[0].(*transfer.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Void indicates an expected call of Void.
+func (mr *MockTransferUsecaseMockRecorder) Void(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Void", reflect.TypeOf((*MockTransferUsecase)(nil).Void), ctx, id)
+}
@@ -1,16 +1,35 @@
 package transferusecase
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/codepnw/simple-bank/internal/features/account"
 	"github.com/codepnw/simple-bank/internal/features/entry"
+	"github.com/codepnw/simple-bank/internal/features/fee"
 	"github.com/codepnw/simple-bank/internal/features/transfer"
+	"github.com/codepnw/simple-bank/pkg/fx"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/codepnw/simple-bank/pkg/utils/pagination"
 )
 
 type TransferParams struct {
-	FromAccountID int64  `json:"from_account_id"`
-	ToAccountID   int64  `json:"to_account_id"`
-	Amount        int64  `json:"amount"`
-	Currency      string `json:"currency"`
+	FromAccountID  int64  `json:"from_account_id"`
+	ToAccountID    int64  `json:"to_account_id"`
+	Amount         int64  `json:"amount"`
+	Currency       string `json:"currency"`
+	IdempotencyKey string `json:"-"`
+}
+
+// requestHash fingerprints the payload so a replayed idempotency key can be
+// matched against the request that first used it.
+func (p *TransferParams) requestHash() string {
+	raw := fmt.Sprintf("%d|%d|%d|%s", p.FromAccountID, p.ToAccountID, p.Amount, strings.ToUpper(p.Currency))
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
 }
 
 type TransferResult struct {
@@ -19,4 +38,79 @@ type TransferResult struct {
 	ToAccount   *account.Account   `json:"to_account"`
 	FromEntry   *entry.Entry       `json:"from_entry"`
 	ToEntry     *entry.Entry       `json:"to_entry"`
+	Fee         *fee.Breakdown     `json:"fee,omitempty"`
+	FeeEntry    *entry.Entry       `json:"fee_entry,omitempty"` // sender's fee debit
+}
+
+// QuoteResult prices a transfer before it is made: the sender pays
+// TotalDebit (Amount plus the fee), the recipient gets ToAmount.
+type QuoteResult struct {
+	FromAccountID int64          `json:"from_account_id"`
+	ToAccountID   int64          `json:"to_account_id"`
+	Amount        int64          `json:"amount"`
+	Currency      string         `json:"currency"`
+	Fee           *fee.Breakdown `json:"fee"`
+	TotalDebit    int64          `json:"total_debit"`
+	ToAmount      int64          `json:"to_amount"`
+	ToCurrency    string         `json:"to_currency"`
+	ExchangeRate  fx.Rate        `json:"exchange_rate" swaggertype:"number" example:"36.5"`
+}
+
+// ReverseParams returns Amount of a transfer, in the recipient's currency,
+// to its sender. Zero reverses the whole amount received.
+type ReverseParams struct {
+	TransferID int64
+	Amount     int64
+}
+
+// amount resolves the amount to send back against the original transfer.
+func (p *ReverseParams) amount(t *transfer.Transfer) (int64, error) {
+	if p.Amount == 0 {
+		return t.ToAmount, nil
+	}
+	if p.Amount < 0 || p.Amount > t.ToAmount {
+		return 0, errs.ErrInvalidReversalAmount
+	}
+	return p.Amount, nil
+}
+
+// HoldParams reserves Amount on FromAccountID for a later transfer to
+// ToAccountID.
+type HoldParams struct {
+	FromAccountID int64
+	ToAccountID   int64
+	Amount        int64
+	Currency      string
+}
+
+// CaptureParams turns a hold into a transfer of Amount; zero captures the
+// whole hold.
+type CaptureParams struct {
+	HoldID int64
+	Amount int64
+}
+
+type CaptureResult struct {
+	Hold *transfer.Hold `json:"hold"`
+	TransferResult
+}
+
+type ListTransfersParams struct {
+	AccountID int64
+	Direction string
+	From      *time.Time
+	To        *time.Time
+	MinAmount int64
+	MaxAmount int64
+	Page      *pagination.Page
+}
+
+func (p *ListTransfersParams) validate() error {
+	if p.From != nil && p.To != nil && p.From.After(*p.To) {
+		return errs.ErrInvalidTimeRange
+	}
+	if p.MinAmount > 0 && p.MaxAmount > 0 && p.MinAmount > p.MaxAmount {
+		return errs.ErrInvalidAmountRange
+	}
+	return nil
 }
@@ -3,41 +3,79 @@ package transferusecase
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
 
 	"github.com/codepnw/simple-bank/internal/consts"
 	"github.com/codepnw/simple-bank/internal/features/account"
 	accountrepository "github.com/codepnw/simple-bank/internal/features/account/repository"
-	"github.com/codepnw/simple-bank/internal/features/entry"
-	entryrepository "github.com/codepnw/simple-bank/internal/features/entry/repository"
+	"github.com/codepnw/simple-bank/internal/features/fee"
+	feerepository "github.com/codepnw/simple-bank/internal/features/fee/repository"
+	"github.com/codepnw/simple-bank/internal/features/ledger"
+	ledgerusecase "github.com/codepnw/simple-bank/internal/features/ledger/usecase"
 	"github.com/codepnw/simple-bank/internal/features/transfer"
 	transferrepository "github.com/codepnw/simple-bank/internal/features/transfer/repository"
+	"github.com/codepnw/simple-bank/internal/features/user"
 	"github.com/codepnw/simple-bank/pkg/auth"
+	"github.com/codepnw/simple-bank/pkg/currency"
 	"github.com/codepnw/simple-bank/pkg/database"
+	"github.com/codepnw/simple-bank/pkg/fx"
 	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/codepnw/simple-bank/pkg/utils/pagination"
 )
 
+//go:generate mockgen -source=transfer_usecase.go -destination=mock_transfer_usecase.go -package=transferusecase
 type TransferUsecase interface {
 	Transfer(ctx context.Context, input *TransferParams) (*TransferResult, error)
+	// Quote prices a transfer, fee included, without making it.
+	Quote(ctx context.Context, input *TransferParams) (*QuoteResult, error)
+	Reverse(ctx context.Context, input *ReverseParams) (*TransferResult, error)
+	GetTransfer(ctx context.Context, id int64) (*transfer.Transfer, error)
+	ListTransfers(ctx context.Context, input *ListTransfersParams) ([]*transfer.Transfer, *pagination.Meta, error)
+
+	// Holds: authorize now, capture into a transfer or void later
+	Authorize(ctx context.Context, input *HoldParams) (*transfer.Hold, error)
+	Capture(ctx context.Context, input *CaptureParams) (*CaptureResult, error)
+	Void(ctx context.Context, id int64) (*transfer.Hold, error)
+	GetHold(ctx context.Context, id int64) (*transfer.Hold, error)
+	ListHolds(ctx context.Context, accountID int64, page *pagination.Page) ([]*transfer.Hold, *pagination.Meta, error)
+
+	// ExpireHolds releases up to limit expired holds for the background
+	// worker and returns how many it claimed.
+	ExpireHolds(ctx context.Context, limit int) (int, error)
 }
 
 type transferUsecase struct {
-	tranRepo transferrepository.TransferRepository
-	accRepo  accountrepository.AccountRepository
-	entRepo  entryrepository.EntryRepository
-	tx       database.TxManager
+	tranRepo   transferrepository.TransferRepository
+	accRepo    accountrepository.AccountRepository
+	feeRepo    feerepository.FeeRepository
+	ledger     ledgerusecase.LedgerUsecase
+	tx         database.TxManager
+	fx         fx.FXRateProvider
+	currencies *currency.Registry
+	holdTTL    time.Duration
 }
 
 func NewTransferUsecase(
 	tranRepo transferrepository.TransferRepository,
 	accRepo accountrepository.AccountRepository,
-	entRepo entryrepository.EntryRepository,
+	feeRepo feerepository.FeeRepository,
+	ledger ledgerusecase.LedgerUsecase,
 	tx database.TxManager,
+	fxProvider fx.FXRateProvider,
+	currencies *currency.Registry,
+	holdTTL time.Duration,
 ) TransferUsecase {
 	return &transferUsecase{
-		tranRepo: tranRepo,
-		accRepo:  accRepo,
-		entRepo:  entRepo,
-		tx:       tx,
+		tranRepo:   tranRepo,
+		accRepo:    accRepo,
+		feeRepo:    feeRepo,
+		ledger:     ledger,
+		tx:         tx,
+		fx:         fxProvider,
+		currencies: currencies,
+		holdTTL:    holdTTL,
 	}
 }
 
@@ -47,81 +85,252 @@ func (u *transferUsecase) Transfer(ctx context.Context, input *TransferParams) (
 
 	userID := auth.GetUserID(ctx)
 
-	if input.FromAccountID == input.ToAccountID {
-		return nil, errs.ErrTransferToSelf
+	// Replay a previous result when the same idempotency key is retried
+	if input.IdempotencyKey != "" {
+		if len(input.IdempotencyKey) > consts.IdempotencyKeyMaxLen {
+			return nil, errs.ErrInvalidIdempotencyKey
+		}
+		result, err := u.replayTransfer(ctx, userID, input)
+		if err == nil {
+			return result, nil
+		}
+		if !errors.Is(err, errs.ErrIdempotencyKeyNotFound) {
+			return nil, err
+		}
 	}
 
-	fromAcc, err := u.accRepo.FindByID(ctx, input.FromAccountID)
+	quote, fromAcc, toAcc, err := u.quote(ctx, userID, input)
 	if err != nil {
 		return nil, err
 	}
-	// Check Owner
-	if fromAcc.OwnerID != userID {
-		return nil, errs.ErrAccountNotFound
-	}
-	// Check Currency
-	if fromAcc.Currency != account.AccountCurrency(input.Currency) {
-		return nil, errs.ErrCurrencyMismatch
+	// Check Balance: funds reserved by holds can't be spent, the overdraft
+	// can. Both are checked again under the row lock
+	if fromAcc.AvailableBalance < quote.TotalDebit {
+		return nil, errs.ErrMoneyNotEnough
 	}
-
-	toAcc, err := u.accRepo.FindByID(ctx, input.ToAccountID)
+	// Check Limits: per transfer now, rolling totals under the row lock
+	limits, err := u.tranRepo.FindLimits(ctx, fromAcc.ID)
 	if err != nil {
 		return nil, err
 	}
-	// Check Currency
-	if fromAcc.Currency != toAcc.Currency {
-		return nil, errs.ErrCurrencyMismatch
-	}
-	// Check Balance
-	if fromAcc.Balance < input.Amount {
-		return nil, errs.ErrMoneyNotEnough
+	if err = limits.CheckAmount(input.Amount); err != nil {
+		return nil, err
 	}
 
-	result := new(TransferResult)
+	var result *TransferResult
 	err = u.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
 		var err error
 
-		// Create Transfer
-		result.Transfer, err = u.tranRepo.Insert(ctx, tx, &transfer.Transfer{
+		result, err = u.post(ctx, tx, &transfer.Transfer{
 			FromAccountID: input.FromAccountID,
 			ToAccountID:   input.ToAccountID,
 			Amount:        input.Amount,
-		})
+			ToAmount:      quote.ToAmount,
+			ExchangeRate:  quote.ExchangeRate,
+			Fee:           quote.Fee.Total,
+		}, fromAcc.Currency, toAcc.Currency, 0)
 		if err != nil {
 			return err
 		}
-
-		// Create Entry From Account (minus)
-		result.FromEntry, err = u.entRepo.Insert(ctx, tx, &entry.Entry{
-			AccountID: input.FromAccountID,
-			Amount:    -input.Amount, // minus
-		})
-		if err != nil {
+		result.Fee = quote.Fee
+		// Re-check under the row locks: a freeze or a hold may have landed
+		// since the accounts were read
+		if err = result.FromAccount.CheckActive(); err != nil {
 			return err
 		}
-
-		// Create Entry To Account (plus)
-		result.ToEntry, err = u.entRepo.Insert(ctx, tx, &entry.Entry{
-			AccountID: input.ToAccountID,
-			Amount:    input.Amount, // plus
-		})
+		if err = result.ToAccount.CheckActive(); err != nil {
+			return err
+		}
+		if result.FromAccount.AvailableBalance < 0 {
+			return errs.ErrMoneyNotEnough
+		}
+		// The source row is locked, so concurrent transfers from it are
+		// already committed and counted
+		used, err := u.tranRepo.SumOutgoing(ctx, tx, input.FromAccountID, time.Now().Add(-consts.TransferLimitWindow))
 		if err != nil {
 			return err
 		}
+		if err = limits.CheckUsage(input.Amount, used); err != nil {
+			return err
+		}
+		u.display(result.FromAccount, result.ToAccount)
+
+		// Save Idempotency Key
+		if input.IdempotencyKey != "" {
+			return u.saveIdempotencyKey(ctx, tx, userID, input, result)
+		}
+		return nil
+	})
+	if err != nil {
+		// A concurrent request with the same key committed first
+		if errors.Is(err, errs.ErrIdempotencyKeyExists) {
+			return u.replayTransfer(ctx, userID, input)
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
+func (u *transferUsecase) Quote(ctx context.Context, input *TransferParams) (*QuoteResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	userID := auth.GetUserID(ctx)
+	if userID == 0 {
+		return nil, errs.ErrNoUserID
+	}
+
+	quote, _, _, err := u.quote(ctx, userID, input)
+	if err != nil {
+		return nil, err
+	}
+	return quote, nil
+}
+
+// quote runs the checks a transfer must pass before any money is counted:
+// ownership, status and currency of both sides. It prices the transfer with
+// its fee and returns both accounts as read.
+func (u *transferUsecase) quote(ctx context.Context, userID int64, input *TransferParams) (*QuoteResult, *account.Account, *account.Account, error) {
+	if input.FromAccountID == input.ToAccountID {
+		return nil, nil, nil, errs.ErrTransferToSelf
+	}
+
+	fromAcc, err := u.accRepo.FindByID(ctx, input.FromAccountID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	// Check Owner: system accounts have no balance floor, so never a source
+	if fromAcc.OwnerID != userID || fromAcc.Type != account.TypeCustomer {
+		return nil, nil, nil, errs.ErrAccountNotFound
+	}
+	// Check Status
+	if err = fromAcc.CheckActive(); err != nil {
+		return nil, nil, nil, err
+	}
+	// Check Currency
+	fromCurr, err := u.currencies.Lookup(input.Currency)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if fromAcc.Currency != account.AccountCurrency(fromCurr.Code) {
+		return nil, nil, nil, errs.ErrCurrencyMismatch
+	}
+
+	toAcc, err := u.accRepo.FindByID(ctx, input.ToAccountID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	// System accounts only move money through their own use cases
+	if toAcc.Type != account.TypeCustomer {
+		return nil, nil, nil, errs.ErrAccountNotFound
+	}
+	if err = toAcc.CheckActive(); err != nil {
+		return nil, nil, nil, err
+	}
+	rate, toAmount, err := u.convert(ctx, input.Amount, fromCurr, string(toAcc.Currency))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// Fee: charged to the sender on top of the amount
+	rule, err := u.feeRepo.FindRule(ctx, fee.OpTransfer, fromCurr.Code, input.Amount)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	fees := rule.Apply(input.Amount)
+
+	return &QuoteResult{
+		FromAccountID: fromAcc.ID,
+		ToAccountID:   toAcc.ID,
+		Amount:        input.Amount,
+		Currency:      fromCurr.Code,
+		Fee:           fees,
+		TotalDebit:    input.Amount + fees.Total,
+		ToAmount:      toAmount,
+		ToCurrency:    string(toAcc.Currency),
+		ExchangeRate:  rate,
+	}, fromAcc, toAcc, nil
+}
 
-		// Update Balance
-		// NOTE: Prevent "Deadlock" sort by ID
-		if input.FromAccountID < input.ToAccountID {
-			// Lock 1 (From) -> Lock 2 (To)
-			result.FromAccount, result.ToAccount, err = u.addMoney(ctx, tx, input.FromAccountID, -input.Amount, input.ToAccountID, input.Amount)
-		} else {
-			// Lock 1 (To) -> Lock 2 (From)
-			result.FromAccount, result.ToAccount, err = u.addMoney(ctx, tx, input.ToAccountID, input.Amount, input.FromAccountID, -input.Amount)
+// Reverse sends money back from the recipient of a transfer to its sender as
+// a linked compensating transfer. The recipient or an admin may reverse; an
+// admin can also reverse out of a frozen account, e.g. to claw back fraud.
+// Reversals don't count towards the recipient's transfer limits.
+func (u *transferUsecase) Reverse(ctx context.Context, input *ReverseParams) (*TransferResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	userID := auth.GetUserID(ctx)
+	if userID == 0 {
+		return nil, errs.ErrNoUserID
+	}
+	isAdmin := auth.HasRole(ctx, user.RoleAdmin)
+
+	orig, err := u.tranRepo.FindByID(ctx, input.TransferID)
+	if err != nil {
+		return nil, err
+	}
+	if err = orig.CheckReversible(); err != nil {
+		return nil, err
+	}
+
+	// The money flows back: the original recipient pays, the sender receives
+	payer, err := u.accRepo.FindByID(ctx, orig.ToAccountID)
+	if err != nil {
+		return nil, err
+	}
+	payee, err := u.accRepo.FindByID(ctx, orig.FromAccountID)
+	if err != nil {
+		return nil, err
+	}
+	// Check Owner: the sender can see the transfer but can't pull money back
+	if !isAdmin && payer.OwnerID != userID {
+		if payee.OwnerID == userID {
+			return nil, errs.ErrNoPermission
 		}
+		return nil, errs.ErrTransferNotFound
+	}
+	// Check Status
+	if err = u.checkReversalStatus(isAdmin, payer, payee); err != nil {
+		return nil, err
+	}
+
+	amount, err := input.amount(orig)
+	if err != nil {
+		return nil, err
+	}
+	refund := orig.Refund(amount)
+	if refund <= 0 {
+		return nil, errs.ErrInvalidReversalAmount
+	}
+	// Check Balance
+	if payer.AvailableBalance < amount {
+		return nil, errs.ErrMoneyNotEnough
+	}
 
+	var result *TransferResult
+	err = u.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
+		var err error
+
+		result, err = u.post(ctx, tx, &transfer.Transfer{
+			FromAccountID: payer.ID,
+			ToAccountID:   payee.ID,
+			Amount:        amount,
+			ToAmount:      refund,
+			ExchangeRate:  orig.ExchangeRate.Inverse(),
+			ReversalOf:    &orig.ID,
+		}, payer.Currency, payee.Currency, 0)
 		if err != nil {
 			return err
 		}
+		// Re-check under the row locks
+		if err = u.checkReversalStatus(isAdmin, result.FromAccount, result.ToAccount); err != nil {
+			return err
+		}
+		if result.FromAccount.AvailableBalance < 0 {
+			return errs.ErrMoneyNotEnough
+		}
+		u.display(result.FromAccount, result.ToAccount)
 		return nil
 	})
 	if err != nil {
@@ -130,16 +339,216 @@ func (u *transferUsecase) Transfer(ctx context.Context, input *TransferParams) (
 	return result, nil
 }
 
-func (u *transferUsecase) addMoney(ctx context.Context, tx *sql.Tx, accID1, amount1, accID2, amount2 int64) (acc1 *account.Account, acc2 *account.Account, err error) {
-	acc1, err = u.accRepo.AddAccountBalance(ctx, tx, accID1, amount1)
+// checkReversalStatus lets an admin move money out of a frozen account;
+// closed accounts are final for everyone.
+func (u *transferUsecase) checkReversalStatus(isAdmin bool, accounts ...*account.Account) error {
+	for _, a := range accounts {
+		err := a.CheckActive()
+		if err == nil || (isAdmin && errors.Is(err, errs.ErrAccountFrozen)) {
+			continue
+		}
+		return err
+	}
+	return nil
+}
+
+func (u *transferUsecase) GetTransfer(ctx context.Context, id int64) (*transfer.Transfer, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	userID := auth.GetUserID(ctx)
+	if userID == 0 {
+		return nil, errs.ErrNoUserID
+	}
+
+	t, err := u.tranRepo.FindByID(ctx, id)
 	if err != nil {
+		return nil, err
+	}
+
+	// Check Owner: either side of the transfer
+	for _, accID := range []int64{t.FromAccountID, t.ToAccountID} {
+		acc, err := u.accRepo.FindByID(ctx, accID)
+		if err != nil {
+			return nil, err
+		}
+		if acc.OwnerID == userID {
+			return t, nil
+		}
+	}
+	return nil, errs.ErrTransferNotFound
+}
+
+func (u *transferUsecase) ListTransfers(ctx context.Context, input *ListTransfersParams) ([]*transfer.Transfer, *pagination.Meta, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	userID := auth.GetUserID(ctx)
+	if userID == 0 {
+		return nil, nil, errs.ErrNoUserID
+	}
+
+	if err := input.validate(); err != nil {
 		return nil, nil, err
 	}
 
-	acc2, err = u.accRepo.AddAccountBalance(ctx, tx, accID2, amount2)
+	// Check Owner
+	if input.AccountID > 0 {
+		acc, err := u.accRepo.FindByID(ctx, input.AccountID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if acc.OwnerID != userID {
+			return nil, nil, errs.ErrAccountNotFound
+		}
+	}
+
+	transfers, err := u.tranRepo.List(ctx, &transfer.Filter{
+		OwnerID:   userID,
+		AccountID: input.AccountID,
+		Direction: input.Direction,
+		From:      input.From,
+		To:        input.To,
+		MinAmount: input.MinAmount,
+		MaxAmount: input.MaxAmount,
+		Page:      input.Page,
+	})
 	if err != nil {
 		return nil, nil, err
 	}
 
-	return acc1, acc2, nil
+	transfers, meta := pagination.Trim(transfers, input.Page, func(t *transfer.Transfer) pagination.Cursor {
+		return pagination.Cursor{CreatedAt: t.CreatedAt, ID: t.ID}
+	})
+	return transfers, meta, nil
+}
+
+func (u *transferUsecase) replayTransfer(ctx context.Context, userID int64, input *TransferParams) (*TransferResult, error) {
+	key, err := u.tranRepo.FindIdempotencyKey(ctx, userID, input.IdempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.RequestHash != input.requestHash() {
+		return nil, errs.ErrIdempotencyKeyConflict
+	}
+
+	result := new(TransferResult)
+	if err = json.Unmarshal(key.Response, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (u *transferUsecase) saveIdempotencyKey(ctx context.Context, tx *sql.Tx, userID int64, input *TransferParams, result *TransferResult) error {
+	resp, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	return u.tranRepo.InsertIdempotencyKey(ctx, tx, &transfer.IdempotencyKey{
+		UserID:      userID,
+		Key:         input.IdempotencyKey,
+		RequestHash: input.requestHash(),
+		TransferID:  result.Transfer.ID,
+		Response:    resp,
+	})
+}
+
+// convert prices amount of from in the destination currency. The rate is
+// the identity when both sides share a currency.
+func (u *transferUsecase) convert(ctx context.Context, amount int64, from *currency.Currency, to string) (fx.Rate, int64, error) {
+	toCurr, err := u.currencies.Lookup(to)
+	if err != nil {
+		return 0, 0, err
+	}
+	rate, err := u.fx.Rate(ctx, from.Code, toCurr.Code)
+	if err != nil {
+		return 0, 0, err
+	}
+	toAmount := rate.Convert(amount, from.Exponent, toCurr.Exponent)
+	if toAmount <= 0 {
+		return 0, 0, errs.ErrInvalidAmount
+	}
+	return rate, toAmount, nil
+}
+
+// post records t and its journal: a debit on the sender and a credit on the
+// recipient. Across currencies the money passes through the FX account of
+// each side, so both currencies balance on their own. A fee is a second
+// debit on the sender, credited to the fees account of its currency. held is
+// the amount of a hold released on the sender as it is debited.
+func (u *transferUsecase) post(ctx context.Context, tx *sql.Tx, t *transfer.Transfer, fromCurr, toCurr account.AccountCurrency, held int64) (*TransferResult, error) {
+	var (
+		result = new(TransferResult)
+		err    error
+	)
+
+	// Create Transfer
+	result.Transfer, err = u.tranRepo.Insert(ctx, tx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := []ledger.Line{{AccountID: t.FromAccountID, Currency: string(fromCurr), Amount: -t.Amount}}
+	if fromCurr != toCurr {
+		fxFrom, err := u.accRepo.FindSystemAccount(ctx, account.TypeFX, fromCurr)
+		if err != nil {
+			return nil, err
+		}
+		fxTo, err := u.accRepo.FindSystemAccount(ctx, account.TypeFX, toCurr)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines,
+			ledger.Line{AccountID: fxFrom.ID, Currency: string(fromCurr), Amount: t.Amount},
+			ledger.Line{AccountID: fxTo.ID, Currency: string(toCurr), Amount: -t.ToAmount},
+		)
+	}
+	lines = append(lines, ledger.Line{AccountID: t.ToAccountID, Currency: string(toCurr), Amount: t.ToAmount})
+	toLine := len(lines) - 1
+
+	if t.Fee > 0 {
+		feeAcc, err := u.accRepo.FindSystemAccount(ctx, account.TypeFees, fromCurr)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines,
+			ledger.Line{AccountID: t.FromAccountID, Currency: string(fromCurr), Amount: -t.Fee},
+			ledger.Line{AccountID: feeAcc.ID, Currency: string(fromCurr), Amount: t.Fee},
+		)
+	}
+
+	kind := ledger.KindTransfer
+	if t.ReversalOf != nil {
+		kind = ledger.KindReversal
+	}
+	params := &ledgerusecase.PostParams{
+		Kind:       kind,
+		TransferID: &result.Transfer.ID,
+		Lines:      lines,
+	}
+	if held > 0 {
+		params.Release = map[int64]int64{t.FromAccountID: held}
+	}
+	posted, err := u.ledger.Post(ctx, tx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := posted.Journal.Entries
+	result.FromEntry, result.ToEntry = entries[0], entries[toLine]
+	if t.Fee > 0 {
+		result.FeeEntry = entries[toLine+1]
+	}
+	result.FromAccount = posted.Accounts[t.FromAccountID]
+	result.ToAccount = posted.Accounts[t.ToAccountID]
+	return result, nil
+}
+
+// display fills in the formatted balance of each account.
+func (u *transferUsecase) display(accounts ...*account.Account) {
+	for _, a := range accounts {
+		a.BalanceDisplay = u.currencies.Format(a.Balance, string(a.Currency))
+	}
 }
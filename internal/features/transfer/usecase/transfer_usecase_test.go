@@ -2,16 +2,27 @@ package transferusecase_test
 
 import (
 	"context"
+	"database/sql"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/codepnw/simple-bank/internal/consts"
+	"github.com/codepnw/simple-bank/internal/features/account"
 	accountrepository "github.com/codepnw/simple-bank/internal/features/account/repository"
-	"github.com/codepnw/simple-bank/internal/features/entry"
-	entryrepository "github.com/codepnw/simple-bank/internal/features/entry/repository"
+	"github.com/codepnw/simple-bank/internal/features/fee"
+	feerepository "github.com/codepnw/simple-bank/internal/features/fee/repository"
+	"github.com/codepnw/simple-bank/internal/features/ledger"
+	ledgerusecase "github.com/codepnw/simple-bank/internal/features/ledger/usecase"
+	"github.com/codepnw/simple-bank/internal/features/transfer"
 	transferrepository "github.com/codepnw/simple-bank/internal/features/transfer/repository"
 	transferusecase "github.com/codepnw/simple-bank/internal/features/transfer/usecase"
+	"github.com/codepnw/simple-bank/internal/features/user"
 	"github.com/codepnw/simple-bank/internal/mocks"
 	"github.com/codepnw/simple-bank/pkg/auth"
+	"github.com/codepnw/simple-bank/pkg/fx"
 	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/codepnw/simple-bank/pkg/utils/pagination"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
@@ -20,20 +31,20 @@ func TestTransfer(t *testing.T) {
 	type testCase struct {
 		name        string
 		input       *transferusecase.TransferParams
-		mockFn      func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *transferusecase.TransferParams)
+		mockFn      func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams)
 		expectedErr error
 	}
 
 	testCases := []testCase{
 		{
-			name: "success FromID < ToID",
+			name: "success",
 			input: &transferusecase.TransferParams{
 				FromAccountID: 1,
 				ToAccountID:   2,
 				Amount:        10,
 				Currency:      "THB",
 			},
-			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *transferusecase.TransferParams) {
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams) {
 				fromAcc := mocks.MockAccountData()
 				accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(fromAcc, nil).Times(1)
 
@@ -41,57 +52,21 @@ func TestTransfer(t *testing.T) {
 				toAcc.OwnerID = 100
 				accRepo.EXPECT().FindByID(gomock.Any(), input.ToAccountID).Return(toAcc, nil).Times(1)
 
-				mockTrans := mocks.MockTransferData(input)
-				tranRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockTrans, nil).Times(1)
-
-				mockFromEnt := &entry.Entry{AccountID: input.FromAccountID, Amount: -input.Amount}
-				entRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockFromEnt, nil).Times(1)
-
-				mockToEnt := &entry.Entry{AccountID: input.FromAccountID, Amount: input.Amount}
-				entRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockToEnt, nil).Times(1)
-
-				addFromAcc := mocks.MockAccountData()
-				addFromAcc.Balance += -input.Amount
-				accRepo.EXPECT().AddAccountBalance(gomock.Any(), gomock.Any(), input.FromAccountID, -input.Amount).Return(addFromAcc, nil).Times(1)
-
-				addToAcc := mocks.MockAccountData()
-				addToAcc.Balance += input.Amount
-				accRepo.EXPECT().AddAccountBalance(gomock.Any(), gomock.Any(), input.ToAccountID, input.Amount).Return(addToAcc, nil).Times(1)
-			},
-			expectedErr: nil,
-		},
-		{
-			name: "success FromID > ToID",
-			input: &transferusecase.TransferParams{
-				FromAccountID: 3,
-				ToAccountID:   2,
-				Amount:        10,
-				Currency:      "THB",
-			},
-			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *transferusecase.TransferParams) {
-				fromAcc := mocks.MockAccountData()
-				accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(fromAcc, nil).Times(1)
-
-				toAcc := mocks.MockAccountData()
-				toAcc.OwnerID = 100
-				accRepo.EXPECT().FindByID(gomock.Any(), input.ToAccountID).Return(toAcc, nil).Times(1)
+				tranRepo.EXPECT().FindLimits(gomock.Any(), fromAcc.ID).Return(mocks.MockLimitsData(), nil).Times(1)
 
 				mockTrans := mocks.MockTransferData(input)
 				tranRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockTrans, nil).Times(1)
 
-				mockFromEnt := &entry.Entry{AccountID: input.FromAccountID, Amount: -input.Amount}
-				entRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockFromEnt, nil).Times(1)
-
-				mockToEnt := &entry.Entry{AccountID: input.FromAccountID, Amount: input.Amount}
-				entRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockToEnt, nil).Times(1)
+				mockPost(ledgerUC, &ledgerusecase.PostParams{
+					Kind:       ledger.KindTransfer,
+					TransferID: &mockTrans.ID,
+					Lines: []ledger.Line{
+						{AccountID: input.FromAccountID, Currency: "THB", Amount: -input.Amount},
+						{AccountID: input.ToAccountID, Currency: "THB", Amount: input.Amount},
+					},
+				}, nil)
 
-				addToAcc := mocks.MockAccountData()
-				addToAcc.Balance += input.Amount
-				accRepo.EXPECT().AddAccountBalance(gomock.Any(), gomock.Any(), input.ToAccountID, input.Amount).Return(addToAcc, nil).Times(1)
-
-				addFromAcc := mocks.MockAccountData()
-				addFromAcc.Balance += -input.Amount
-				accRepo.EXPECT().AddAccountBalance(gomock.Any(), gomock.Any(), input.FromAccountID, -input.Amount).Return(addFromAcc, nil).Times(1)
+				tranRepo.EXPECT().SumOutgoing(gomock.Any(), gomock.Any(), input.FromAccountID, gomock.Any()).Return(&transfer.Usage{Amount: input.Amount, Count: 1}, nil).Times(1)
 			},
 			expectedErr: nil,
 		},
@@ -103,7 +78,7 @@ func TestTransfer(t *testing.T) {
 				Amount:        10,
 				Currency:      "THB",
 			},
-			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *transferusecase.TransferParams) {
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams) {
 			},
 			expectedErr: errs.ErrTransferToSelf,
 		},
@@ -115,13 +90,29 @@ func TestTransfer(t *testing.T) {
 				Amount:        10,
 				Currency:      "THB",
 			},
-			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *transferusecase.TransferParams) {
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams) {
 				a := mocks.MockAccountData()
 				a.OwnerID = 100
 				accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(a, nil).Times(1)
 			},
 			expectedErr: errs.ErrAccountNotFound,
 		},
+		{
+			name: "fail system account source",
+			input: &transferusecase.TransferParams{
+				FromAccountID: 1,
+				ToAccountID:   2,
+				Amount:        10,
+				Currency:      "THB",
+			},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams) {
+				// Owned by the caller, as when a user took the system username
+				a := mocks.MockCashAccountData()
+				a.OwnerID, a.Type = 10, account.TypeFees
+				accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(a, nil).Times(1)
+			},
+			expectedErr: errs.ErrAccountNotFound,
+		},
 		{
 			name: "fail get from account",
 			input: &transferusecase.TransferParams{
@@ -130,7 +121,7 @@ func TestTransfer(t *testing.T) {
 				Amount:        10,
 				Currency:      "THB",
 			},
-			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *transferusecase.TransferParams) {
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams) {
 				accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(nil, mocks.ErrDatabase).Times(1)
 			},
 			expectedErr: mocks.ErrDatabase,
@@ -143,9 +134,9 @@ func TestTransfer(t *testing.T) {
 				Amount:        100,
 				Currency:      "THB",
 			},
-			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *transferusecase.TransferParams) {
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams) {
 				fromAcc := mocks.MockAccountData()
-				fromAcc.Balance = 20
+				fromAcc.Balance, fromAcc.AvailableBalance = 20, 20
 				accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(fromAcc, nil).Times(1)
 
 				accRepo.EXPECT().FindByID(gomock.Any(), input.ToAccountID).Return(nil, mocks.ErrDatabase).Times(1)
@@ -153,14 +144,143 @@ func TestTransfer(t *testing.T) {
 			expectedErr: mocks.ErrDatabase,
 		},
 		{
-			name: "fail currency not equal",
+			name: "success cross currency",
+			input: &transferusecase.TransferParams{
+				FromAccountID: 1,
+				ToAccountID:   2,
+				Amount:        100,
+				Currency:      "USD",
+			},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams) {
+				fromAcc := mocks.MockAccountData()
+				fromAcc.Currency = "USD"
+				accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(fromAcc, nil).Times(1)
+
+				toAcc := mocks.MockAccountData()
+				toAcc.OwnerID = 100
+				accRepo.EXPECT().FindByID(gomock.Any(), input.ToAccountID).Return(toAcc, nil).Times(1)
+
+				tranRepo.EXPECT().FindLimits(gomock.Any(), fromAcc.ID).Return(mocks.MockLimitsData(), nil).Times(1)
+
+				// 1.00 USD -> 36.50 THB
+				mockTrans := mocks.MockTransferData(input)
+				tranRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), &transfer.Transfer{
+					FromAccountID: 1,
+					ToAccountID:   2,
+					Amount:        100,
+					ToAmount:      3650,
+					ExchangeRate:  36_500_000,
+				}).Return(mockTrans, nil).Times(1)
+
+				// Each currency balances through its FX account
+				mockFXAccount(accRepo, "USD", 20)
+				mockFXAccount(accRepo, "THB", 21)
+				mockPost(ledgerUC, &ledgerusecase.PostParams{
+					Kind:       ledger.KindTransfer,
+					TransferID: &mockTrans.ID,
+					Lines: []ledger.Line{
+						{AccountID: 1, Currency: "USD", Amount: -100},
+						{AccountID: 20, Currency: "USD", Amount: 100},
+						{AccountID: 21, Currency: "THB", Amount: -3650},
+						{AccountID: 2, Currency: "THB", Amount: 3650},
+					},
+				}, nil)
+
+				tranRepo.EXPECT().SumOutgoing(gomock.Any(), gomock.Any(), input.FromAccountID, gomock.Any()).Return(&transfer.Usage{Amount: input.Amount, Count: 1}, nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "success cross currency exponent",
+			input: &transferusecase.TransferParams{
+				FromAccountID: 1,
+				ToAccountID:   2,
+				Amount:        250,
+				Currency:      "USD",
+			},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams) {
+				fromAcc := mocks.MockAccountData()
+				fromAcc.Currency = "USD"
+				accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(fromAcc, nil).Times(1)
+
+				toAcc := mocks.MockAccountData()
+				toAcc.Currency = "JPY"
+				accRepo.EXPECT().FindByID(gomock.Any(), input.ToAccountID).Return(toAcc, nil).Times(1)
+
+				tranRepo.EXPECT().FindLimits(gomock.Any(), fromAcc.ID).Return(mocks.MockLimitsData(), nil).Times(1)
+
+				// 2.50 USD (cents) -> 375 JPY (no minor unit)
+				mockTrans := mocks.MockTransferData(input)
+				tranRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), &transfer.Transfer{
+					FromAccountID: 1,
+					ToAccountID:   2,
+					Amount:        250,
+					ToAmount:      375,
+					ExchangeRate:  150_000_000,
+				}).Return(mockTrans, nil).Times(1)
+
+				mockFXAccount(accRepo, "USD", 20)
+				mockFXAccount(accRepo, "JPY", 22)
+				mockPost(ledgerUC, &ledgerusecase.PostParams{
+					Kind:       ledger.KindTransfer,
+					TransferID: &mockTrans.ID,
+					Lines: []ledger.Line{
+						{AccountID: 1, Currency: "USD", Amount: -250},
+						{AccountID: 20, Currency: "USD", Amount: 250},
+						{AccountID: 22, Currency: "JPY", Amount: -375},
+						{AccountID: 2, Currency: "JPY", Amount: 375},
+					},
+				}, nil)
+
+				tranRepo.EXPECT().SumOutgoing(gomock.Any(), gomock.Any(), input.FromAccountID, gomock.Any()).Return(&transfer.Usage{Amount: input.Amount, Count: 1}, nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "fail exchange rate not found",
+			input: &transferusecase.TransferParams{
+				FromAccountID: 1,
+				ToAccountID:   2,
+				Amount:        100,
+				Currency:      "THB",
+			},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams) {
+				fromAcc := mocks.MockAccountData()
+				accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(fromAcc, nil).Times(1)
+
+				toAcc := mocks.MockAccountData()
+				toAcc.Currency = "JPY"
+				accRepo.EXPECT().FindByID(gomock.Any(), input.ToAccountID).Return(toAcc, nil).Times(1)
+			},
+			expectedErr: errs.ErrExchangeRateNotFound,
+		},
+		{
+			name: "fail to account currency disabled",
 			input: &transferusecase.TransferParams{
 				FromAccountID: 1,
 				ToAccountID:   2,
 				Amount:        100,
 				Currency:      "THB",
 			},
-			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *transferusecase.TransferParams) {
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams) {
+				fromAcc := mocks.MockAccountData()
+				accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(fromAcc, nil).Times(1)
+
+				toAcc := mocks.MockAccountData()
+				toAcc.Currency = "EUR"
+				accRepo.EXPECT().FindByID(gomock.Any(), input.ToAccountID).Return(toAcc, nil).Times(1)
+			},
+			expectedErr: errs.ErrInvalidCurrency,
+		},
+		{
+			name: "fail converted amount too small",
+			input: &transferusecase.TransferParams{
+				FromAccountID: 1,
+				ToAccountID:   2,
+				Amount:        1,
+				Currency:      "THB",
+			},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams) {
 				fromAcc := mocks.MockAccountData()
 				accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(fromAcc, nil).Times(1)
 
@@ -168,7 +288,7 @@ func TestTransfer(t *testing.T) {
 				toAcc.Currency = "USD"
 				accRepo.EXPECT().FindByID(gomock.Any(), input.ToAccountID).Return(toAcc, nil).Times(1)
 			},
-			expectedErr: errs.ErrCurrencyMismatch,
+			expectedErr: errs.ErrInvalidAmount,
 		},
 		{
 			name: "fail currency mismatch",
@@ -178,7 +298,7 @@ func TestTransfer(t *testing.T) {
 				Amount:        10,
 				Currency:      "THB",
 			},
-			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *transferusecase.TransferParams) {
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams) {
 				a := mocks.MockAccountData()
 				a.Currency = "USD"
 				accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(a, nil).Times(1)
@@ -186,132 +306,195 @@ func TestTransfer(t *testing.T) {
 			expectedErr: errs.ErrCurrencyMismatch,
 		},
 		{
-			name: "fail money not enough",
+			name: "fail from account frozen",
 			input: &transferusecase.TransferParams{
 				FromAccountID: 1,
 				ToAccountID:   2,
-				Amount:        100,
+				Amount:        10,
+				Currency:      "THB",
+			},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams) {
+				fromAcc := mocks.MockAccountData()
+				fromAcc.Status = account.StatusFrozen
+				accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(fromAcc, nil).Times(1)
+			},
+			expectedErr: errs.ErrAccountFrozen,
+		},
+		{
+			name: "fail to account closed",
+			input: &transferusecase.TransferParams{
+				FromAccountID: 1,
+				ToAccountID:   2,
+				Amount:        10,
 				Currency:      "THB",
 			},
-			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *transferusecase.TransferParams) {
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams) {
 				fromAcc := mocks.MockAccountData()
-				fromAcc.Balance = 20
 				accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(fromAcc, nil).Times(1)
 
 				toAcc := mocks.MockAccountData()
 				toAcc.ID = 2
 				toAcc.OwnerID = 100
+				toAcc.Status = account.StatusClosed
 				accRepo.EXPECT().FindByID(gomock.Any(), input.ToAccountID).Return(toAcc, nil).Times(1)
 			},
-			expectedErr: errs.ErrMoneyNotEnough,
+			expectedErr: errs.ErrAccountClosed,
 		},
 		{
-			name: "fail insert transfer",
+			name: "fail money not enough",
 			input: &transferusecase.TransferParams{
 				FromAccountID: 1,
 				ToAccountID:   2,
 				Amount:        100,
 				Currency:      "THB",
 			},
-			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *transferusecase.TransferParams) {
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams) {
 				fromAcc := mocks.MockAccountData()
+				fromAcc.Balance, fromAcc.AvailableBalance = 20, 20
 				accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(fromAcc, nil).Times(1)
 
 				toAcc := mocks.MockAccountData()
 				toAcc.ID = 2
+				toAcc.OwnerID = 100
 				accRepo.EXPECT().FindByID(gomock.Any(), input.ToAccountID).Return(toAcc, nil).Times(1)
-
-				tranRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, mocks.ErrDatabase).Times(1)
 			},
-			expectedErr: mocks.ErrDatabase,
+			expectedErr: errs.ErrMoneyNotEnough,
 		},
 		{
-			name: "fail insert from account entry",
+			name: "success overdraft covers shortfall",
 			input: &transferusecase.TransferParams{
 				FromAccountID: 1,
 				ToAccountID:   2,
 				Amount:        100,
 				Currency:      "THB",
 			},
-			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *transferusecase.TransferParams) {
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams) {
+				// 20 in the account and 80 of overdraft
 				fromAcc := mocks.MockAccountData()
+				fromAcc.Balance, fromAcc.OverdraftLimit, fromAcc.AvailableBalance = 20, 80, 100
 				accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(fromAcc, nil).Times(1)
 
 				toAcc := mocks.MockAccountData()
 				toAcc.ID = 2
+				toAcc.OwnerID = 100
 				accRepo.EXPECT().FindByID(gomock.Any(), input.ToAccountID).Return(toAcc, nil).Times(1)
 
+				tranRepo.EXPECT().FindLimits(gomock.Any(), fromAcc.ID).Return(mocks.MockLimitsData(), nil).Times(1)
+
 				mockTrans := mocks.MockTransferData(input)
 				tranRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockTrans, nil).Times(1)
 
-				entRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, mocks.ErrDatabase).Times(1)
+				overdrawn := mocks.MockAccountData()
+				overdrawn.ID = input.FromAccountID
+				overdrawn.Balance, overdrawn.OverdraftLimit, overdrawn.OverdraftUsed, overdrawn.AvailableBalance = -80, 80, 80, 0
+				mockPost(ledgerUC, &ledgerusecase.PostParams{
+					Kind:       ledger.KindTransfer,
+					TransferID: &mockTrans.ID,
+					Lines: []ledger.Line{
+						{AccountID: input.FromAccountID, Currency: "THB", Amount: -input.Amount},
+						{AccountID: input.ToAccountID, Currency: "THB", Amount: input.Amount},
+					},
+				}, map[int64]*account.Account{input.FromAccountID: overdrawn})
+
+				tranRepo.EXPECT().SumOutgoing(gomock.Any(), gomock.Any(), input.FromAccountID, gomock.Any()).Return(&transfer.Usage{Amount: input.Amount, Count: 1}, nil).Times(1)
 			},
-			expectedErr: mocks.ErrDatabase,
+			expectedErr: nil,
 		},
 		{
-			name: "fail insert to account entry",
+			// A hold landed between the check and the row lock
+			name: "fail hold reserved funds under lock",
 			input: &transferusecase.TransferParams{
 				FromAccountID: 1,
 				ToAccountID:   2,
 				Amount:        100,
 				Currency:      "THB",
 			},
-			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *transferusecase.TransferParams) {
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams) {
 				fromAcc := mocks.MockAccountData()
 				accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(fromAcc, nil).Times(1)
 
 				toAcc := mocks.MockAccountData()
 				toAcc.ID = 2
+				toAcc.OwnerID = 100
 				accRepo.EXPECT().FindByID(gomock.Any(), input.ToAccountID).Return(toAcc, nil).Times(1)
 
+				tranRepo.EXPECT().FindLimits(gomock.Any(), fromAcc.ID).Return(mocks.MockLimitsData(), nil).Times(1)
+
 				mockTrans := mocks.MockTransferData(input)
 				tranRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockTrans, nil).Times(1)
 
-				mockEnt := &entry.Entry{AccountID: input.FromAccountID, Amount: -input.Amount}
-				entRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockEnt, nil).Times(1)
-
-				entRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, mocks.ErrDatabase).Times(1)
+				locked := mocks.MockAccountData()
+				locked.ID = input.FromAccountID
+				locked.Balance, locked.HeldAmount, locked.AvailableBalance = 900, 950, -50
+				mockPost(ledgerUC, &ledgerusecase.PostParams{
+					Kind:       ledger.KindTransfer,
+					TransferID: &mockTrans.ID,
+					Lines: []ledger.Line{
+						{AccountID: input.FromAccountID, Currency: "THB", Amount: -input.Amount},
+						{AccountID: input.ToAccountID, Currency: "THB", Amount: input.Amount},
+					},
+				}, map[int64]*account.Account{input.FromAccountID: locked})
 			},
-			expectedErr: mocks.ErrDatabase,
+			expectedErr: errs.ErrMoneyNotEnough,
 		},
 		{
-			name: "fail add acc1 balance",
+			// A concurrent debit spent the funds after the check
+			name: "fail balance update passes overdraft limit",
 			input: &transferusecase.TransferParams{
 				FromAccountID: 1,
 				ToAccountID:   2,
 				Amount:        100,
 				Currency:      "THB",
 			},
-			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *transferusecase.TransferParams) {
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams) {
 				fromAcc := mocks.MockAccountData()
 				accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(fromAcc, nil).Times(1)
 
 				toAcc := mocks.MockAccountData()
 				toAcc.ID = 2
+				toAcc.OwnerID = 100
 				accRepo.EXPECT().FindByID(gomock.Any(), input.ToAccountID).Return(toAcc, nil).Times(1)
 
+				tranRepo.EXPECT().FindLimits(gomock.Any(), fromAcc.ID).Return(mocks.MockLimitsData(), nil).Times(1)
+
 				mockTrans := mocks.MockTransferData(input)
 				tranRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockTrans, nil).Times(1)
 
-				mockFromEnt := &entry.Entry{AccountID: input.FromAccountID, Amount: -input.Amount}
-				entRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockFromEnt, nil).Times(1)
+				ledgerUC.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errs.ErrMoneyNotEnough).Times(1)
+			},
+			expectedErr: errs.ErrMoneyNotEnough,
+		},
+		{
+			name: "fail insert transfer",
+			input: &transferusecase.TransferParams{
+				FromAccountID: 1,
+				ToAccountID:   2,
+				Amount:        100,
+				Currency:      "THB",
+			},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams) {
+				fromAcc := mocks.MockAccountData()
+				accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(fromAcc, nil).Times(1)
+
+				toAcc := mocks.MockAccountData()
+				toAcc.ID = 2
+				accRepo.EXPECT().FindByID(gomock.Any(), input.ToAccountID).Return(toAcc, nil).Times(1)
 
-				mockToEnt := &entry.Entry{AccountID: input.FromAccountID, Amount: input.Amount}
-				entRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockToEnt, nil).Times(1)
+				tranRepo.EXPECT().FindLimits(gomock.Any(), fromAcc.ID).Return(mocks.MockLimitsData(), nil).Times(1)
 
-				accRepo.EXPECT().AddAccountBalance(gomock.Any(), gomock.Any(), input.FromAccountID, -input.Amount).Return(nil, mocks.ErrDatabase).Times(1)
+				tranRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, mocks.ErrDatabase).Times(1)
 			},
 			expectedErr: mocks.ErrDatabase,
 		},
 		{
-			name: "fail add acc2 balance",
+			name: "fail post journal",
 			input: &transferusecase.TransferParams{
 				FromAccountID: 1,
 				ToAccountID:   2,
 				Amount:        100,
 				Currency:      "THB",
 			},
-			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, entRepo *entryrepository.MockEntryRepository, input *transferusecase.TransferParams) {
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams) {
 				fromAcc := mocks.MockAccountData()
 				accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(fromAcc, nil).Times(1)
 
@@ -319,30 +502,46 @@ func TestTransfer(t *testing.T) {
 				toAcc.ID = 2
 				accRepo.EXPECT().FindByID(gomock.Any(), input.ToAccountID).Return(toAcc, nil).Times(1)
 
+				tranRepo.EXPECT().FindLimits(gomock.Any(), fromAcc.ID).Return(mocks.MockLimitsData(), nil).Times(1)
+
 				mockTrans := mocks.MockTransferData(input)
 				tranRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockTrans, nil).Times(1)
 
-				mockFromEnt := &entry.Entry{AccountID: input.FromAccountID, Amount: -input.Amount}
-				entRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockFromEnt, nil).Times(1)
+				ledgerUC.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, mocks.ErrDatabase).Times(1)
+			},
+			expectedErr: mocks.ErrDatabase,
+		},
+		{
+			name: "fail fx account not found",
+			input: &transferusecase.TransferParams{
+				FromAccountID: 1,
+				ToAccountID:   2,
+				Amount:        100,
+				Currency:      "USD",
+			},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams) {
+				fromAcc := mocks.MockAccountData()
+				fromAcc.Currency = "USD"
+				accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(fromAcc, nil).Times(1)
 
-				mockToEnt := &entry.Entry{AccountID: input.FromAccountID, Amount: input.Amount}
-				entRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockToEnt, nil).Times(1)
+				toAcc := mocks.MockAccountData()
+				toAcc.ID = 2
+				accRepo.EXPECT().FindByID(gomock.Any(), input.ToAccountID).Return(toAcc, nil).Times(1)
 
-				addFromAcc := mocks.MockAccountData()
-				addFromAcc.Balance += -input.Amount
-				accRepo.EXPECT().AddAccountBalance(gomock.Any(), gomock.Any(), input.FromAccountID, -input.Amount).Return(addFromAcc, nil).Times(1)
+				tranRepo.EXPECT().FindLimits(gomock.Any(), fromAcc.ID).Return(mocks.MockLimitsData(), nil).Times(1)
+				tranRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).Return(mocks.MockTransferData(input), nil).Times(1)
 
-				accRepo.EXPECT().AddAccountBalance(gomock.Any(), gomock.Any(), input.ToAccountID, input.Amount).Return(nil, mocks.ErrDatabase).Times(1)
+				accRepo.EXPECT().FindSystemAccount(gomock.Any(), account.TypeFX, account.AccountCurrency("USD")).Return(nil, errs.ErrSystemAccountNotFound).Times(1)
 			},
-			expectedErr: mocks.ErrDatabase,
+			expectedErr: errs.ErrSystemAccountNotFound,
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			uc, tranRepo, accRepo, entRepo := setup(t)
+			uc, tranRepo, accRepo, ledgerUC := setup(t)
 
-			tc.mockFn(tranRepo, accRepo, entRepo, tc.input)
+			tc.mockFn(tranRepo, accRepo, ledgerUC, tc.input)
 
 			ctx := auth.SetUserID(context.Background(), int64(10))
 
@@ -358,17 +557,884 @@ func TestTransfer(t *testing.T) {
 	}
 }
 
-func setup(t *testing.T) (transferusecase.TransferUsecase, *transferrepository.MockTransferRepository, *accountrepository.MockAccountRepository, *entryrepository.MockEntryRepository) {
-	t.Helper()
+func TestTransferFee(t *testing.T) {
+	type testCase struct {
+		name        string
+		rule        *fee.Rule
+		input       *transferusecase.TransferParams
+		mockFn      func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams)
+		fee         int64
+		expectedErr error
+	}
 
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
+	// 0.01 flat plus 1%
+	rule := &fee.Rule{ID: 1, Operation: fee.OpTransfer, Currency: "THB", Flat: 1, RateBps: 100}
 
-	tranRepo := transferrepository.NewMockTransferRepository(ctrl)
-	accRepo := accountrepository.NewMockAccountRepository(ctrl)
-	entRepo := entryrepository.NewMockEntryRepository(ctrl)
-	mockTx := &mocks.MockTx{}
+	testCases := []testCase{
+		{
+			name: "success fee posted to fees account",
+			rule: rule,
+			input: &transferusecase.TransferParams{
+				FromAccountID: 1,
+				ToAccountID:   2,
+				Amount:        100,
+				Currency:      "THB",
+			},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams) {
+				accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(mocks.MockAccountData(), nil).Times(1)
+
+				toAcc := mocks.MockAccountData()
+				toAcc.OwnerID = 100
+				accRepo.EXPECT().FindByID(gomock.Any(), input.ToAccountID).Return(toAcc, nil).Times(1)
+
+				tranRepo.EXPECT().FindLimits(gomock.Any(), gomock.Any()).Return(mocks.MockLimitsData(), nil).Times(1)
+				tranRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), &transfer.Transfer{
+					FromAccountID: 1,
+					ToAccountID:   2,
+					Amount:        100,
+					ToAmount:      100,
+					ExchangeRate:  fx.Identity,
+					Fee:           2,
+				}).DoAndReturn(func(_ context.Context, _ *sql.Tx, in *transfer.Transfer) (*transfer.Transfer, error) {
+					in.ID = 1
+					return in, nil
+				}).Times(1)
+
+				feeAcc := mocks.MockCashAccountData()
+				feeAcc.ID, feeAcc.Type = 3, account.TypeFees
+				accRepo.EXPECT().FindSystemAccount(gomock.Any(), account.TypeFees, account.AccountCurrency("THB")).Return(feeAcc, nil).Times(1)
+
+				transferID := int64(1)
+				mockPost(ledgerUC, &ledgerusecase.PostParams{
+					Kind:       ledger.KindTransfer,
+					TransferID: &transferID,
+					Lines: []ledger.Line{
+						{AccountID: 1, Currency: "THB", Amount: -100},
+						{AccountID: 2, Currency: "THB", Amount: 100},
+						{AccountID: 1, Currency: "THB", Amount: -2},
+						{AccountID: 3, Currency: "THB", Amount: 2},
+					},
+				}, nil)
+
+				tranRepo.EXPECT().SumOutgoing(gomock.Any(), gomock.Any(), input.FromAccountID, gomock.Any()).Return(&transfer.Usage{Amount: input.Amount, Count: 1}, nil).Times(1)
+			},
+			fee:         2,
+			expectedErr: nil,
+		},
+		{
+			name: "fail balance does not cover fee",
+			rule: rule,
+			input: &transferusecase.TransferParams{
+				FromAccountID: 1,
+				ToAccountID:   2,
+				Amount:        100,
+				Currency:      "THB",
+			},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams) {
+				fromAcc := mocks.MockAccountData()
+				fromAcc.Balance, fromAcc.AvailableBalance = 101, 101
+				accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(fromAcc, nil).Times(1)
+
+				toAcc := mocks.MockAccountData()
+				toAcc.OwnerID = 100
+				accRepo.EXPECT().FindByID(gomock.Any(), input.ToAccountID).Return(toAcc, nil).Times(1)
+			},
+			expectedErr: errs.ErrMoneyNotEnough,
+		},
+		{
+			name: "fail fees account not found",
+			rule: rule,
+			input: &transferusecase.TransferParams{
+				FromAccountID: 1,
+				ToAccountID:   2,
+				Amount:        100,
+				Currency:      "THB",
+			},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams) {
+				accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(mocks.MockAccountData(), nil).Times(1)
+
+				toAcc := mocks.MockAccountData()
+				toAcc.OwnerID = 100
+				accRepo.EXPECT().FindByID(gomock.Any(), input.ToAccountID).Return(toAcc, nil).Times(1)
+
+				tranRepo.EXPECT().FindLimits(gomock.Any(), gomock.Any()).Return(mocks.MockLimitsData(), nil).Times(1)
+				tranRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).Return(mocks.MockTransferData(input), nil).Times(1)
+				accRepo.EXPECT().FindSystemAccount(gomock.Any(), account.TypeFees, gomock.Any()).Return(nil, errs.ErrSystemAccountNotFound).Times(1)
+			},
+			expectedErr: errs.ErrSystemAccountNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, tranRepo, accRepo, ledgerUC := setupWithFee(t, tc.rule)
+
+			tc.mockFn(tranRepo, accRepo, ledgerUC, tc.input)
+
+			ctx := auth.SetUserID(context.Background(), 10)
+
+			result, err := uc.Transfer(ctx, tc.input)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.fee, result.Transfer.Fee)
+				assert.Equal(t, tc.fee, result.Fee.Total)
+				assert.Equal(t, -tc.fee, result.FeeEntry.Amount)
+			}
+		})
+	}
+}
+
+func TestQuote(t *testing.T) {
+	type testCase struct {
+		name        string
+		userID      int64
+		rule        *fee.Rule
+		input       *transferusecase.TransferParams
+		mockFn      func(accRepo *accountrepository.MockAccountRepository, input *transferusecase.TransferParams)
+		expected    *fee.Breakdown
+		totalDebit  int64
+		toAmount    int64
+		expectedErr error
+	}
+
+	mockAccounts := func(accRepo *accountrepository.MockAccountRepository, input *transferusecase.TransferParams) {
+		fromAcc := mocks.MockAccountData()
+		fromAcc.Currency = account.AccountCurrency(input.Currency)
+		accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(fromAcc, nil).Times(1)
+
+		toAcc := mocks.MockAccountData()
+		toAcc.OwnerID = 100
+		accRepo.EXPECT().FindByID(gomock.Any(), input.ToAccountID).Return(toAcc, nil).Times(1)
+	}
+	thb := func(amount int64) *transferusecase.TransferParams {
+		return &transferusecase.TransferParams{FromAccountID: 1, ToAccountID: 2, Amount: amount, Currency: "THB"}
+	}
+
+	testCases := []testCase{
+		{
+			name:        "success flat plus percentage",
+			userID:      10,
+			rule:        &fee.Rule{Currency: "THB", Flat: 1, RateBps: 100},
+			input:       thb(1000),
+			mockFn:      mockAccounts,
+			expected:    &fee.Breakdown{Currency: "THB", Flat: 1, Percentage: 10, Total: 11},
+			totalDebit:  1011,
+			toAmount:    1000,
+			expectedErr: nil,
+		},
+		{
+			name:        "success percentage rounds half up",
+			userID:      10,
+			rule:        &fee.Rule{Currency: "THB", RateBps: 15},
+			input:       thb(1000),
+			mockFn:      mockAccounts,
+			expected:    &fee.Breakdown{Currency: "THB", Percentage: 2, Total: 2},
+			totalDebit:  1002,
+			toAmount:    1000,
+			expectedErr: nil,
+		},
+		{
+			name:        "success percentage rounds down below half",
+			userID:      10,
+			rule:        &fee.Rule{Currency: "THB", RateBps: 14},
+			input:       thb(1000),
+			mockFn:      mockAccounts,
+			expected:    &fee.Breakdown{Currency: "THB", Percentage: 1, Total: 1},
+			totalDebit:  1001,
+			toAmount:    1000,
+			expectedErr: nil,
+		},
+		{
+			name:        "success raised to minimum",
+			userID:      10,
+			rule:        &fee.Rule{Currency: "THB", RateBps: 10, MinFee: 5},
+			input:       thb(1000),
+			mockFn:      mockAccounts,
+			expected:    &fee.Breakdown{Currency: "THB", Percentage: 1, Adjustment: 4, Total: 5},
+			totalDebit:  1005,
+			toAmount:    1000,
+			expectedErr: nil,
+		},
+		{
+			name:        "success capped at maximum",
+			userID:      10,
+			rule:        &fee.Rule{Currency: "THB", RateBps: 100, MaxFee: 5},
+			input:       thb(1000),
+			mockFn:      mockAccounts,
+			expected:    &fee.Breakdown{Currency: "THB", Percentage: 10, Adjustment: -5, Total: 5},
+			totalDebit:  1005,
+			toAmount:    1000,
+			expectedErr: nil,
+		},
+		{
+			name:   "success cross currency fee in source currency",
+			userID: 10,
+			rule:   &fee.Rule{Currency: "USD", RateBps: 50},
+			input: &transferusecase.TransferParams{
+				FromAccountID: 1,
+				ToAccountID:   2,
+				Amount:        1000,
+				Currency:      "USD",
+			},
+			mockFn:      mockAccounts,
+			expected:    &fee.Breakdown{Currency: "USD", Percentage: 5, Total: 5},
+			totalDebit:  1005,
+			toAmount:    36500,
+			expectedErr: nil,
+		},
+		{
+			name:        "fail no user id",
+			userID:      0,
+			rule:        &fee.Rule{},
+			input:       thb(1000),
+			mockFn:      func(accRepo *accountrepository.MockAccountRepository, input *transferusecase.TransferParams) {},
+			expectedErr: errs.ErrNoUserID,
+		},
+		{
+			name:   "fail not owner",
+			userID: 99,
+			rule:   &fee.Rule{},
+			input:  thb(1000),
+			mockFn: func(accRepo *accountrepository.MockAccountRepository, input *transferusecase.TransferParams) {
+				accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(mocks.MockAccountData(), nil).Times(1)
+			},
+			expectedErr: errs.ErrAccountNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, _, accRepo, _ := setupWithFee(t, tc.rule)
+
+			tc.mockFn(accRepo, tc.input)
+
+			ctx := auth.SetUserID(context.Background(), tc.userID)
+
+			result, err := uc.Quote(ctx, tc.input)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, result.Fee)
+				assert.Equal(t, tc.totalDebit, result.TotalDebit)
+				assert.Equal(t, tc.toAmount, result.ToAmount)
+			}
+		})
+	}
+}
+
+func TestTransferLimits(t *testing.T) {
+	type testCase struct {
+		name              string
+		input             *transferusecase.TransferParams
+		used              *transfer.Usage
+		expectedLimit     string
+		expectedRemaining string
+	}
+
+	testCases := []testCase{
+		{
+			name:              "fail max amount",
+			input:             &transferusecase.TransferParams{FromAccountID: 1, ToAccountID: 2, Amount: 1500, Currency: "THB"},
+			expectedLimit:     transfer.LimitMaxAmount,
+			expectedRemaining: "1000",
+		},
+		{
+			name:              "fail daily amount",
+			input:             &transferusecase.TransferParams{FromAccountID: 1, ToAccountID: 2, Amount: 800, Currency: "THB"},
+			used:              &transfer.Usage{Amount: 5500, Count: 3},
+			expectedLimit:     transfer.LimitDailyAmount,
+			expectedRemaining: "300",
+		},
+		{
+			name:              "fail daily count",
+			input:             &transferusecase.TransferParams{FromAccountID: 1, ToAccountID: 2, Amount: 100, Currency: "THB"},
+			used:              &transfer.Usage{Amount: 600, Count: 6},
+			expectedLimit:     transfer.LimitDailyCount,
+			expectedRemaining: "0",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, tranRepo, accRepo, ledgerUC := setup(t)
+
+			fromAcc := mocks.MockAccountData()
+			fromAcc.Balance, fromAcc.AvailableBalance = 10000, 10000
+			accRepo.EXPECT().FindByID(gomock.Any(), tc.input.FromAccountID).Return(fromAcc, nil).Times(1)
+
+			toAcc := mocks.MockAccountData()
+			toAcc.OwnerID = 100
+			accRepo.EXPECT().FindByID(gomock.Any(), tc.input.ToAccountID).Return(toAcc, nil).Times(1)
+
+			tranRepo.EXPECT().FindLimits(gomock.Any(), fromAcc.ID).Return(mocks.MockLimitsData(), nil).Times(1)
+
+			// Rolling totals are checked inside the transaction, after the locks
+			if tc.used != nil {
+				tranRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).Return(mocks.MockTransferData(tc.input), nil).Times(1)
+				ledgerUC.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ *sql.Tx, in *ledgerusecase.PostParams) (*ledgerusecase.PostResult, error) {
+					return mocks.MockPostResult(in, map[int64]*account.Account{1: mocks.MockAccountData(), 2: mocks.MockAccountData()}), nil
+				}).Times(1)
+				tranRepo.EXPECT().SumOutgoing(gomock.Any(), gomock.Any(), tc.input.FromAccountID, gomock.Any()).Return(tc.used, nil).Times(1)
+			}
+
+			ctx := auth.SetUserID(context.Background(), int64(10))
+
+			_, err := uc.Transfer(ctx, tc.input)
+
+			assert.ErrorIs(t, err, errs.ErrTransferLimitExceeded)
+			appErr := errs.From(err)
+			assert.Equal(t, tc.expectedLimit, appErr.Metadata["limit"])
+			assert.Equal(t, tc.expectedRemaining, appErr.Metadata["remaining"])
+		})
+	}
+}
+
+func TestTransferIdempotency(t *testing.T) {
+	type testCase struct {
+		name        string
+		first       *transferusecase.TransferParams
+		retry       *transferusecase.TransferParams
+		mockFn      func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams)
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name: "success replay same payload",
+			first: &transferusecase.TransferParams{
+				FromAccountID:  1,
+				ToAccountID:    2,
+				Amount:         10,
+				Currency:       "THB",
+				IdempotencyKey: "key-001",
+			},
+			retry: &transferusecase.TransferParams{
+				FromAccountID:  1,
+				ToAccountID:    2,
+				Amount:         10,
+				Currency:       "THB",
+				IdempotencyKey: "key-001",
+			},
+			mockFn:      mockIdempotentTransfer,
+			expectedErr: nil,
+		},
+		{
+			name: "fail replay different payload",
+			first: &transferusecase.TransferParams{
+				FromAccountID:  1,
+				ToAccountID:    2,
+				Amount:         10,
+				Currency:       "THB",
+				IdempotencyKey: "key-001",
+			},
+			retry: &transferusecase.TransferParams{
+				FromAccountID:  1,
+				ToAccountID:    2,
+				Amount:         20,
+				Currency:       "THB",
+				IdempotencyKey: "key-001",
+			},
+			mockFn:      mockIdempotentTransfer,
+			expectedErr: errs.ErrIdempotencyKeyConflict,
+		},
+		{
+			name: "fail key too long",
+			retry: &transferusecase.TransferParams{
+				FromAccountID:  1,
+				ToAccountID:    2,
+				Amount:         10,
+				Currency:       "THB",
+				IdempotencyKey: strings.Repeat("k", 256),
+			},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams) {
+			},
+			expectedErr: errs.ErrInvalidIdempotencyKey,
+		},
+		{
+			name: "fail find key",
+			retry: &transferusecase.TransferParams{
+				FromAccountID:  1,
+				ToAccountID:    2,
+				Amount:         10,
+				Currency:       "THB",
+				IdempotencyKey: "key-001",
+			},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams) {
+				tranRepo.EXPECT().FindIdempotencyKey(gomock.Any(), int64(10), input.IdempotencyKey).Return(nil, mocks.ErrDatabase).Times(1)
+			},
+			expectedErr: mocks.ErrDatabase,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, tranRepo, accRepo, ledgerUC := setup(t)
+
+			input := tc.first
+			if input == nil {
+				input = tc.retry
+			}
+			tc.mockFn(tranRepo, accRepo, ledgerUC, input)
+
+			ctx := auth.SetUserID(context.Background(), int64(10))
+
+			var firstResult *transferusecase.TransferResult
+			if tc.first != nil {
+				var err error
+				firstResult, err = uc.Transfer(ctx, tc.first)
+				assert.NoError(t, err)
+			}
+
+			result, err := uc.Transfer(ctx, tc.retry)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, firstResult.Transfer.ID, result.Transfer.ID)
+				assert.Equal(t, firstResult.FromAccount.Balance, result.FromAccount.Balance)
+			}
+		})
+	}
+}
+
+func TestReverseTransfer(t *testing.T) {
+	// Account 1 (owner 100) sent to account 2 (owner 10, the caller)
+	original := func() *transfer.Transfer {
+		return &transfer.Transfer{ID: 1, FromAccountID: 1, ToAccountID: 2, Amount: 100, ToAmount: 100, ExchangeRate: 1_000_000}
+	}
+	accounts := func(accRepo *accountrepository.MockAccountRepository) (payer, payee *account.Account) {
+		payer = mocks.MockAccountData()
+		payer.ID = 2
+		payee = mocks.MockAccountData()
+		payee.ID, payee.OwnerID = 1, 100
+		accRepo.EXPECT().FindByID(gomock.Any(), int64(2)).Return(payer, nil).Times(1)
+		accRepo.EXPECT().FindByID(gomock.Any(), int64(1)).Return(payee, nil).Times(1)
+		return payer, payee
+	}
+
+	type testCase struct {
+		name        string
+		role        user.Role
+		input       *transferusecase.ReverseParams
+		mockFn      func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase)
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:  "success full amount",
+			role:  user.RoleCustomer,
+			input: &transferusecase.ReverseParams{TransferID: 1},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase) {
+				tranRepo.EXPECT().FindByID(gomock.Any(), int64(1)).Return(original(), nil).Times(1)
+				accounts(accRepo)
+				mockReversal(tranRepo, ledgerUC,
+					ledger.Line{AccountID: 2, Currency: "THB", Amount: -100},
+					ledger.Line{AccountID: 1, Currency: "THB", Amount: 100},
+				)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:  "success partial at original rate",
+			role:  user.RoleCustomer,
+			input: &transferusecase.ReverseParams{TransferID: 1, Amount: 1825},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase) {
+				// 1.00 USD became 36.50 THB; half of it refunds 0.50 USD
+				orig := original()
+				orig.ToAmount, orig.ExchangeRate = 3650, 36_500_000
+				tranRepo.EXPECT().FindByID(gomock.Any(), int64(1)).Return(orig, nil).Times(1)
+				payer, payee := accounts(accRepo)
+				payer.Balance, payer.AvailableBalance = 5000, 5000
+				payee.Currency = "USD"
+				mockFXAccount(accRepo, "THB", 21)
+				mockFXAccount(accRepo, "USD", 20)
+				mockReversal(tranRepo, ledgerUC,
+					ledger.Line{AccountID: 2, Currency: "THB", Amount: -1825},
+					ledger.Line{AccountID: 21, Currency: "THB", Amount: 1825},
+					ledger.Line{AccountID: 20, Currency: "USD", Amount: -50},
+					ledger.Line{AccountID: 1, Currency: "USD", Amount: 50},
+				)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:  "success admin from frozen account",
+			role:  user.RoleAdmin,
+			input: &transferusecase.ReverseParams{TransferID: 1},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase) {
+				tranRepo.EXPECT().FindByID(gomock.Any(), int64(1)).Return(original(), nil).Times(1)
+				payer, _ := accounts(accRepo)
+				payer.OwnerID, payer.Status = 200, account.StatusFrozen
+				mockReversal(tranRepo, ledgerUC,
+					ledger.Line{AccountID: 2, Currency: "THB", Amount: -100},
+					ledger.Line{AccountID: 1, Currency: "THB", Amount: 100},
+				)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:  "fail already reversed",
+			role:  user.RoleCustomer,
+			input: &transferusecase.ReverseParams{TransferID: 1},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase) {
+				orig := original()
+				reversedBy := int64(5)
+				orig.ReversedBy = &reversedBy
+				tranRepo.EXPECT().FindByID(gomock.Any(), int64(1)).Return(orig, nil).Times(1)
+			},
+			expectedErr: errs.ErrTransferAlreadyReversed,
+		},
+		{
+			name:  "fail reversal of a reversal",
+			role:  user.RoleCustomer,
+			input: &transferusecase.ReverseParams{TransferID: 1},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase) {
+				orig := original()
+				reversalOf := int64(5)
+				orig.ReversalOf = &reversalOf
+				tranRepo.EXPECT().FindByID(gomock.Any(), int64(1)).Return(orig, nil).Times(1)
+			},
+			expectedErr: errs.ErrTransferNotReversible,
+		},
+		{
+			name:  "fail sender can't reverse",
+			role:  user.RoleCustomer,
+			input: &transferusecase.ReverseParams{TransferID: 1},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase) {
+				tranRepo.EXPECT().FindByID(gomock.Any(), int64(1)).Return(original(), nil).Times(1)
+				payer, payee := accounts(accRepo)
+				payer.OwnerID, payee.OwnerID = 100, 10
+			},
+			expectedErr: errs.ErrNoPermission,
+		},
+		{
+			name:  "fail amount above received",
+			role:  user.RoleCustomer,
+			input: &transferusecase.ReverseParams{TransferID: 1, Amount: 101},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase) {
+				tranRepo.EXPECT().FindByID(gomock.Any(), int64(1)).Return(original(), nil).Times(1)
+				accounts(accRepo)
+			},
+			expectedErr: errs.ErrInvalidReversalAmount,
+		},
+		{
+			name:  "fail money not enough",
+			role:  user.RoleCustomer,
+			input: &transferusecase.ReverseParams{TransferID: 1},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase) {
+				tranRepo.EXPECT().FindByID(gomock.Any(), int64(1)).Return(original(), nil).Times(1)
+				payer, _ := accounts(accRepo)
+				payer.Balance, payer.AvailableBalance = 50, 50
+			},
+			expectedErr: errs.ErrMoneyNotEnough,
+		},
+		{
+			name:  "fail recipient frozen",
+			role:  user.RoleCustomer,
+			input: &transferusecase.ReverseParams{TransferID: 1},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase) {
+				tranRepo.EXPECT().FindByID(gomock.Any(), int64(1)).Return(original(), nil).Times(1)
+				payer, _ := accounts(accRepo)
+				payer.Status = account.StatusFrozen
+			},
+			expectedErr: errs.ErrAccountFrozen,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, tranRepo, accRepo, ledgerUC := setup(t)
+
+			tc.mockFn(tranRepo, accRepo, ledgerUC)
+
+			ctx := auth.SetUserID(context.Background(), int64(10))
+			ctx = auth.SetRole(ctx, tc.role)
+			result, err := uc.Reverse(ctx, tc.input)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(1), *result.Transfer.ReversalOf)
+				assert.Equal(t, int64(2), result.Transfer.FromAccountID)
+				assert.Equal(t, int64(1), result.Transfer.ToAccountID)
+			}
+		})
+	}
+}
+
+func TestGetTransfer(t *testing.T) {
+	type testCase struct {
+		name        string
+		transferID  int64
+		mockFn      func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, transferID int64)
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:       "success sender",
+			transferID: 1,
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, transferID int64) {
+				tranRepo.EXPECT().FindByID(gomock.Any(), transferID).Return(&transfer.Transfer{ID: transferID, FromAccountID: 1, ToAccountID: 2}, nil).Times(1)
+
+				accRepo.EXPECT().FindByID(gomock.Any(), int64(1)).Return(mocks.MockAccountData(), nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:       "success recipient",
+			transferID: 1,
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, transferID int64) {
+				tranRepo.EXPECT().FindByID(gomock.Any(), transferID).Return(&transfer.Transfer{ID: transferID, FromAccountID: 1, ToAccountID: 2}, nil).Times(1)
+
+				other := mocks.MockAccountData()
+				other.OwnerID = 100
+				accRepo.EXPECT().FindByID(gomock.Any(), int64(1)).Return(other, nil).Times(1)
+				accRepo.EXPECT().FindByID(gomock.Any(), int64(2)).Return(mocks.MockAccountData(), nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:       "fail not owner",
+			transferID: 1,
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, transferID int64) {
+				tranRepo.EXPECT().FindByID(gomock.Any(), transferID).Return(&transfer.Transfer{ID: transferID, FromAccountID: 1, ToAccountID: 2}, nil).Times(1)
+
+				other := mocks.MockAccountData()
+				other.OwnerID = 100
+				accRepo.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(other, nil).Times(2)
+			},
+			expectedErr: errs.ErrTransferNotFound,
+		},
+		{
+			name:       "fail not found",
+			transferID: 1,
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, transferID int64) {
+				tranRepo.EXPECT().FindByID(gomock.Any(), transferID).Return(nil, errs.ErrTransferNotFound).Times(1)
+			},
+			expectedErr: errs.ErrTransferNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, tranRepo, accRepo, _ := setup(t)
+
+			tc.mockFn(tranRepo, accRepo, tc.transferID)
+
+			ctx := auth.SetUserID(context.Background(), int64(10))
+
+			result, err := uc.GetTransfer(ctx, tc.transferID)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, result)
+			}
+		})
+	}
+}
+
+func TestListTransfers(t *testing.T) {
+	type testCase struct {
+		name        string
+		input       *transferusecase.ListTransfersParams
+		mockFn      func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, input *transferusecase.ListTransfersParams)
+		expectedErr error
+	}
+
+	now := time.Now()
+	yesterday := now.Add(-24 * time.Hour)
+
+	testCases := []testCase{
+		{
+			name:  "success all accounts",
+			input: &transferusecase.ListTransfersParams{Direction: consts.DirectionOutgoing, Page: &pagination.Page{Limit: 10, Offset: 10}},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, input *transferusecase.ListTransfersParams) {
+				filter := &transfer.Filter{OwnerID: 10, Direction: consts.DirectionOutgoing, Page: input.Page}
+				tranRepo.EXPECT().List(gomock.Any(), filter).Return([]*transfer.Transfer{{ID: 1}}, nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:  "success one account",
+			input: &transferusecase.ListTransfersParams{AccountID: 10, From: &yesterday, To: &now, Page: &pagination.Page{Limit: 5}},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, input *transferusecase.ListTransfersParams) {
+				accRepo.EXPECT().FindByID(gomock.Any(), input.AccountID).Return(mocks.MockAccountData(), nil).Times(1)
+
+				tranRepo.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*transfer.Transfer{}, nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:  "fail not owner",
+			input: &transferusecase.ListTransfersParams{AccountID: 10, Page: &pagination.Page{Limit: 5}},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, input *transferusecase.ListTransfersParams) {
+				a := mocks.MockAccountData()
+				a.OwnerID = 100
+				accRepo.EXPECT().FindByID(gomock.Any(), input.AccountID).Return(a, nil).Times(1)
+			},
+			expectedErr: errs.ErrAccountNotFound,
+		},
+		{
+			name:  "fail invalid time range",
+			input: &transferusecase.ListTransfersParams{From: &now, To: &yesterday},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, input *transferusecase.ListTransfersParams) {
+			},
+			expectedErr: errs.ErrInvalidTimeRange,
+		},
+		{
+			name:  "fail invalid amount range",
+			input: &transferusecase.ListTransfersParams{MinAmount: 100, MaxAmount: 10},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, input *transferusecase.ListTransfersParams) {
+			},
+			expectedErr: errs.ErrInvalidAmountRange,
+		},
+		{
+			name:  "fail db error",
+			input: &transferusecase.ListTransfersParams{Page: &pagination.Page{Limit: 5}},
+			mockFn: func(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, input *transferusecase.ListTransfersParams) {
+				tranRepo.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, mocks.ErrDatabase).Times(1)
+			},
+			expectedErr: mocks.ErrDatabase,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, tranRepo, accRepo, _ := setup(t)
+
+			tc.mockFn(tranRepo, accRepo, tc.input)
+
+			ctx := auth.SetUserID(context.Background(), int64(10))
+
+			result, _, err := uc.ListTransfers(ctx, tc.input)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, result)
+			}
+		})
+	}
+}
+
+// mockIdempotentTransfer expects one full transfer that stores its key, then
+// serves the stored key to the retry.
+func mockIdempotentTransfer(tranRepo *transferrepository.MockTransferRepository, accRepo *accountrepository.MockAccountRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, input *transferusecase.TransferParams) {
+	var stored *transfer.IdempotencyKey
+
+	gomock.InOrder(
+		tranRepo.EXPECT().FindIdempotencyKey(gomock.Any(), int64(10), input.IdempotencyKey).Return(nil, errs.ErrIdempotencyKeyNotFound).Times(1),
+		tranRepo.EXPECT().FindIdempotencyKey(gomock.Any(), int64(10), input.IdempotencyKey).DoAndReturn(
+			func(_ context.Context, _ int64, _ string) (*transfer.IdempotencyKey, error) {
+				return stored, nil
+			},
+		).Times(1),
+	)
+
+	fromAcc := mocks.MockAccountData()
+	accRepo.EXPECT().FindByID(gomock.Any(), input.FromAccountID).Return(fromAcc, nil).Times(1)
+
+	toAcc := mocks.MockAccountData()
+	toAcc.OwnerID = 100
+	accRepo.EXPECT().FindByID(gomock.Any(), input.ToAccountID).Return(toAcc, nil).Times(1)
+
+	tranRepo.EXPECT().FindLimits(gomock.Any(), fromAcc.ID).Return(mocks.MockLimitsData(), nil).Times(1)
+
+	mockTrans := mocks.MockTransferData(input)
+	tranRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockTrans, nil).Times(1)
+
+	mockPost(ledgerUC, &ledgerusecase.PostParams{
+		Kind:       ledger.KindTransfer,
+		TransferID: &mockTrans.ID,
+		Lines: []ledger.Line{
+			{AccountID: input.FromAccountID, Currency: "THB", Amount: -input.Amount},
+			{AccountID: input.ToAccountID, Currency: "THB", Amount: input.Amount},
+		},
+	}, nil)
+
+	tranRepo.EXPECT().SumOutgoing(gomock.Any(), gomock.Any(), input.FromAccountID, gomock.Any()).Return(&transfer.Usage{Amount: input.Amount, Count: 1}, nil).Times(1)
+
+	tranRepo.EXPECT().InsertIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *sql.Tx, key *transfer.IdempotencyKey) error {
+			stored = key
+			return nil
+		},
+	).Times(1)
+}
+
+// mockReversal expects account 2 to post lines back to account 1.
+func mockReversal(tranRepo *transferrepository.MockTransferRepository, ledgerUC *ledgerusecase.MockLedgerUsecase, lines ...ledger.Line) {
+	tranRepo.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ *sql.Tx, in *transfer.Transfer) (*transfer.Transfer, error) {
+		in.ID = 9
+		return in, nil
+	}).Times(1)
+
+	transferID := int64(9)
+	mockPost(ledgerUC, &ledgerusecase.PostParams{
+		Kind:       ledger.KindReversal,
+		TransferID: &transferID,
+		Lines:      lines,
+	}, nil)
+}
+
+// mockPost expects input and posts it. Every account gets a fresh balance
+// unless accounts has one.
+func mockPost(ledgerUC *ledgerusecase.MockLedgerUsecase, input *ledgerusecase.PostParams, accounts map[int64]*account.Account) {
+	posted := make(map[int64]*account.Account)
+	for _, l := range input.Lines {
+		acc, ok := accounts[l.AccountID]
+		if !ok {
+			acc = mocks.MockAccountData()
+			acc.ID = l.AccountID
+		}
+		posted[l.AccountID] = acc
+	}
+	ledgerUC.EXPECT().Post(gomock.Any(), gomock.Any(), input).Return(mocks.MockPostResult(input, posted), nil).Times(1)
+}
+
+func mockFXAccount(accRepo *accountrepository.MockAccountRepository, currency string, id int64) {
+	fxAcc := mocks.MockCashAccountData()
+	fxAcc.ID, fxAcc.Type, fxAcc.Currency = id, account.TypeFX, account.AccountCurrency(currency)
+	accRepo.EXPECT().FindSystemAccount(gomock.Any(), account.TypeFX, fxAcc.Currency).Return(fxAcc, nil).Times(1)
+}
+
+func setup(t *testing.T) (transferusecase.TransferUsecase, *transferrepository.MockTransferRepository, *accountrepository.MockAccountRepository, *ledgerusecase.MockLedgerUsecase) {
+	t.Helper()
+	return setupWithFee(t, &fee.Rule{})
+}
+
+// setupWithFee charges every transfer by rule.
+func setupWithFee(t *testing.T, rule *fee.Rule) (transferusecase.TransferUsecase, *transferrepository.MockTransferRepository, *accountrepository.MockAccountRepository, *ledgerusecase.MockLedgerUsecase) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tranRepo := transferrepository.NewMockTransferRepository(ctrl)
+	accRepo := accountrepository.NewMockAccountRepository(ctrl)
+	feeRepo := feerepository.NewMockFeeRepository(ctrl)
+	ledgerUC := ledgerusecase.NewMockLedgerUsecase(ctrl)
+	mockTx := &mocks.MockTx{}
+
+	feeRepo.EXPECT().FindRule(gomock.Any(), fee.OpTransfer, gomock.Any(), gomock.Any()).Return(rule, nil).AnyTimes()
+
+	fxProvider, err := fx.NewStaticProvider(map[string]string{"USD/THB": "36.5", "USD/JPY": "150"})
+	if err != nil {
+		t.Fatal(err)
+	}
 
-	uc := transferusecase.NewTransferUsecase(tranRepo, accRepo, entRepo, mockTx)
-	return uc, tranRepo, accRepo, entRepo
+	uc := transferusecase.NewTransferUsecase(tranRepo, accRepo, feeRepo, ledgerUC, mockTx, fxProvider, mocks.MockCurrencies(), time.Hour)
+	return uc, tranRepo, accRepo, ledgerUC
 }
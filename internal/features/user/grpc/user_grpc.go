@@ -0,0 +1,111 @@
+package usergrpc
+
+import (
+	"context"
+
+	"github.com/codepnw/simple-bank/internal/features/user"
+	userusecase "github.com/codepnw/simple-bank/internal/features/user/usecase"
+	pb "github.com/codepnw/simple-bank/pb/proto"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/codepnw/simple-bank/pkg/utils/helper"
+)
+
+type UserServer struct {
+	pb.UnimplementedSimpleBankServer
+	uc userusecase.UserUsecase
+}
+
+func NewUserServer(uc userusecase.UserUsecase) *UserServer {
+	return &UserServer{uc: uc}
+}
+
+// registerInput mirrors the REST RegisterReq rules.
+type registerInput struct {
+	Username  string `validate:"required,min=4"`
+	Password  string `validate:"required,min=6"`
+	FirstName string `validate:"required"`
+	LastName  string `validate:"required"`
+	Email     string `validate:"required,email"`
+}
+
+type loginInput struct {
+	Email    string `validate:"required,email"`
+	Password string `validate:"required,min=6"`
+}
+
+type refreshTokenInput struct {
+	RefreshToken string `validate:"required"`
+}
+
+func (s *UserServer) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.TokenResponse, error) {
+	input := &registerInput{
+		Username:  req.GetUsername(),
+		Password:  req.GetPassword(),
+		FirstName: req.GetFirstName(),
+		LastName:  req.GetLastName(),
+		Email:     req.GetEmail(),
+	}
+	if err := helper.Validate(input); err != nil {
+		return nil, errs.InvalidInput(err)
+	}
+
+	data, err := s.uc.Register(ctx, &user.User{
+		Username:  input.Username,
+		Password:  input.Password,
+		FirstName: input.FirstName,
+		LastName:  input.LastName,
+		Email:     input.Email,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toPbToken(data), nil
+}
+
+func (s *UserServer) Login(ctx context.Context, req *pb.LoginRequest) (*pb.TokenResponse, error) {
+	input := &loginInput{
+		Email:    req.GetEmail(),
+		Password: req.GetPassword(),
+	}
+	if err := helper.Validate(input); err != nil {
+		return nil, errs.InvalidInput(err)
+	}
+
+	data, err := s.uc.Login(ctx, input.Email, input.Password)
+	if err != nil {
+		return nil, err
+	}
+	return toPbToken(data), nil
+}
+
+func (s *UserServer) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.TokenResponse, error) {
+	input := &refreshTokenInput{RefreshToken: req.GetRefreshToken()}
+	if err := helper.Validate(input); err != nil {
+		return nil, errs.InvalidInput(err)
+	}
+
+	data, err := s.uc.RefreshToken(ctx, input.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	return toPbToken(data), nil
+}
+
+func (s *UserServer) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	input := &refreshTokenInput{RefreshToken: req.GetRefreshToken()}
+	if err := helper.Validate(input); err != nil {
+		return nil, errs.InvalidInput(err)
+	}
+
+	if err := s.uc.Logout(ctx, input.RefreshToken); err != nil {
+		return nil, err
+	}
+	return &pb.LogoutResponse{}, nil
+}
+
+func toPbToken(data *userusecase.TokenResponse) *pb.TokenResponse {
+	return &pb.TokenResponse{
+		AccessToken:  data.AccessToken,
+		RefreshToken: data.RefreshToken,
+	}
+}
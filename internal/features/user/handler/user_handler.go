@@ -1,11 +1,11 @@
 package userhandler
 
 import (
-	"errors"
-
+	"github.com/codepnw/simple-bank/internal/consts"
 	"github.com/codepnw/simple-bank/internal/features/user"
 	userusecase "github.com/codepnw/simple-bank/internal/features/user/usecase"
 	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/codepnw/simple-bank/pkg/utils/helper"
 	"github.com/codepnw/simple-bank/pkg/utils/response"
 	"github.com/gin-gonic/gin"
 )
@@ -31,7 +31,7 @@ func NewUserHandler(uc userusecase.UserUsecase) *userHandler {
 func (h *userHandler) Register(c *gin.Context) {
 	req := new(RegisterReq)
 	if err := c.ShouldBindJSON(req); err != nil {
-		response.BadRequest(c, err.Error())
+		response.Error(c, errs.InvalidInput(err))
 		return
 	}
 
@@ -44,17 +44,8 @@ func (h *userHandler) Register(c *gin.Context) {
 	}
 	data, err := h.uc.Register(c.Request.Context(), input)
 	if err != nil {
-		switch err {
-		case errs.ErrEmailAlreadyExists:
-			response.BadRequest(c, err.Error())
-			return
-		case errs.ErrUsernameAlreadyExists:
-			response.BadRequest(c, err.Error())
-			return
-		default:
-			response.InternalServerError(c, err)
-			return
-		}
+		response.Error(c, err)
+		return
 	}
 	response.Created(c, "", data)
 }
@@ -72,17 +63,13 @@ func (h *userHandler) Register(c *gin.Context) {
 func (h *userHandler) Login(c *gin.Context) {
 	req := new(LoginReq)
 	if err := c.ShouldBindJSON(req); err != nil {
-		response.BadRequest(c, err.Error())
+		response.Error(c, errs.InvalidInput(err))
 		return
 	}
 
 	data, err := h.uc.Login(c.Request.Context(), req.Email, req.Password)
 	if err != nil {
-		if errors.Is(err, errs.ErrInvalidCredentials) {
-			response.BadRequest(c, err.Error())
-			return
-		}
-		response.InternalServerError(c, err)
+		response.Error(c, err)
 		return
 	}
 	response.Success(c, "", data)
@@ -103,26 +90,14 @@ func (h *userHandler) Login(c *gin.Context) {
 func (h *userHandler) RefreshToken(c *gin.Context) {
 	req := new(RefreshTokenReq)
 	if err := c.ShouldBindJSON(req); err != nil {
-		response.BadRequest(c, err.Error())
+		response.Error(c, errs.InvalidInput(err))
 		return
 	}
 
 	data, err := h.uc.RefreshToken(c.Request.Context(), req.RefreshToken)
 	if err != nil {
-		switch err {
-		case errs.ErrTokenNotFound:
-			response.NotFound(c, err.Error())
-			return
-		case errs.ErrTokenRevoked:
-			response.BadRequest(c, err.Error())
-			return
-		case errs.ErrTokenExpires:
-			response.BadRequest(c, err.Error())
-			return
-		default:
-			response.InternalServerError(c, err)
-			return
-		}
+		response.Error(c, err)
+		return
 	}
 	response.Success(c, "", data)
 }
@@ -142,17 +117,74 @@ func (h *userHandler) RefreshToken(c *gin.Context) {
 func (h *userHandler) Logout(c *gin.Context) {
 	req := new(RefreshTokenReq)
 	if err := c.ShouldBindJSON(req); err != nil {
-		response.BadRequest(c, err.Error())
+		response.Error(c, errs.InvalidInput(err))
 		return
 	}
 
 	err := h.uc.Logout(c.Request.Context(), req.RefreshToken)
 	if err != nil {
-		if errors.Is(err, errs.ErrTokenNotFound) {
-			response.NotFound(c, err.Error())
-			return
-		}
-		response.InternalServerError(c, err)
+		response.Error(c, err)
+		return
+	}
+	response.NoContent(c)
+}
+
+// @Summary List Sessions
+// @Description list the devices signed in to the current user
+// @Tags users
+// @Produce      json
+// @Success 200 {object} []user.Session "List Sessions Successfully"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /users/sessions [get]
+func (h *userHandler) ListSessions(c *gin.Context) {
+	data, err := h.uc.ListSessions(c.Request.Context())
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.Success(c, "", data)
+}
+
+// @Summary Revoke Session
+// @Description sign out one device
+// @Tags users
+// @Produce      json
+// @Param session_id path int true "Session ID"
+// @Success 204 {object} response.NoContentResponse "successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid Input"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Session Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /users/sessions/{session_id} [delete]
+func (h *userHandler) RevokeSession(c *gin.Context) {
+	id, err := helper.ParseInt64(c.Param(consts.ParamSessionID))
+	if err != nil {
+		response.Error(c, errs.ErrInvalidID.Wrap(err))
+		return
+	}
+
+	if err = h.uc.RevokeSession(c.Request.Context(), id); err != nil {
+		response.Error(c, err)
+		return
+	}
+	response.NoContent(c)
+}
+
+// @Summary Logout All
+// @Description sign out every device, including this one
+// @Tags users
+// @Produce      json
+// @Success 204 {object} response.NoContentResponse "successfully"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Security     BearerAuth
+// @Router /users/sessions [delete]
+func (h *userHandler) LogoutAll(c *gin.Context) {
+	if err := h.uc.LogoutAll(c.Request.Context()); err != nil {
+		response.Error(c, err)
 		return
 	}
 	response.NoContent(c)
@@ -66,6 +66,21 @@ func (mr *MockUserRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockUserRepository)(nil).FindByID), ctx, id)
 }
 
+// FindRotatedToken mocks base method.
+func (m *MockUserRepository) FindRotatedToken(ctx context.Context, token string) (*user.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRotatedToken", ctx, token)
+	ret0, _ := ret[0].(*user.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRotatedToken indicates an expected call of FindRotatedToken.
+func (mr *MockUserRepositoryMockRecorder) FindRotatedToken(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRotatedToken", reflect.TypeOf((*MockUserRepository)(nil).FindRotatedToken), ctx, token)
+}
+
 // Insert mocks base method.
 func (m *MockUserRepository) Insert(ctx context.Context, db database.DBExec, input *user.User) (*user.User, error) {
 	m.ctrl.T.Helper()
@@ -81,22 +96,96 @@ func (mr *MockUserRepositoryMockRecorder) Insert(ctx, db, input interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockUserRepository)(nil).Insert), ctx, db, input)
 }
 
-// RevokedRefreshToken mocks base method.
-func (m *MockUserRepository) RevokedRefreshToken(ctx context.Context, db database.DBExec, token string) error {
+// InsertSecurityEvent mocks base method.
+func (m *MockUserRepository) InsertSecurityEvent(ctx context.Context, db database.DBExec, input *user.SecurityEvent) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RevokedRefreshToken", ctx, db, token)
+	ret := m.ctrl.Call(m, "InsertSecurityEvent", ctx, db, input)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// InsertSecurityEvent indicates an expected call of InsertSecurityEvent.
+func (mr *MockUserRepositoryMockRecorder) InsertSecurityEvent(ctx, db, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertSecurityEvent", reflect.TypeOf((*MockUserRepository)(nil).InsertSecurityEvent), ctx, db, input)
+}
+
+// ListSessions mocks base method.
+func (m *MockUserRepository) ListSessions(ctx context.Context, userID int64) ([]*user.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx, userID)
+	ret0, _ := ret[0].([]*user.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockUserRepositoryMockRecorder) ListSessions(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockUserRepository)(nil).ListSessions), ctx, userID)
+}
+
+// RevokeAllSessions mocks base method.
+func (m *MockUserRepository) RevokeAllSessions(ctx context.Context, db database.DBExec, userID int64) ([]*user.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAllSessions", ctx, db, userID)
+	ret0, _ := ret[0].([]*user.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAllSessions indicates an expected call of RevokeAllSessions.
+func (mr *MockUserRepositoryMockRecorder) RevokeAllSessions(ctx, db, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAllSessions", reflect.TypeOf((*MockUserRepository)(nil).RevokeAllSessions), ctx, db, userID)
+}
+
+// RevokeSession mocks base method.
+func (m *MockUserRepository) RevokeSession(ctx context.Context, db database.DBExec, userID, sessionID int64) (*user.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, db, userID, sessionID)
+	ret0, _ := ret[0].(*user.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockUserRepositoryMockRecorder) RevokeSession(ctx, db, userID, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockUserRepository)(nil).RevokeSession), ctx, db, userID, sessionID)
+}
+
+// RevokedRefreshToken mocks base method.
+func (m *MockUserRepository) RevokedRefreshToken(ctx context.Context, db database.DBExec, token string) (*user.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokedRefreshToken", ctx, db, token)
+	ret0, _ := ret[0].(*user.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // RevokedRefreshToken indicates an expected call of RevokedRefreshToken.
 func (mr *MockUserRepositoryMockRecorder) RevokedRefreshToken(ctx, db, token interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokedRefreshToken", reflect.TypeOf((*MockUserRepository)(nil).RevokedRefreshToken), ctx, db, token)
 }
 
+// RotateRefreshToken mocks base method.
+func (m *MockUserRepository) RotateRefreshToken(ctx context.Context, db database.DBExec, oldToken string, input *user.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateRefreshToken", ctx, db, oldToken, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateRefreshToken indicates an expected call of RotateRefreshToken.
+func (mr *MockUserRepositoryMockRecorder) RotateRefreshToken(ctx, db, oldToken, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateRefreshToken", reflect.TypeOf((*MockUserRepository)(nil).RotateRefreshToken), ctx, db, oldToken, input)
+}
+
 // SaveRefreshToken mocks base method.
-func (m *MockUserRepository) SaveRefreshToken(ctx context.Context, db database.DBExec, input *user.Auth) error {
+func (m *MockUserRepository) SaveRefreshToken(ctx context.Context, db database.DBExec, input *user.Session) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SaveRefreshToken", ctx, db, input)
 	ret0, _ := ret[0].(error)
@@ -110,10 +199,10 @@ func (mr *MockUserRepositoryMockRecorder) SaveRefreshToken(ctx, db, input interf
 }
 
 // ValidateRefreshToken mocks base method.
-func (m *MockUserRepository) ValidateRefreshToken(ctx context.Context, token string) (int64, error) {
+func (m *MockUserRepository) ValidateRefreshToken(ctx context.Context, token string) (*user.Session, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ValidateRefreshToken", ctx, token)
-	ret0, _ := ret[0].(int64)
+	ret0, _ := ret[0].(*user.Session)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/codepnw/simple-bank/internal/features/user"
 	"github.com/codepnw/simple-bank/pkg/database"
+	"github.com/codepnw/simple-bank/pkg/token"
 	"github.com/codepnw/simple-bank/pkg/utils/errs"
 )
 
@@ -16,27 +17,36 @@ import (
 type UserRepository interface {
 	FindByID(ctx context.Context, id int64) (*user.User, error)
 	FindByEmail(ctx context.Context, email string) (*user.User, error)
-	ValidateRefreshToken(ctx context.Context, token string) (int64, error)
+	ValidateRefreshToken(ctx context.Context, token string) (*user.Session, error)
+	ListSessions(ctx context.Context, userID int64) ([]*user.Session, error)
+	FindRotatedToken(ctx context.Context, token string) (*user.Session, error)
 
 	// Transactions
 	Insert(ctx context.Context, db database.DBExec, input *user.User) (*user.User, error)
-	SaveRefreshToken(ctx context.Context, db database.DBExec, input *user.Auth) error
-	RevokedRefreshToken(ctx context.Context, db database.DBExec, token string) error
+	SaveRefreshToken(ctx context.Context, db database.DBExec, input *user.Session) error
+	RotateRefreshToken(ctx context.Context, db database.DBExec, oldToken string, input *user.Session) error
+	RevokedRefreshToken(ctx context.Context, db database.DBExec, token string) (*user.Session, error)
+	RevokeSession(ctx context.Context, db database.DBExec, userID, sessionID int64) (*user.Session, error)
+	RevokeAllSessions(ctx context.Context, db database.DBExec, userID int64) ([]*user.Session, error)
+	InsertSecurityEvent(ctx context.Context, db database.DBExec, input *user.SecurityEvent) error
 }
 
+// userRepository stores refresh tokens only as hashes; callers always pass
+// the raw token.
 type userRepository struct {
-	db *sql.DB
+	db     *sql.DB
+	hasher *token.Hasher
 }
 
-func NewUserRepository(db *sql.DB) UserRepository {
-	return &userRepository{db: db}
+func NewUserRepository(db *sql.DB, hasher *token.Hasher) UserRepository {
+	return &userRepository{db: db, hasher: hasher}
 }
 
 func (r *userRepository) Insert(ctx context.Context, db database.DBExec, input *user.User) (*user.User, error) {
 	m := userDomainToModel(input)
 	query := `
 		INSERT INTO users (username, password, first_name, last_name, email)
-		VALUES ($1, $2, $3, $4, $5) RETURNING id, created_at, updated_at
+		VALUES ($1, $2, $3, $4, $5) RETURNING id, role, created_at, updated_at
 	`
 	err := db.QueryRowContext(
 		ctx,
@@ -46,7 +56,7 @@ func (r *userRepository) Insert(ctx context.Context, db database.DBExec, input *
 		m.FirstName,
 		m.LastName,
 		m.Email,
-	).Scan(&m.ID, &m.CreatedAt, &m.UpdatedAt)
+	).Scan(&m.ID, &m.Role, &m.CreatedAt, &m.UpdatedAt)
 	if err != nil {
 		if strings.Contains(err.Error(), `duplicate key value violates unique constraint "users_username_key"`) {
 			return nil, errs.ErrUsernameAlreadyExists
@@ -61,7 +71,7 @@ func (r *userRepository) Insert(ctx context.Context, db database.DBExec, input *
 
 func (r *userRepository) FindByID(ctx context.Context, id int64) (*user.User, error) {
 	query := `
-		SELECT id, username, first_name, last_name, email FROM users
+		SELECT id, username, first_name, last_name, email, role, created_at, updated_at FROM users
 		WHERE id = $1 LIMIT 1
 	`
 	u := new(user.User)
@@ -71,6 +81,9 @@ func (r *userRepository) FindByID(ctx context.Context, id int64) (*user.User, er
 		&u.FirstName,
 		&u.LastName,
 		&u.Email,
+		&u.Role,
+		&u.CreatedAt,
+		&u.UpdatedAt,
 	); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, errs.ErrUserNotFound
@@ -82,7 +95,7 @@ func (r *userRepository) FindByID(ctx context.Context, id int64) (*user.User, er
 
 func (r *userRepository) FindByEmail(ctx context.Context, email string) (*user.User, error) {
 	query := `
-		SELECT id, email, password FROM users
+		SELECT id, email, password, role FROM users
 		WHERE email = $1 LIMIT 1
 	`
 	u := new(user.User)
@@ -90,6 +103,7 @@ func (r *userRepository) FindByEmail(ctx context.Context, email string) (*user.U
 		&u.ID,
 		&u.Email,
 		&u.Password,
+		&u.Role,
 	); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, errs.ErrUserNotFound
@@ -99,20 +113,50 @@ func (r *userRepository) FindByEmail(ctx context.Context, email string) (*user.U
 	return u, nil
 }
 
-func (r *userRepository) SaveRefreshToken(ctx context.Context, db database.DBExec, input *user.Auth) error {
+// SaveRefreshToken starts a new session; each login gets its own row.
+func (r *userRepository) SaveRefreshToken(ctx context.Context, db database.DBExec, input *user.Session) error {
 	query := `
-		INSERT INTO auth (user_id, token, expires_at) VALUES ($1, $2, $3)
-		ON CONFLICT (user_id)
-		DO UPDATE SET
-			token = EXCLUDED.token, expires_at = EXCLUDED.expires_at, revoked = FALSE
+		INSERT INTO sessions (user_id, token_hash, access_jti, access_expires_at, user_agent, client_ip, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 	`
-	_, err := db.ExecContext(ctx, query, input.UserID, input.Token, input.ExpiresAt)
+	_, err := db.ExecContext(
+		ctx,
+		query,
+		input.UserID,
+		r.hasher.Hash(input.Token),
+		input.AccessTokenID,
+		input.AccessExpiresAt,
+		input.UserAgent,
+		input.ClientIP,
+		input.ExpiresAt,
+	)
 	return err
 }
 
-func (r *userRepository) RevokedRefreshToken(ctx context.Context, db database.DBExec, token string) error {
-	query := `UPDATE auth SET revoked = TRUE WHERE token = $1`
-	res, err := db.ExecContext(ctx, query, token)
+// RotateRefreshToken swaps the session's refresh token in place, so the
+// device keeps a single row across refreshes, and remembers the old token
+// for reuse detection. The old token must still be current; a concurrent
+// refresh that already replaced it loses.
+func (r *userRepository) RotateRefreshToken(ctx context.Context, db database.DBExec, oldToken string, input *user.Session) error {
+	query := `
+		UPDATE sessions
+		SET token_hash = $1, access_jti = $2, access_expires_at = $3,
+			user_agent = $4, client_ip = $5, expires_at = $6,
+			last_used_at = NOW(), updated_at = NOW()
+		WHERE id = $7 AND token_hash = $8 AND revoked = FALSE
+	`
+	res, err := db.ExecContext(
+		ctx,
+		query,
+		r.hasher.Hash(input.Token),
+		input.AccessTokenID,
+		input.AccessExpiresAt,
+		input.UserAgent,
+		input.ClientIP,
+		input.ExpiresAt,
+		input.ID,
+		r.hasher.Hash(oldToken),
+	)
 	if err != nil {
 		return err
 	}
@@ -124,29 +168,228 @@ func (r *userRepository) RevokedRefreshToken(ctx context.Context, db database.DB
 	if rows == 0 {
 		return errs.ErrTokenNotFound
 	}
-	return nil
+
+	query = `INSERT INTO rotated_refresh_tokens (token_hash, session_id) VALUES ($1, $2)`
+	_, err = db.ExecContext(ctx, query, r.hasher.Hash(oldToken), input.ID)
+	return err
+}
+
+// FindRotatedToken returns the session (token family) a rotated-out refresh
+// token belonged to.
+func (r *userRepository) FindRotatedToken(ctx context.Context, refreshToken string) (*user.Session, error) {
+	query := `
+		SELECT s.id, s.user_id, s.revoked FROM rotated_refresh_tokens rt
+		JOIN sessions s ON s.id = rt.session_id
+		WHERE rt.token_hash = $1
+	`
+	s := new(user.Session)
+	err := r.db.QueryRowContext(ctx, query, r.hasher.Hash(refreshToken)).Scan(&s.ID, &s.UserID, &s.Revoked)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrTokenNotFound
+		}
+		return nil, err
+	}
+	return s, nil
+}
+
+func (r *userRepository) InsertSecurityEvent(ctx context.Context, db database.DBExec, input *user.SecurityEvent) error {
+	query := `
+		INSERT INTO security_events (user_id, session_id, type, user_agent, client_ip)
+		VALUES ($1, NULLIF($2::BIGINT, 0), $3, $4, $5)
+	`
+	_, err := db.ExecContext(
+		ctx,
+		query,
+		input.UserID,
+		input.SessionID,
+		input.Type,
+		input.UserAgent,
+		input.ClientIP,
+	)
+	return err
+}
+
+// RevokedRefreshToken revokes the session holding the token and returns it,
+// so its access token can be denied too.
+func (r *userRepository) RevokedRefreshToken(ctx context.Context, db database.DBExec, refreshToken string) (*user.Session, error) {
+	query := `
+		UPDATE sessions SET revoked = TRUE, updated_at = NOW()
+		WHERE token_hash = $1
+		RETURNING ` + revokedSessionColumns
+	s, err := scanRevokedSession(db.QueryRowContext(ctx, query, r.hasher.Hash(refreshToken)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrTokenNotFound
+		}
+		return nil, err
+	}
+	return s, nil
+}
+
+func (r *userRepository) RevokeSession(ctx context.Context, db database.DBExec, userID, sessionID int64) (*user.Session, error) {
+	query := `
+		UPDATE sessions SET revoked = TRUE, updated_at = NOW()
+		WHERE id = $1 AND user_id = $2 AND revoked = FALSE
+		RETURNING ` + revokedSessionColumns
+	s, err := scanRevokedSession(db.QueryRowContext(ctx, query, sessionID, userID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrSessionNotFound
+		}
+		return nil, err
+	}
+	return s, nil
+}
+
+func (r *userRepository) RevokeAllSessions(ctx context.Context, db database.DBExec, userID int64) ([]*user.Session, error) {
+	query := `
+		UPDATE sessions SET revoked = TRUE, updated_at = NOW()
+		WHERE user_id = $1 AND revoked = FALSE
+		RETURNING ` + revokedSessionColumns
+	rows, err := db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := make([]*user.Session, 0)
+	for rows.Next() {
+		s, err := scanRevokedSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return sessions, nil
 }
 
-func (r *userRepository) ValidateRefreshToken(ctx context.Context, token string) (int64, error) {
+const revokedSessionColumns = `id, user_id, access_jti, access_expires_at`
+
+func scanRevokedSession(row interface{ Scan(...any) error }) (*user.Session, error) {
 	var (
-		userID    int64
-		revoked   bool
-		expiresAt time.Time
+		s         = &user.Session{Revoked: true}
+		expiresAt sql.NullTime
+	)
+	if err := row.Scan(&s.ID, &s.UserID, &s.AccessTokenID, &expiresAt); err != nil {
+		return nil, err
+	}
+	s.AccessExpiresAt = expiresAt.Time
+	return s, nil
+}
+
+func (r *userRepository) ValidateRefreshToken(ctx context.Context, refreshToken string) (*user.Session, error) {
+	query := `
+		SELECT id, user_id, revoked, expires_at FROM sessions
+		WHERE token_hash = $1
+	`
+	s := new(user.Session)
+	err := r.db.QueryRowContext(ctx, query, r.hasher.Hash(refreshToken)).Scan(
+		&s.ID,
+		&s.UserID,
+		&s.Revoked,
+		&s.ExpiresAt,
 	)
-	query := `SELECT user_id, revoked, expires_at FROM auth WHERE token = $1`
-	err := r.db.QueryRowContext(ctx, query, token).Scan(&userID, &revoked, &expiresAt)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return 0, errs.ErrTokenNotFound
+			return nil, errs.ErrTokenNotFound
+		}
+		return nil, err
+	}
+
+	if s.Revoked {
+		return nil, errs.ErrTokenRevoked
+	}
+	if time.Now().After(s.ExpiresAt) {
+		return nil, errs.ErrTokenExpires
+	}
+	s.Token = refreshToken
+	return s, nil
+}
+
+// ListSessions returns the user's active sessions, most recently used first.
+func (r *userRepository) ListSessions(ctx context.Context, userID int64) ([]*user.Session, error) {
+	query := `
+		SELECT id, user_id, user_agent, client_ip, expires_at, last_used_at, created_at
+		FROM sessions
+		WHERE user_id = $1 AND revoked = FALSE AND expires_at > NOW()
+		ORDER BY last_used_at DESC, id DESC
+	`
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := make([]*user.Session, 0)
+	for rows.Next() {
+		s := new(user.Session)
+		if err := rows.Scan(
+			&s.ID,
+			&s.UserID,
+			&s.UserAgent,
+			&s.ClientIP,
+			&s.ExpiresAt,
+			&s.LastUsedAt,
+			&s.CreatedAt,
+		); err != nil {
+			return nil, err
 		}
+		sessions = append(sessions, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// HashLegacyTokens hashes refresh tokens left in plaintext by migration
+// 000011 and clears the raw values. It is a no-op once they are all done or
+// on a schema created without the legacy column.
+func HashLegacyTokens(ctx context.Context, db *sql.DB, hasher *token.Hasher) (int, error) {
+	var exists bool
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM information_schema.columns
+			WHERE table_name = 'sessions' AND column_name = 'legacy_token'
+		)
+	`
+	if err := db.QueryRowContext(ctx, query).Scan(&exists); err != nil {
 		return 0, err
 	}
+	if !exists {
+		return 0, nil
+	}
 
-	if revoked {
-		return 0, errs.ErrTokenRevoked
+	rows, err := db.QueryContext(ctx, `SELECT id, legacy_token FROM sessions WHERE legacy_token IS NOT NULL`)
+	if err != nil {
+		return 0, err
 	}
-	if time.Now().After(expiresAt) {
-		return 0, errs.ErrTokenExpires
+	legacy := make(map[int64]string)
+	for rows.Next() {
+		var (
+			id  int64
+			raw string
+		)
+		if err := rows.Scan(&id, &raw); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		legacy[id] = raw
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	query = `UPDATE sessions SET token_hash = $1, legacy_token = NULL WHERE id = $2`
+	for id, raw := range legacy {
+		if _, err := db.ExecContext(ctx, query, hasher.Hash(raw), id); err != nil {
+			return 0, err
+		}
 	}
-	return userID, nil
+	return len(legacy), nil
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/codepnw/simple-bank/internal/consts"
 	"github.com/codepnw/simple-bank/internal/features/user"
 	userrepository "github.com/codepnw/simple-bank/internal/features/user/repository"
+	"github.com/codepnw/simple-bank/pkg/auth"
 	"github.com/codepnw/simple-bank/pkg/database"
 	"github.com/codepnw/simple-bank/pkg/token"
 	"github.com/codepnw/simple-bank/pkg/utils/errs"
@@ -21,19 +22,24 @@ type UserUsecase interface {
 	Login(ctx context.Context, email, pwd string) (*TokenResponse, error)
 	RefreshToken(ctx context.Context, refreshToken string) (*TokenResponse, error)
 	Logout(ctx context.Context, refreshToken string) error
+	ListSessions(ctx context.Context) ([]*user.Session, error)
+	RevokeSession(ctx context.Context, sessionID int64) error
+	LogoutAll(ctx context.Context) error
 }
 
 type userUsecase struct {
-	repo  userrepository.UserRepository
-	token token.TokenMaker
-	tx    database.TxManager
+	repo     userrepository.UserRepository
+	token    token.TokenMaker
+	tx       database.TxManager
+	denylist token.Denylist
 }
 
-func NewUserUsecase(repo userrepository.UserRepository, token token.TokenMaker, tx database.TxManager) UserUsecase {
+func NewUserUsecase(repo userrepository.UserRepository, token token.TokenMaker, tx database.TxManager, denylist token.Denylist) UserUsecase {
 	return &userUsecase{
-		repo:  repo,
-		token: token,
-		tx:    tx,
+		repo:     repo,
+		token:    token,
+		tx:       tx,
+		denylist: denylist,
 	}
 }
 
@@ -56,17 +62,13 @@ func (u *userUsecase) Register(ctx context.Context, input *user.User) (*TokenRes
 		}
 
 		// Generate Token
-		resp, err := u.generateToken(userData)
+		resp, access, err := u.generateToken(userData)
 		if err != nil {
 			return err
 		}
 
 		// Save Refresh Token
-		err = u.repo.SaveRefreshToken(ctx, tx, &user.Auth{
-			UserID:    userData.ID,
-			Token:     resp.RefreshToken,
-			ExpiresAt: time.Now().Add(consts.TokenRefreshDuration),
-		})
+		err = u.repo.SaveRefreshToken(ctx, tx, newSession(ctx, userData.ID, resp.RefreshToken, access))
 		if err != nil {
 			return err
 		}
@@ -99,17 +101,13 @@ func (u *userUsecase) Login(ctx context.Context, email, pwd string) (*TokenRespo
 	var response *TokenResponse
 	err = u.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
 		// Generate Token
-		resp, err := u.generateToken(userData)
+		resp, access, err := u.generateToken(userData)
 		if err != nil {
 			return err
 		}
 
 		// Save Refresh Token
-		err = u.repo.SaveRefreshToken(ctx, tx, &user.Auth{
-			UserID:    userData.ID,
-			Token:     resp.RefreshToken,
-			ExpiresAt: time.Now().Add(consts.TokenRefreshDuration),
-		})
+		err = u.repo.SaveRefreshToken(ctx, tx, newSession(ctx, userData.ID, resp.RefreshToken, access))
 		if err != nil {
 			return err
 		}
@@ -127,36 +125,31 @@ func (u *userUsecase) RefreshToken(ctx context.Context, refreshToken string) (*T
 	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
 	defer cancel()
 
-	userID, err := u.repo.ValidateRefreshToken(ctx, refreshToken)
+	session, err := u.repo.ValidateRefreshToken(ctx, refreshToken)
 	if err != nil {
+		if errors.Is(err, errs.ErrTokenNotFound) {
+			return nil, u.checkReuse(ctx, refreshToken)
+		}
 		return nil, err
 	}
 
-	userData, err := u.repo.FindByID(ctx, userID)
+	userData, err := u.repo.FindByID(ctx, session.UserID)
 	if err != nil {
 		return nil, err
 	}
 
 	var response *TokenResponse
 	err = u.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
-		// Revoked Refresh Token
-		if err = u.repo.RevokedRefreshToken(ctx, tx, refreshToken); err != nil {
-			return nil
-		}
-
 		// Generate Token
-		resp, err := u.generateToken(userData)
+		resp, access, err := u.generateToken(userData)
 		if err != nil {
-			return nil
+			return err
 		}
 
-		// Save Refresh Token
-		err = u.repo.SaveRefreshToken(ctx, tx, &user.Auth{
-			UserID:    userData.ID,
-			Token:     resp.RefreshToken,
-			ExpiresAt: time.Now().Add(consts.TokenRefreshDuration),
-		})
-		if err != nil {
+		// Rotate the session's Refresh Token
+		next := newSession(ctx, userData.ID, resp.RefreshToken, access)
+		next.ID = session.ID
+		if err = u.repo.RotateRefreshToken(ctx, tx, refreshToken, next); err != nil {
 			return err
 		}
 
@@ -169,15 +162,49 @@ func (u *userUsecase) RefreshToken(ctx context.Context, refreshToken string) (*T
 	return response, nil
 }
 
+// checkReuse handles a refresh token that is no longer current. If it was
+// rotated out of a session, someone is replaying it: the whole session is
+// revoked so both the thief and the owner have to sign in again.
+func (u *userUsecase) checkReuse(ctx context.Context, refreshToken string) error {
+	family, err := u.repo.FindRotatedToken(ctx, refreshToken)
+	if err != nil {
+		return err
+	}
+
+	client := auth.GetClient(ctx)
+	err = u.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
+		revoked, err := u.repo.RevokeSession(ctx, tx, family.UserID, family.ID)
+		if err != nil && !errors.Is(err, errs.ErrSessionNotFound) {
+			return err
+		}
+		if err = u.denyAccess(ctx, revoked); err != nil {
+			return err
+		}
+
+		return u.repo.InsertSecurityEvent(ctx, tx, &user.SecurityEvent{
+			UserID:    family.UserID,
+			SessionID: family.ID,
+			Type:      user.EventRefreshTokenReuse,
+			UserAgent: client.UserAgent,
+			ClientIP:  client.IP,
+		})
+	})
+	if err != nil {
+		return err
+	}
+	return errs.ErrTokenReused
+}
+
 func (u *userUsecase) Logout(ctx context.Context, refreshToken string) error {
 	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
 	defer cancel()
 
 	err := u.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
-		if err := u.repo.RevokedRefreshToken(ctx, tx, refreshToken); err != nil {
+		revoked, err := u.repo.RevokedRefreshToken(ctx, tx, refreshToken)
+		if err != nil {
 			return err
 		}
-		return nil
+		return u.denyAccess(ctx, revoked)
 	})
 	if err != nil {
 		return err
@@ -185,24 +212,103 @@ func (u *userUsecase) Logout(ctx context.Context, refreshToken string) error {
 	return nil
 }
 
+func (u *userUsecase) ListSessions(ctx context.Context) ([]*user.Session, error) {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	userID := auth.GetUserID(ctx)
+	if userID == 0 {
+		return nil, errs.ErrNoUserID
+	}
+	return u.repo.ListSessions(ctx, userID)
+}
+
+func (u *userUsecase) RevokeSession(ctx context.Context, sessionID int64) error {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	userID := auth.GetUserID(ctx)
+	if userID == 0 {
+		return errs.ErrNoUserID
+	}
+
+	return u.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
+		revoked, err := u.repo.RevokeSession(ctx, tx, userID, sessionID)
+		if err != nil {
+			return err
+		}
+		return u.denyAccess(ctx, revoked)
+	})
+}
+
+// LogoutAll revokes every session of the current user, including the one
+// making the request.
+func (u *userUsecase) LogoutAll(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, consts.ContextTimeout)
+	defer cancel()
+
+	userID := auth.GetUserID(ctx)
+	if userID == 0 {
+		return errs.ErrNoUserID
+	}
+
+	return u.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
+		revoked, err := u.repo.RevokeAllSessions(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+		return u.denyAccess(ctx, revoked...)
+	})
+}
+
+// denyAccess puts the access tokens of revoked sessions on the denylist so
+// they stop working before they expire. It runs inside the revoking
+// transaction: if the denylist fails, the session stays active.
+func (u *userUsecase) denyAccess(ctx context.Context, sessions ...*user.Session) error {
+	for _, s := range sessions {
+		if s == nil || s.AccessTokenID == "" {
+			continue
+		}
+		if err := u.denylist.Deny(ctx, s.AccessTokenID, s.AccessExpiresAt); err != nil {
+			return fmt.Errorf("deny access token failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// newSession records the refresh token and the access token issued with it
+// against the device in ctx.
+func newSession(ctx context.Context, userID int64, refreshToken string, access *token.Payload) *user.Session {
+	client := auth.GetClient(ctx)
+	return &user.Session{
+		UserID:          userID,
+		Token:           refreshToken,
+		AccessTokenID:   access.ID,
+		AccessExpiresAt: access.ExpiredAt,
+		UserAgent:       client.UserAgent,
+		ClientIP:        client.IP,
+		ExpiresAt:       time.Now().Add(consts.TokenRefreshDuration),
+	}
+}
+
 type TokenResponse struct {
 	AccessToken  string `json:"access_token"`
 	RefreshToken string `json:"refresh_token"`
 }
 
-func (u *userUsecase) generateToken(usr *user.User) (*TokenResponse, error) {
-	accessToken, err := u.token.GenerateAccessToken(usr)
+func (u *userUsecase) generateToken(usr *user.User) (*TokenResponse, *token.Payload, error) {
+	accessToken, access, err := u.token.GenerateAccessToken(usr)
 	if err != nil {
-		return nil, fmt.Errorf("gen access token failed: %w", err)
+		return nil, nil, fmt.Errorf("gen access token failed: %w", err)
 	}
 	refreshToken, err := u.token.GenerateRefreshToken(usr)
 	if err != nil {
-		return nil, fmt.Errorf("gen refresh token failed: %w", err)
+		return nil, nil, fmt.Errorf("gen refresh token failed: %w", err)
 	}
 
 	response := &TokenResponse{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
 	}
-	return response, nil
+	return response, access, nil
 }
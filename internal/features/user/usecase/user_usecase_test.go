@@ -3,11 +3,15 @@ package userusecase_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/codepnw/simple-bank/internal/features/user"
 	userrepository "github.com/codepnw/simple-bank/internal/features/user/repository"
 	userusecase "github.com/codepnw/simple-bank/internal/features/user/usecase"
 	"github.com/codepnw/simple-bank/internal/mocks"
+	"github.com/codepnw/simple-bank/pkg/auth"
+	"github.com/codepnw/simple-bank/pkg/database"
+	"github.com/codepnw/simple-bank/pkg/token/denylist"
 	"github.com/codepnw/simple-bank/pkg/utils/errs"
 	"github.com/codepnw/simple-bank/pkg/utils/helper"
 	"github.com/golang/mock/gomock"
@@ -156,13 +160,18 @@ func TestRefreshToken(t *testing.T) {
 			token: "mock_refresh_token",
 			mockFn: func(mockRepo *userrepository.MockUserRepository, token string) {
 				u := mocks.MockUserData()
-				mockRepo.EXPECT().ValidateRefreshToken(gomock.Any(), token).Return(u.ID, nil).Times(1)
+				session := &user.Session{ID: 3, UserID: u.ID, Token: token}
+				mockRepo.EXPECT().ValidateRefreshToken(gomock.Any(), token).Return(session, nil).Times(1)
 
 				mockRepo.EXPECT().FindByID(gomock.Any(), u.ID).Return(u, nil).Times(1)
 
-				mockRepo.EXPECT().RevokedRefreshToken(gomock.Any(), gomock.Any(), token).Return(nil).Times(1)
-
-				mockRepo.EXPECT().SaveRefreshToken(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				mockRepo.EXPECT().RotateRefreshToken(gomock.Any(), gomock.Any(), token, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ database.DBExec, _ string, next *user.Session) error {
+						// Same device row, new token
+						assert.Equal(t, session.ID, next.ID)
+						assert.NotEqual(t, token, next.Token)
+						return nil
+					}).Times(1)
 			},
 			expectedErr: nil,
 		},
@@ -170,22 +179,58 @@ func TestRefreshToken(t *testing.T) {
 			name:  "fail not found",
 			token: "mock_refresh_token",
 			mockFn: func(mockRepo *userrepository.MockUserRepository, token string) {
-				mockRepo.EXPECT().ValidateRefreshToken(gomock.Any(), token).Return(int64(0), errs.ErrTokenNotFound).Times(1)
+				mockRepo.EXPECT().ValidateRefreshToken(gomock.Any(), token).Return(nil, errs.ErrTokenNotFound).Times(1)
+
+				mockRepo.EXPECT().FindRotatedToken(gomock.Any(), token).Return(nil, errs.ErrTokenNotFound).Times(1)
 			},
 			expectedErr: errs.ErrTokenNotFound,
 		},
+		{
+			name:  "fail reused token revokes family",
+			token: "mock_rotated_token",
+			mockFn: func(mockRepo *userrepository.MockUserRepository, token string) {
+				family := &user.Session{ID: 3, UserID: 10}
+				mockRepo.EXPECT().ValidateRefreshToken(gomock.Any(), token).Return(nil, errs.ErrTokenNotFound).Times(1)
+
+				mockRepo.EXPECT().FindRotatedToken(gomock.Any(), token).Return(family, nil).Times(1)
+
+				mockRepo.EXPECT().RevokeSession(gomock.Any(), gomock.Any(), family.UserID, family.ID).Return(family, nil).Times(1)
+
+				mockRepo.EXPECT().InsertSecurityEvent(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ database.DBExec, event *user.SecurityEvent) error {
+						assert.Equal(t, user.EventRefreshTokenReuse, event.Type)
+						assert.Equal(t, family.ID, event.SessionID)
+						return nil
+					}).Times(1)
+			},
+			expectedErr: errs.ErrTokenReused,
+		},
+		{
+			name:  "fail reused token family already revoked",
+			token: "mock_rotated_token",
+			mockFn: func(mockRepo *userrepository.MockUserRepository, token string) {
+				family := &user.Session{ID: 3, UserID: 10, Revoked: true}
+				mockRepo.EXPECT().ValidateRefreshToken(gomock.Any(), token).Return(nil, errs.ErrTokenNotFound).Times(1)
+
+				mockRepo.EXPECT().FindRotatedToken(gomock.Any(), token).Return(family, nil).Times(1)
+
+				mockRepo.EXPECT().RevokeSession(gomock.Any(), gomock.Any(), family.UserID, family.ID).Return(nil, errs.ErrSessionNotFound).Times(1)
+
+				mockRepo.EXPECT().InsertSecurityEvent(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			expectedErr: errs.ErrTokenReused,
+		},
 		{
 			name:  "fail db error",
 			token: "mock_refresh_token",
 			mockFn: func(mockRepo *userrepository.MockUserRepository, token string) {
 				u := mocks.MockUserData()
-				mockRepo.EXPECT().ValidateRefreshToken(gomock.Any(), token).Return(u.ID, nil).Times(1)
+				session := &user.Session{ID: 3, UserID: u.ID, Token: token}
+				mockRepo.EXPECT().ValidateRefreshToken(gomock.Any(), token).Return(session, nil).Times(1)
 
 				mockRepo.EXPECT().FindByID(gomock.Any(), u.ID).Return(u, nil).Times(1)
 
-				mockRepo.EXPECT().RevokedRefreshToken(gomock.Any(), gomock.Any(), token).Return(nil).Times(1)
-
-				mockRepo.EXPECT().SaveRefreshToken(gomock.Any(), gomock.Any(), gomock.Any()).Return(mocks.ErrDatabase).Times(1)
+				mockRepo.EXPECT().RotateRefreshToken(gomock.Any(), gomock.Any(), token, gomock.Any()).Return(mocks.ErrDatabase).Times(1)
 			},
 			expectedErr: mocks.ErrDatabase,
 		},
@@ -200,7 +245,7 @@ func TestRefreshToken(t *testing.T) {
 			result, err := uc.RefreshToken(context.Background(), tc.token)
 
 			if tc.expectedErr != nil {
-				assert.Error(t, err)
+				assert.ErrorIs(t, err, tc.expectedErr)
 			} else {
 				assert.NoError(t, err)
 				assert.NotNil(t, result)
@@ -222,7 +267,8 @@ func TestLogout(t *testing.T) {
 			name:  "success",
 			token: "mock_refresh_token",
 			mockFn: func(mockRepo *userrepository.MockUserRepository, token string) {
-				mockRepo.EXPECT().RevokedRefreshToken(gomock.Any(), gomock.Any(), token).Return(nil).Times(1)
+				session := &user.Session{ID: 3, AccessTokenID: "mock_jti", AccessExpiresAt: time.Now().Add(time.Minute)}
+				mockRepo.EXPECT().RevokedRefreshToken(gomock.Any(), gomock.Any(), token).Return(session, nil).Times(1)
 			},
 			expectedErr: nil,
 		},
@@ -230,7 +276,7 @@ func TestLogout(t *testing.T) {
 			name:  "fail db error",
 			token: "mock_refresh_token",
 			mockFn: func(mockRepo *userrepository.MockUserRepository, token string) {
-				mockRepo.EXPECT().RevokedRefreshToken(gomock.Any(), gomock.Any(), token).Return(mocks.ErrDatabase).Times(1)
+				mockRepo.EXPECT().RevokedRefreshToken(gomock.Any(), gomock.Any(), token).Return(nil, mocks.ErrDatabase).Times(1)
 			},
 			expectedErr: mocks.ErrDatabase,
 		},
@@ -238,7 +284,7 @@ func TestLogout(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			uc, repo, _ := setup(t)
+			uc, repo, deny := setup(t)
 
 			tc.mockFn(repo, tc.token)
 
@@ -246,6 +292,123 @@ func TestLogout(t *testing.T) {
 
 			if tc.expectedErr != nil {
 				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+
+				// The access token issued with the session stops working too
+				denied, err := deny.IsDenied(context.Background(), "mock_jti")
+				assert.NoError(t, err)
+				assert.True(t, denied)
+			}
+		})
+	}
+}
+
+func TestRevokeSession(t *testing.T) {
+	type testCase struct {
+		name        string
+		userID      int64
+		sessionID   int64
+		mockFn      func(mockRepo *userrepository.MockUserRepository, userID, sessionID int64)
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:      "success",
+			userID:    10,
+			sessionID: 3,
+			mockFn: func(mockRepo *userrepository.MockUserRepository, userID, sessionID int64) {
+				mockRepo.EXPECT().RevokeSession(gomock.Any(), gomock.Any(), userID, sessionID).Return(&user.Session{ID: sessionID, UserID: userID}, nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "fail no user id",
+			sessionID:   3,
+			mockFn:      func(mockRepo *userrepository.MockUserRepository, userID, sessionID int64) {},
+			expectedErr: errs.ErrNoUserID,
+		},
+		{
+			name:      "fail other user's session",
+			userID:    10,
+			sessionID: 99,
+			mockFn: func(mockRepo *userrepository.MockUserRepository, userID, sessionID int64) {
+				mockRepo.EXPECT().RevokeSession(gomock.Any(), gomock.Any(), userID, sessionID).Return(nil, errs.ErrSessionNotFound).Times(1)
+			},
+			expectedErr: errs.ErrSessionNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, repo, _ := setup(t)
+
+			tc.mockFn(repo, tc.userID, tc.sessionID)
+
+			ctx := context.Background()
+			if tc.userID != 0 {
+				ctx = auth.SetUserID(ctx, tc.userID)
+			}
+
+			err := uc.RevokeSession(ctx, tc.sessionID)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestLogoutAll(t *testing.T) {
+	type testCase struct {
+		name        string
+		userID      int64
+		mockFn      func(mockRepo *userrepository.MockUserRepository, userID int64)
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:   "success",
+			userID: 10,
+			mockFn: func(mockRepo *userrepository.MockUserRepository, userID int64) {
+				mockRepo.EXPECT().RevokeAllSessions(gomock.Any(), gomock.Any(), userID).Return([]*user.Session{{ID: 3, UserID: userID}}, nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "fail no user id",
+			mockFn:      func(mockRepo *userrepository.MockUserRepository, userID int64) {},
+			expectedErr: errs.ErrNoUserID,
+		},
+		{
+			name:   "fail db error",
+			userID: 10,
+			mockFn: func(mockRepo *userrepository.MockUserRepository, userID int64) {
+				mockRepo.EXPECT().RevokeAllSessions(gomock.Any(), gomock.Any(), userID).Return(nil, mocks.ErrDatabase).Times(1)
+			},
+			expectedErr: mocks.ErrDatabase,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, repo, _ := setup(t)
+
+			tc.mockFn(repo, tc.userID)
+
+			ctx := context.Background()
+			if tc.userID != 0 {
+				ctx = auth.SetUserID(ctx, tc.userID)
+			}
+
+			err := uc.LogoutAll(ctx)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
 			} else {
 				assert.NoError(t, err)
 			}
@@ -253,7 +416,7 @@ func TestLogout(t *testing.T) {
 	}
 }
 
-func setup(t *testing.T) (userusecase.UserUsecase, *userrepository.MockUserRepository, mocks.MockDB) {
+func setup(t *testing.T) (userusecase.UserUsecase, *userrepository.MockUserRepository, *denylist.Memory) {
 	t.Helper()
 
 	ctrl := gomock.NewController(t)
@@ -262,9 +425,9 @@ func setup(t *testing.T) (userusecase.UserUsecase, *userrepository.MockUserRepos
 	mockRepo := userrepository.NewMockUserRepository(ctrl)
 
 	mockToken := mocks.MockToken{}
-	mockDB := mocks.MockDB{}
 	mockTx := mocks.MockTx{}
+	deny := denylist.NewMemory()
 
-	uc := userusecase.NewUserUsecase(mockRepo, mockToken, &mockTx)
-	return uc, mockRepo, mockDB
+	uc := userusecase.NewUserUsecase(mockRepo, mockToken, &mockTx, deny)
+	return uc, mockRepo, deny
 }
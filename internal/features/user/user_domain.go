@@ -2,6 +2,15 @@ package user
 
 import "time"
 
+type Role string
+
+// Customers only see their own data; admins are operations staff with
+// read access across owners.
+const (
+	RoleCustomer Role = "customer"
+	RoleAdmin    Role = "admin"
+)
+
 type User struct {
 	ID        int64     `json:"id"`
 	Username  string    `json:"username"`
@@ -9,16 +18,41 @@ type User struct {
 	FirstName string    `json:"first_name"`
 	LastName  string    `json:"last_name"`
 	Email     string    `json:"email"`
+	Role      Role      `json:"role"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
-type Auth struct {
+// Session is one signed-in device. Token is the device's current refresh
+// token and AccessTokenID the ID of the last access token issued to it;
+// neither is exposed.
+type Session struct {
+	ID              int64     `json:"id"`
+	UserID          int64     `json:"-"`
+	Token           string    `json:"-"`
+	AccessTokenID   string    `json:"-"`
+	AccessExpiresAt time.Time `json:"-"`
+	UserAgent       string    `json:"user_agent"`
+	ClientIP        string    `json:"client_ip"`
+	Revoked         bool      `json:"-"`
+	ExpiresAt       time.Time `json:"expires_at"`
+	LastUsedAt      time.Time `json:"last_used_at"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"-"`
+}
+
+type SecurityEventType string
+
+// EventRefreshTokenReuse: a refresh token that was already rotated out of
+// its session was presented again.
+const EventRefreshTokenReuse SecurityEventType = "refresh_token_reuse"
+
+type SecurityEvent struct {
 	ID        int64
 	UserID    int64
-	Token     string
-	Revoked   bool
-	ExpiresAt time.Time
+	SessionID int64
+	Type      SecurityEventType
+	UserAgent string
+	ClientIP  string
 	CreatedAt time.Time
-	UpdatedAt time.Time
 }
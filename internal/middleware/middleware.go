@@ -2,43 +2,57 @@ package middleware
 
 import (
 	"context"
-	"log"
 	"strings"
 
 	"github.com/codepnw/simple-bank/internal/consts"
+	"github.com/codepnw/simple-bank/internal/features/user"
+	"github.com/codepnw/simple-bank/pkg/auth"
 	"github.com/codepnw/simple-bank/pkg/token"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
 	"github.com/codepnw/simple-bank/pkg/utils/response"
 	"github.com/gin-gonic/gin"
 )
 
 type AuthMiddleware struct {
-	token token.TokenMaker
+	token    token.TokenMaker
+	denylist token.Denylist
 }
 
-func NewMiddleware(token token.TokenMaker) *AuthMiddleware {
-	return &AuthMiddleware{token: token}
+func NewMiddleware(token token.TokenMaker, denylist token.Denylist) *AuthMiddleware {
+	return &AuthMiddleware{token: token, denylist: denylist}
 }
 
 func (m *AuthMiddleware) Authorized() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
-			response.Unauthorized(c, "header is missing")
+			response.Error(c, errs.ErrMissingAuthHeader)
 			c.Abort()
 			return
 		}
 
 		parts := strings.Split(authHeader, " ")
 		if len(parts) != 2 || parts[0] != "Bearer" {
-			response.Unauthorized(c, "invalid token format")
+			response.Error(c, errs.ErrInvalidAuthHeader)
 			c.Abort()
 			return
 		}
 
 		claims, err := m.token.VerifyAccessToken(parts[1])
 		if err != nil {
-			log.Printf("verify token failed: %v", err)
-			response.Unauthorized(c, "verify token failed")
+			response.Error(c, errs.ErrInvalidToken.Wrap(err))
+			c.Abort()
+			return
+		}
+
+		denied, err := m.denylist.IsDenied(c.Request.Context(), claims.ID)
+		if err != nil {
+			response.Error(c, err)
+			c.Abort()
+			return
+		}
+		if denied {
+			response.Error(c, errs.ErrTokenRevoked)
 			c.Abort()
 			return
 		}
@@ -46,7 +60,33 @@ func (m *AuthMiddleware) Authorized() gin.HandlerFunc {
 		ctx := c.Request.Context()
 		ctx = context.WithValue(ctx, consts.ContextUserClaimsKey, claims)
 		ctx = context.WithValue(ctx, consts.ContextUserIDKey, claims.UserID)
+		ctx = auth.SetRole(ctx, claims.Role)
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
 
+// RequireRole lets through only callers holding one of roles. It must run
+// after Authorized.
+func (m *AuthMiddleware) RequireRole(roles ...user.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !auth.HasRole(c.Request.Context(), roles...) {
+			response.Error(c, errs.ErrNoPermission)
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// ClientInfo records the caller's user agent and IP for session tracking.
+func ClientInfo() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := auth.SetClient(c.Request.Context(), auth.Client{
+			UserAgent: c.Request.UserAgent(),
+			IP:        c.ClientIP(),
+		})
 		c.Request = c.Request.WithContext(ctx)
 		c.Next()
 	}
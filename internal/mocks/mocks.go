@@ -6,10 +6,15 @@ import (
 	"database/sql"
 	"errors"
 
+	"github.com/codepnw/simple-bank/internal/consts"
 	"github.com/codepnw/simple-bank/internal/features/account"
+	"github.com/codepnw/simple-bank/internal/features/entry"
+	"github.com/codepnw/simple-bank/internal/features/ledger"
+	ledgerusecase "github.com/codepnw/simple-bank/internal/features/ledger/usecase"
 	"github.com/codepnw/simple-bank/internal/features/transfer"
 	transferusecase "github.com/codepnw/simple-bank/internal/features/transfer/usecase"
 	"github.com/codepnw/simple-bank/internal/features/user"
+	"github.com/codepnw/simple-bank/pkg/currency"
 	"github.com/codepnw/simple-bank/pkg/token"
 )
 
@@ -17,8 +22,9 @@ var ErrDatabase = errors.New("database error")
 
 type MockToken struct{}
 
-func (m MockToken) GenerateAccessToken(u *user.User) (string, error) {
-	return "", nil
+func (m MockToken) GenerateAccessToken(u *user.User) (string, *token.Payload, error) {
+	payload, err := token.NewTokenPayload(u, consts.TokenAccessDuration)
+	return "", payload, err
 }
 
 func (m MockToken) GenerateRefreshToken(u *user.User) (string, error) {
@@ -66,11 +72,31 @@ func MockUserData() *user.User {
 
 func MockAccountData() *account.Account {
 	return &account.Account{
-		ID:       10,
-		OwnerID:  10,
-		Balance:  1000,
+		ID:               10,
+		OwnerID:          10,
+		Balance:          1000,
+		AvailableBalance: 1000,
+		Currency:         "THB",
+		Type:             account.TypeCustomer,
+	}
+}
+
+func MockCashAccountData() *account.Account {
+	return &account.Account{
+		ID:       1,
+		OwnerID:  1,
 		Currency: "THB",
+		Type:     account.TypeCash,
+	}
+}
+
+// MockPostResult posts input as journal 1 with the balances in accounts.
+func MockPostResult(input *ledgerusecase.PostParams, accounts map[int64]*account.Account) *ledgerusecase.PostResult {
+	journal := &ledger.Journal{ID: 1, Kind: input.Kind, TransferID: input.TransferID}
+	for _, l := range input.Lines {
+		journal.Entries = append(journal.Entries, &entry.Entry{JournalID: 1, AccountID: l.AccountID, Amount: l.Amount})
 	}
+	return &ledgerusecase.PostResult{Journal: journal, Accounts: accounts}
 }
 
 func MockTransferData(input *transferusecase.TransferParams) *transfer.Transfer {
@@ -81,3 +107,28 @@ func MockTransferData(input *transferusecase.TransferParams) *transfer.Transfer
 		Amount:        input.Amount,
 	}
 }
+
+// MockLimitsData: 1,000 per transfer, 5,000 and 5 transfers per 24h
+func MockLimitsData() *transfer.Limits {
+	return &transfer.Limits{
+		AccountID:   10,
+		Currency:    "THB",
+		MaxAmount:   1000,
+		DailyAmount: 5000,
+		DailyCount:  5,
+	}
+}
+
+// MockCurrencies: THB, USD and JPY are enabled (no THB/USD <-> JPY rate), EUR is disabled
+func MockCurrencies() *currency.Registry {
+	r, err := currency.NewRegistry([]*currency.Currency{
+		{Code: "THB", Exponent: 2, Symbol: "฿", Enabled: true},
+		{Code: "USD", Exponent: 2, Symbol: "$", Enabled: true},
+		{Code: "JPY", Exponent: 0, Symbol: "¥", Enabled: true},
+		{Code: "EUR", Exponent: 2, Symbol: "€", Enabled: false},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
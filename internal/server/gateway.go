@@ -0,0 +1,141 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/codepnw/simple-bank/docs/openapi"
+	"github.com/codepnw/simple-bank/internal/consts"
+	pb "github.com/codepnw/simple-bank/pb/proto"
+	"github.com/codepnw/simple-bank/pkg/config"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/codepnw/simple-bank/pkg/utils/response"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// ================ gRPC Gateway ====================
+
+// gatewayKey marks calls proxied by this process's gateway, so the gRPC
+// server trusts the client address it forwards and nobody else's. It is
+// random per process and never sent to clients.
+var gatewayKey = rand.Text()
+
+const metadataGatewayKey = "x-gateway-key"
+
+// RunGatewayServer serves the SimpleBank service as JSON over HTTP, using
+// the google.api.http routes in the proto. Calls are proxied to the gRPC
+// server so they go through the same interceptors.
+func RunGatewayServer(cfg *config.EnvConfig) error {
+	ctx := context.Background()
+
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				UseProtoNames:   true,
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		}),
+		runtime.WithIncomingHeaderMatcher(gatewayHeaderMatcher),
+		runtime.WithErrorHandler(gatewayErrorHandler),
+		runtime.WithMetadata(func(context.Context, *http.Request) metadata.MD {
+			return metadata.Pairs(metadataGatewayKey, gatewayKey)
+		}),
+	)
+
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if err := pb.RegisterSimpleBankHandlerFromEndpoint(ctx, mux, dialTarget(cfg.Server.GrpcAddr), opts); err != nil {
+		return fmt.Errorf("cannot register gateway: %v", err)
+	}
+
+	httpMux := http.NewServeMux()
+	httpMux.Handle("/", mux)
+	httpMux.HandleFunc("/openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(openapi.Spec)
+	})
+
+	addr := cfg.Server.GatewayAddr
+	log.Printf("start gRPC gateway docs at %s/openapi.json", addr)
+	log.Printf("start gRPC gateway at %s", addr)
+	return http.ListenAndServe(addr, httpMux)
+}
+
+// gatewayHeaderMatcher forwards Idempotency-Key on top of the default
+// permanent headers (Authorization among them).
+func gatewayHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, consts.HeaderIdempotencyKey) {
+		return consts.MetadataIdempotencyKey, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// fromGateway reports whether md carries this process's gateway key. A
+// client can forward its own value through Grpc-Metadata-*, so any of the
+// values may be the gateway's.
+func fromGateway(md metadata.MD) bool {
+	for _, v := range md.Get(metadataGatewayKey) {
+		if subtle.ConstantTimeCompare([]byte(v), []byte(gatewayKey)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// gatewayErrorHandler writes errors in the same ErrorResponse shape as the
+// gin router, reading the code back from the status ErrorInfo.
+func gatewayErrorHandler(ctx context.Context, mux *runtime.ServeMux, m runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	st := status.Convert(err)
+	httpStatus := runtime.HTTPStatusFromCode(st.Code())
+
+	resp := response.ErrorResponse{
+		Code:    httpStatus,
+		Type:    st.Code().String(),
+		Message: st.Message(),
+	}
+	for _, d := range st.Details() {
+		switch detail := d.(type) {
+		case *errdetails.ErrorInfo:
+			if detail.GetDomain() == errs.ErrorDomain {
+				resp.Type = detail.GetReason()
+			}
+		case *errdetails.BadRequest:
+			for _, f := range detail.GetFieldViolations() {
+				resp.Details = append(resp.Details, errs.FieldViolation{
+					Field:       f.GetField(),
+					Description: f.GetDescription(),
+				})
+			}
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.Printf("write gateway error failed: %v", err)
+	}
+}
+
+// dialTarget fills in localhost for listen addresses like ":9090".
+func dialTarget(addr string) string {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil || host != "" {
+		return addr
+	}
+	return net.JoinHostPort("localhost", port)
+}
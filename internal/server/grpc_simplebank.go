@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+
+	accountgrpc "github.com/codepnw/simple-bank/internal/features/account/grpc"
+	admingrpc "github.com/codepnw/simple-bank/internal/features/admin/grpc"
+	transfergrpc "github.com/codepnw/simple-bank/internal/features/transfer/grpc"
+	usergrpc "github.com/codepnw/simple-bank/internal/features/user/grpc"
+	pb "github.com/codepnw/simple-bank/pb/proto"
+)
+
+// simpleBankServer serves the single SimpleBank service by delegating each
+// RPC to the feature server that owns it.
+type simpleBankServer struct {
+	pb.UnimplementedSimpleBankServer
+	transfer *transfergrpc.TransferServer
+	account  *accountgrpc.AccountServer
+	user     *usergrpc.UserServer
+	admin    *admingrpc.AdminServer
+}
+
+func (s *simpleBankServer) CreateTransfer(ctx context.Context, req *pb.CreateTransferRequest) (*pb.CreateTransferResponse, error) {
+	return s.transfer.CreateTransfer(ctx, req)
+}
+
+func (s *simpleBankServer) QuoteTransfer(ctx context.Context, req *pb.QuoteTransferRequest) (*pb.QuoteTransferResponse, error) {
+	return s.transfer.QuoteTransfer(ctx, req)
+}
+
+func (s *simpleBankServer) Deposit(ctx context.Context, req *pb.DepositRequest) (*pb.DepositResponse, error) {
+	return s.account.Deposit(ctx, req)
+}
+
+func (s *simpleBankServer) Withdraw(ctx context.Context, req *pb.WithdrawRequest) (*pb.WithdrawResponse, error) {
+	return s.account.Withdraw(ctx, req)
+}
+
+func (s *simpleBankServer) QuoteWithdraw(ctx context.Context, req *pb.QuoteWithdrawRequest) (*pb.QuoteWithdrawResponse, error) {
+	return s.account.QuoteWithdraw(ctx, req)
+}
+
+func (s *simpleBankServer) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.TokenResponse, error) {
+	return s.user.Register(ctx, req)
+}
+
+func (s *simpleBankServer) Login(ctx context.Context, req *pb.LoginRequest) (*pb.TokenResponse, error) {
+	return s.user.Login(ctx, req)
+}
+
+func (s *simpleBankServer) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.TokenResponse, error) {
+	return s.user.RefreshToken(ctx, req)
+}
+
+func (s *simpleBankServer) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	return s.user.Logout(ctx, req)
+}
+
+func (s *simpleBankServer) CreateAccount(ctx context.Context, req *pb.CreateAccountRequest) (*pb.CreateAccountResponse, error) {
+	return s.account.CreateAccount(ctx, req)
+}
+
+func (s *simpleBankServer) GetAccount(ctx context.Context, req *pb.GetAccountRequest) (*pb.GetAccountResponse, error) {
+	return s.account.GetAccount(ctx, req)
+}
+
+func (s *simpleBankServer) ListAccounts(ctx context.Context, req *pb.ListAccountsRequest) (*pb.ListAccountsResponse, error) {
+	return s.account.ListAccounts(ctx, req)
+}
+
+func (s *simpleBankServer) AdminFindUser(ctx context.Context, req *pb.AdminFindUserRequest) (*pb.AdminFindUserResponse, error) {
+	return s.admin.AdminFindUser(ctx, req)
+}
+
+func (s *simpleBankServer) AdminGetUser(ctx context.Context, req *pb.AdminGetUserRequest) (*pb.AdminGetUserResponse, error) {
+	return s.admin.AdminGetUser(ctx, req)
+}
+
+func (s *simpleBankServer) AdminListUserAccounts(ctx context.Context, req *pb.AdminListUserAccountsRequest) (*pb.AdminListUserAccountsResponse, error) {
+	return s.admin.AdminListUserAccounts(ctx, req)
+}
+
+func (s *simpleBankServer) AdminGetAccount(ctx context.Context, req *pb.AdminGetAccountRequest) (*pb.AdminGetAccountResponse, error) {
+	return s.admin.AdminGetAccount(ctx, req)
+}
+
+func (s *simpleBankServer) AdminChangeAccountStatus(ctx context.Context, req *pb.AdminChangeAccountStatusRequest) (*pb.AdminChangeAccountStatusResponse, error) {
+	return s.admin.AdminChangeAccountStatus(ctx, req)
+}
+
+func (s *simpleBankServer) AdminListStatusChanges(ctx context.Context, req *pb.AdminListStatusChangesRequest) (*pb.AdminListStatusChangesResponse, error) {
+	return s.admin.AdminListStatusChanges(ctx, req)
+}
+
+func (s *simpleBankServer) AdminGetTransferLimits(ctx context.Context, req *pb.AdminGetTransferLimitsRequest) (*pb.AdminGetTransferLimitsResponse, error) {
+	return s.admin.AdminGetTransferLimits(ctx, req)
+}
+
+func (s *simpleBankServer) AdminSetTransferLimits(ctx context.Context, req *pb.AdminSetTransferLimitsRequest) (*pb.AdminSetTransferLimitsResponse, error) {
+	return s.admin.AdminSetTransferLimits(ctx, req)
+}
+
+func (s *simpleBankServer) AdminSetOverdraftLimit(ctx context.Context, req *pb.AdminSetOverdraftLimitRequest) (*pb.AdminSetOverdraftLimitResponse, error) {
+	return s.admin.AdminSetOverdraftLimit(ctx, req)
+}
+
+func (s *simpleBankServer) AdminListEntries(ctx context.Context, req *pb.AdminListEntriesRequest) (*pb.AdminListEntriesResponse, error) {
+	return s.admin.AdminListEntries(ctx, req)
+}
+
+func (s *simpleBankServer) AdminGetEntry(ctx context.Context, req *pb.AdminGetEntryRequest) (*pb.AdminGetEntryResponse, error) {
+	return s.admin.AdminGetEntry(ctx, req)
+}
+
+func (s *simpleBankServer) AdminListTransfers(ctx context.Context, req *pb.AdminListTransfersRequest) (*pb.AdminListTransfersResponse, error) {
+	return s.admin.AdminListTransfers(ctx, req)
+}
+
+func (s *simpleBankServer) AdminGetTransfer(ctx context.Context, req *pb.AdminGetTransferRequest) (*pb.AdminGetTransferResponse, error) {
+	return s.admin.AdminGetTransfer(ctx, req)
+}
@@ -5,11 +5,18 @@ import (
 	accounthandler "github.com/codepnw/simple-bank/internal/features/account/handler"
 	accountrepository "github.com/codepnw/simple-bank/internal/features/account/repository"
 	accountusecase "github.com/codepnw/simple-bank/internal/features/account/usecase"
+	entryrepository "github.com/codepnw/simple-bank/internal/features/entry/repository"
+	feerepository "github.com/codepnw/simple-bank/internal/features/fee/repository"
+	ledgerrepository "github.com/codepnw/simple-bank/internal/features/ledger/repository"
+	ledgerusecase "github.com/codepnw/simple-bank/internal/features/ledger/usecase"
+	"github.com/codepnw/simple-bank/internal/features/user"
 )
 
 func (cfg *routesConfig) registerAccountRoutes() {
 	repo := accountrepository.NewAccountRepository(cfg.db)
-	uc := accountusecase.NewAccountUsecase(repo)
+	entRepo := entryrepository.NewEntryRepository(cfg.db)
+	ledgerUC := ledgerusecase.NewLedgerUsecase(ledgerrepository.NewLedgerRepository(cfg.db), repo, entRepo)
+	uc := accountusecase.NewAccountUsecase(repo, entRepo, feerepository.NewFeeRepository(cfg.db), ledgerUC, cfg.tx, cfg.cur)
 	handler := accounthandler.NewAccountHandler(uc)
 
 	r := cfg.router.Group(cfg.prefix+"/accounts", cfg.mid.Authorized())
@@ -17,5 +24,10 @@ func (cfg *routesConfig) registerAccountRoutes() {
 		r.POST("", handler.CreateAccount)
 		r.GET("/:"+consts.ParamAccountID, handler.GetAccount)
 		r.GET("", handler.ListAccounts)
+		r.POST("/:"+consts.ParamAccountID+"/deposits", cfg.mid.RequireRole(user.RoleAdmin), handler.Deposit)
+		r.POST("/:"+consts.ParamAccountID+"/withdrawals", handler.Withdraw)
+		r.POST("/:"+consts.ParamAccountID+"/withdrawals/quote", handler.QuoteWithdraw)
+		r.GET("/:"+consts.ParamAccountID+"/entries", handler.ListEntries)
+		r.GET("/:"+consts.ParamAccountID+"/statement", handler.Statement)
 	}
 }
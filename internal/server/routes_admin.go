@@ -0,0 +1,39 @@
+package server
+
+import (
+	"github.com/codepnw/simple-bank/internal/consts"
+	accountrepository "github.com/codepnw/simple-bank/internal/features/account/repository"
+	adminhandler "github.com/codepnw/simple-bank/internal/features/admin/handler"
+	adminusecase "github.com/codepnw/simple-bank/internal/features/admin/usecase"
+	entryrepository "github.com/codepnw/simple-bank/internal/features/entry/repository"
+	transferrepository "github.com/codepnw/simple-bank/internal/features/transfer/repository"
+	"github.com/codepnw/simple-bank/internal/features/user"
+	userrepository "github.com/codepnw/simple-bank/internal/features/user/repository"
+)
+
+func (cfg *routesConfig) registerAdminRoutes() {
+	userRepo := userrepository.NewUserRepository(cfg.db, cfg.hasher)
+	accRepo := accountrepository.NewAccountRepository(cfg.db)
+	entRepo := entryrepository.NewEntryRepository(cfg.db)
+	tranRepo := transferrepository.NewTransferRepository(cfg.db)
+
+	uc := adminusecase.NewAdminUsecase(userRepo, accRepo, entRepo, tranRepo, cfg.tx, cfg.cur)
+	handler := adminhandler.NewAdminHandler(uc)
+
+	r := cfg.router.Group(cfg.prefix+"/admin", cfg.mid.Authorized(), cfg.mid.RequireRole(user.RoleAdmin))
+	{
+		r.GET("/users", handler.FindUser)
+		r.GET("/users/:"+consts.ParamUserID, handler.GetUser)
+		r.GET("/users/:"+consts.ParamUserID+"/accounts", handler.ListUserAccounts)
+		r.GET("/accounts/:"+consts.ParamAccountID, handler.GetAccount)
+		r.PATCH("/accounts/:"+consts.ParamAccountID+"/status", handler.ChangeAccountStatus)
+		r.GET("/accounts/:"+consts.ParamAccountID+"/status-changes", handler.ListStatusChanges)
+		r.GET("/accounts/:"+consts.ParamAccountID+"/limits", handler.GetTransferLimits)
+		r.PUT("/accounts/:"+consts.ParamAccountID+"/limits", handler.SetTransferLimits)
+		r.PUT("/accounts/:"+consts.ParamAccountID+"/overdraft", handler.SetOverdraftLimit)
+		r.GET("/accounts/:"+consts.ParamAccountID+"/entries", handler.ListEntries)
+		r.GET("/entries/:"+consts.ParamEntryID, handler.GetEntry)
+		r.GET("/transfers", handler.ListTransfers)
+		r.GET("/transfers/:"+consts.ParamTransferID, handler.GetTransfer)
+	}
+}
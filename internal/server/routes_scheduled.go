@@ -0,0 +1,37 @@
+package server
+
+import (
+	"github.com/codepnw/simple-bank/internal/consts"
+	accountrepository "github.com/codepnw/simple-bank/internal/features/account/repository"
+	entryrepository "github.com/codepnw/simple-bank/internal/features/entry/repository"
+	feerepository "github.com/codepnw/simple-bank/internal/features/fee/repository"
+	ledgerrepository "github.com/codepnw/simple-bank/internal/features/ledger/repository"
+	ledgerusecase "github.com/codepnw/simple-bank/internal/features/ledger/usecase"
+	scheduledhandler "github.com/codepnw/simple-bank/internal/features/scheduled/handler"
+	scheduledrepository "github.com/codepnw/simple-bank/internal/features/scheduled/repository"
+	scheduledusecase "github.com/codepnw/simple-bank/internal/features/scheduled/usecase"
+	transferrepository "github.com/codepnw/simple-bank/internal/features/transfer/repository"
+	transferusecase "github.com/codepnw/simple-bank/internal/features/transfer/usecase"
+)
+
+func (cfg *routesConfig) registerScheduledRoutes() {
+	schedRepo := scheduledrepository.NewScheduledRepository(cfg.db)
+	tranRepo := transferrepository.NewTransferRepository(cfg.db)
+	accRepo := accountrepository.NewAccountRepository(cfg.db)
+	entRepo := entryrepository.NewEntryRepository(cfg.db)
+	ledgerUC := ledgerusecase.NewLedgerUsecase(ledgerrepository.NewLedgerRepository(cfg.db), accRepo, entRepo)
+
+	tranUC := transferusecase.NewTransferUsecase(tranRepo, accRepo, feerepository.NewFeeRepository(cfg.db), ledgerUC, cfg.tx, cfg.fx, cfg.cur, cfg.holdTTL)
+	uc := scheduledusecase.NewScheduledUsecase(schedRepo, accRepo, tranUC, cfg.tx, cfg.cur, cfg.retry)
+	handler := scheduledhandler.NewScheduledHandler(uc)
+
+	r := cfg.router.Group(cfg.prefix+"/transfers/scheduled", cfg.mid.Authorized())
+	{
+		r.POST("", handler.Create)
+		r.GET("", handler.List)
+		r.GET("/:"+consts.ParamScheduledID, handler.Get)
+		r.PATCH("/:"+consts.ParamScheduledID, handler.Update)
+		r.DELETE("/:"+consts.ParamScheduledID, handler.Cancel)
+		r.GET("/:"+consts.ParamScheduledID+"/runs", handler.ListRuns)
+	}
+}
@@ -1,8 +1,12 @@
 package server
 
 import (
+	"github.com/codepnw/simple-bank/internal/consts"
 	accountrepository "github.com/codepnw/simple-bank/internal/features/account/repository"
 	entryrepository "github.com/codepnw/simple-bank/internal/features/entry/repository"
+	feerepository "github.com/codepnw/simple-bank/internal/features/fee/repository"
+	ledgerrepository "github.com/codepnw/simple-bank/internal/features/ledger/repository"
+	ledgerusecase "github.com/codepnw/simple-bank/internal/features/ledger/usecase"
 	transferhandler "github.com/codepnw/simple-bank/internal/features/transfer/handler"
 	transferrepository "github.com/codepnw/simple-bank/internal/features/transfer/repository"
 	transferusecase "github.com/codepnw/simple-bank/internal/features/transfer/usecase"
@@ -12,12 +16,23 @@ func (cfg *routesConfig) registerTransferRoutes() {
 	tranRepo := transferrepository.NewTransferRepository(cfg.db)
 	accRepo := accountrepository.NewAccountRepository(cfg.db)
 	entRepo := entryrepository.NewEntryRepository(cfg.db)
+	ledgerUC := ledgerusecase.NewLedgerUsecase(ledgerrepository.NewLedgerRepository(cfg.db), accRepo, entRepo)
 
-	uc := transferusecase.NewTransferUsecase(tranRepo, accRepo, entRepo, cfg.tx)
+	uc := transferusecase.NewTransferUsecase(tranRepo, accRepo, feerepository.NewFeeRepository(cfg.db), ledgerUC, cfg.tx, cfg.fx, cfg.cur, cfg.holdTTL)
 	handler := transferhandler.NewTransferHandler(uc)
 
 	r := cfg.router.Group(cfg.prefix+"/transfers", cfg.mid.Authorized())
 	{
 		r.POST("", handler.CreateTransfer)
+		r.GET("", handler.ListTransfers)
+		r.POST("/quote", handler.QuoteTransfer)
+		r.GET("/:"+consts.ParamTransferID, handler.GetTransfer)
+		r.POST("/:"+consts.ParamTransferID+"/reverse", handler.ReverseTransfer)
+
+		r.POST("/holds", handler.AuthorizeHold)
+		r.GET("/holds", handler.ListHolds)
+		r.GET("/holds/:"+consts.ParamHoldID, handler.GetHold)
+		r.POST("/holds/:"+consts.ParamHoldID+"/capture", handler.CaptureHold)
+		r.POST("/holds/:"+consts.ParamHoldID+"/void", handler.VoidHold)
 	}
 }
@@ -1,14 +1,15 @@
 package server
 
 import (
+	"github.com/codepnw/simple-bank/internal/consts"
 	userhandler "github.com/codepnw/simple-bank/internal/features/user/handler"
 	userrepository "github.com/codepnw/simple-bank/internal/features/user/repository"
 	userusecase "github.com/codepnw/simple-bank/internal/features/user/usecase"
 )
 
 func (cfg *routesConfig) registerUserRoutes() {
-	repo := userrepository.NewUserRepository(cfg.db)
-	uc := userusecase.NewUserUsecase(repo, cfg.token, cfg.tx)
+	repo := userrepository.NewUserRepository(cfg.db, cfg.hasher)
+	uc := userusecase.NewUserUsecase(repo, cfg.token, cfg.tx, cfg.deny)
 	handler := userhandler.NewUserHandler(uc)
 
 	auth := cfg.router.Group(cfg.prefix + "/auth")
@@ -23,5 +24,8 @@ func (cfg *routesConfig) registerUserRoutes() {
 		// Private
 		usr.POST("/refresh-token", cfg.mid.Authorized(), handler.RefreshToken)
 		usr.POST("/logout", cfg.mid.Authorized(), handler.Logout)
+		usr.GET("/sessions", cfg.mid.Authorized(), handler.ListSessions)
+		usr.DELETE("/sessions", cfg.mid.Authorized(), handler.LogoutAll)
+		usr.DELETE("/sessions/:"+consts.ParamSessionID, cfg.mid.Authorized(), handler.RevokeSession)
 	}
 }
@@ -0,0 +1,149 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	accountrepository "github.com/codepnw/simple-bank/internal/features/account/repository"
+	entryrepository "github.com/codepnw/simple-bank/internal/features/entry/repository"
+	feerepository "github.com/codepnw/simple-bank/internal/features/fee/repository"
+	"github.com/codepnw/simple-bank/internal/features/interest"
+	interestrepository "github.com/codepnw/simple-bank/internal/features/interest/repository"
+	interestusecase "github.com/codepnw/simple-bank/internal/features/interest/usecase"
+	ledgerrepository "github.com/codepnw/simple-bank/internal/features/ledger/repository"
+	ledgerusecase "github.com/codepnw/simple-bank/internal/features/ledger/usecase"
+	"github.com/codepnw/simple-bank/internal/features/scheduled"
+	scheduledrepository "github.com/codepnw/simple-bank/internal/features/scheduled/repository"
+	scheduledusecase "github.com/codepnw/simple-bank/internal/features/scheduled/usecase"
+	transferrepository "github.com/codepnw/simple-bank/internal/features/transfer/repository"
+	transferusecase "github.com/codepnw/simple-bank/internal/features/transfer/usecase"
+	"github.com/codepnw/simple-bank/pkg/config"
+	"github.com/codepnw/simple-bank/pkg/currency"
+	"github.com/codepnw/simple-bank/pkg/database"
+	"github.com/codepnw/simple-bank/pkg/fx"
+)
+
+// RunScheduler executes due scheduled transfers every SCHEDULER_INTERVAL.
+// Claims skip rows locked by another instance, so it is safe to run the
+// worker on every node.
+func RunScheduler(cfg *config.EnvConfig, db *sql.DB, tx database.TxManager, fxProvider fx.FXRateProvider, currencies *currency.Registry) error {
+	tranRepo := transferrepository.NewTransferRepository(db)
+	accRepo := accountrepository.NewAccountRepository(db)
+	entRepo := entryrepository.NewEntryRepository(db)
+	ledgerUC := ledgerusecase.NewLedgerUsecase(ledgerrepository.NewLedgerRepository(db), accRepo, entRepo)
+
+	tranUC := transferusecase.NewTransferUsecase(tranRepo, accRepo, feerepository.NewFeeRepository(db), ledgerUC, tx, fxProvider, currencies, cfg.Hold.TTL)
+	uc := scheduledusecase.NewScheduledUsecase(scheduledrepository.NewScheduledRepository(db), accRepo, tranUC, tx, currencies, retryPolicy(&cfg.Scheduler))
+
+	ticker := time.NewTicker(cfg.Scheduler.Interval)
+	defer ticker.Stop()
+
+	log.Printf("scheduler running every %s", cfg.Scheduler.Interval)
+	for range ticker.C {
+		drain("scheduled transfers", cfg.Scheduler.BatchSize, uc.RunDue)
+	}
+	return nil
+}
+
+// RunHoldExpiry releases expired holds every HOLD_EXPIRY_INTERVAL. It runs
+// on every node regardless of the scheduler, since claims skip holds locked
+// by another instance.
+func RunHoldExpiry(cfg *config.EnvConfig, db *sql.DB, tx database.TxManager, fxProvider fx.FXRateProvider, currencies *currency.Registry) error {
+	accRepo := accountrepository.NewAccountRepository(db)
+	ledgerUC := ledgerusecase.NewLedgerUsecase(ledgerrepository.NewLedgerRepository(db), accRepo, entryrepository.NewEntryRepository(db))
+	uc := transferusecase.NewTransferUsecase(transferrepository.NewTransferRepository(db), accRepo, feerepository.NewFeeRepository(db), ledgerUC, tx, fxProvider, currencies, cfg.Hold.TTL)
+
+	ticker := time.NewTicker(cfg.Hold.ExpiryInterval)
+	defer ticker.Stop()
+
+	log.Printf("hold expiry running every %s", cfg.Hold.ExpiryInterval)
+	for range ticker.C {
+		drain("hold expiry", cfg.Hold.BatchSize, uc.ExpireHolds)
+	}
+	return nil
+}
+
+// RunReconciler checks balances against entries and transfers against their
+// journals every RECONCILE_INTERVAL and logs what disagrees. It never
+// corrects; that is left to an admin running cmd/reconcile.
+func RunReconciler(cfg *config.EnvConfig, db *sql.DB, tx database.TxManager) error {
+	uc := ledgerusecase.NewReconcileUsecase(
+		ledgerrepository.NewLedgerRepository(db),
+		accountrepository.NewAccountRepository(db),
+		entryrepository.NewEntryRepository(db),
+		tx,
+	)
+
+	ticker := time.NewTicker(cfg.Reconcile.Interval)
+	defer ticker.Stop()
+
+	log.Printf("reconciler running every %s", cfg.Reconcile.Interval)
+	for range ticker.C {
+		report, err := uc.Reconcile(context.Background())
+		if err != nil {
+			log.Printf("reconciler: %v", err)
+			continue
+		}
+		if report.Clean() {
+			continue
+		}
+		for _, b := range report.Balances {
+			log.Printf("reconciler: account %d (%s) balance %d, entries %d, diff %d", b.AccountID, b.Currency, b.Balance, b.EntriesTotal, b.Diff)
+		}
+		for _, t := range report.Transfers {
+			log.Printf("reconciler: transfer %d debited %d of %d, credited %d of %d", t.TransferID, -t.Debited, t.Amount+t.Fee, t.Credited, t.ToAmount)
+		}
+	}
+	return nil
+}
+
+// RunInterest accrues yesterday's interest and pays out every accrual from
+// before this month every INTEREST_INTERVAL. Both steps are idempotent, so
+// running them on every tick and every node only does the work once.
+func RunInterest(cfg *config.EnvConfig, db *sql.DB, tx database.TxManager) error {
+	accRepo := accountrepository.NewAccountRepository(db)
+	ledgerUC := ledgerusecase.NewLedgerUsecase(ledgerrepository.NewLedgerRepository(db), accRepo, entryrepository.NewEntryRepository(db))
+	uc := interestusecase.NewInterestUsecase(interestrepository.NewInterestRepository(db), accRepo, ledgerUC, tx)
+
+	ticker := time.NewTicker(cfg.Interest.Interval)
+	defer ticker.Stop()
+
+	log.Printf("interest running every %s", cfg.Interest.Interval)
+	for range ticker.C {
+		today := interest.Day(time.Now())
+		// Catch up missed days, oldest first
+		for d := cfg.Interest.CatchUpDays; d >= 1; d-- {
+			day := today.AddDate(0, 0, -d)
+			drain("interest accrual", cfg.Interest.BatchSize, func(ctx context.Context, limit int) (int, error) {
+				return uc.Accrue(ctx, day, limit)
+			})
+		}
+		drain("interest posting", cfg.Interest.BatchSize, func(ctx context.Context, limit int) (int, error) {
+			return uc.Post(ctx, interest.MonthStart(today), limit)
+		})
+	}
+	return nil
+}
+
+// drain runs a job batch by batch until a short batch shows nothing is left.
+func drain(name string, batchSize int, job func(ctx context.Context, limit int) (int, error)) {
+	for {
+		n, err := job(context.Background(), batchSize)
+		if err != nil {
+			log.Printf("scheduler: %s: %v", name, err)
+			return
+		}
+		if n < batchSize {
+			return
+		}
+	}
+}
+
+func retryPolicy(cfg *config.SchedulerConfig) scheduled.RetryPolicy {
+	return scheduled.RetryPolicy{
+		MaxAttempts: cfg.MaxAttempts,
+		Delay:       cfg.RetryDelay,
+	}
+}
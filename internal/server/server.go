@@ -3,28 +3,47 @@ package server
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"net"
 	"strings"
+	"time"
 
+	"github.com/codepnw/simple-bank/internal/consts"
+	accountgrpc "github.com/codepnw/simple-bank/internal/features/account/grpc"
 	accountrepository "github.com/codepnw/simple-bank/internal/features/account/repository"
+	accountusecase "github.com/codepnw/simple-bank/internal/features/account/usecase"
+	admingrpc "github.com/codepnw/simple-bank/internal/features/admin/grpc"
+	adminusecase "github.com/codepnw/simple-bank/internal/features/admin/usecase"
 	entryrepository "github.com/codepnw/simple-bank/internal/features/entry/repository"
+	feerepository "github.com/codepnw/simple-bank/internal/features/fee/repository"
+	ledgerrepository "github.com/codepnw/simple-bank/internal/features/ledger/repository"
+	ledgerusecase "github.com/codepnw/simple-bank/internal/features/ledger/usecase"
+	"github.com/codepnw/simple-bank/internal/features/scheduled"
 	transfergrpc "github.com/codepnw/simple-bank/internal/features/transfer/grpc"
 	transferrepository "github.com/codepnw/simple-bank/internal/features/transfer/repository"
 	transferusecase "github.com/codepnw/simple-bank/internal/features/transfer/usecase"
+	"github.com/codepnw/simple-bank/internal/features/user"
+	usergrpc "github.com/codepnw/simple-bank/internal/features/user/grpc"
+	userrepository "github.com/codepnw/simple-bank/internal/features/user/repository"
+	userusecase "github.com/codepnw/simple-bank/internal/features/user/usecase"
 	"github.com/codepnw/simple-bank/internal/middleware"
 	pb "github.com/codepnw/simple-bank/pb/proto"
 	"github.com/codepnw/simple-bank/pkg/auth"
 	"github.com/codepnw/simple-bank/pkg/config"
+	"github.com/codepnw/simple-bank/pkg/currency"
 	"github.com/codepnw/simple-bank/pkg/database"
+	"github.com/codepnw/simple-bank/pkg/fx"
 	"github.com/codepnw/simple-bank/pkg/token"
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
 	"github.com/codepnw/simple-bank/pkg/utils/response"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 
@@ -34,12 +53,18 @@ import (
 )
 
 type routesConfig struct {
-	db     *sql.DB
-	router *gin.Engine
-	prefix string
-	token  token.TokenMaker
-	tx     database.TxManager
-	mid    *middleware.AuthMiddleware
+	db      *sql.DB
+	router  *gin.Engine
+	prefix  string
+	token   token.TokenMaker
+	hasher  *token.Hasher
+	deny    token.Denylist
+	tx      database.TxManager
+	fx      fx.FXRateProvider
+	cur     *currency.Registry
+	retry   scheduled.RetryPolicy
+	holdTTL time.Duration
+	mid     *middleware.AuthMiddleware
 }
 
 func setupRouter() *gin.Engine {
@@ -49,11 +74,12 @@ func setupRouter() *gin.Engine {
 
 	r.Use(gin.Logger())
 	r.Use(gin.Recovery())
+	r.Use(middleware.ClientInfo())
 
 	corsConfig := cors.DefaultConfig()
 	corsConfig.AllowAllOrigins = true
 	corsConfig.AllowMethods = []string{"POST", "GET", "PUT", "OPTIONS", "DELETE", "PATCH"}
-	corsConfig.AllowHeaders = []string{"Origin", "Content-Length", "Content-Type", "Authorization", "X-Requested-With"}
+	corsConfig.AllowHeaders = []string{"Origin", "Content-Length", "Content-Type", "Authorization", "X-Requested-With", consts.HeaderIdempotencyKey}
 	corsConfig.ExposeHeaders = []string{"Content-Length"}
 	corsConfig.AllowCredentials = true
 
@@ -68,25 +94,33 @@ func setupRouter() *gin.Engine {
 	return r
 }
 
-func RunHTTPServer(cfg *config.EnvConfig, db *sql.DB, tx database.TxManager, token token.TokenMaker) error {
+func RunHTTPServer(cfg *config.EnvConfig, db *sql.DB, tx database.TxManager, token token.TokenMaker, hasher *token.Hasher, denylist token.Denylist, fxProvider fx.FXRateProvider, currencies *currency.Registry) error {
 	// New Middleware
-	mid := middleware.NewMiddleware(token)
+	mid := middleware.NewMiddleware(token, denylist)
 
 	// Setup Router
 	router := setupRouter()
 
 	// Config Routes
 	routes := &routesConfig{
-		router: router,
-		prefix: cfg.Server.HTTPPrefix,
-		token:  token,
-		db:     db,
-		tx:     tx,
-		mid:    mid,
+		router:  router,
+		prefix:  cfg.Server.HTTPPrefix,
+		token:   token,
+		hasher:  hasher,
+		deny:    denylist,
+		db:      db,
+		tx:      tx,
+		fx:      fxProvider,
+		cur:     currencies,
+		retry:   retryPolicy(&cfg.Scheduler),
+		holdTTL: cfg.Hold.TTL,
+		mid:     mid,
 	}
 	routes.registerUserRoutes()
 	routes.registerAccountRoutes()
 	routes.registerTransferRoutes()
+	routes.registerScheduledRoutes()
+	routes.registerAdminRoutes()
 
 	addr := cfg.Server.HTTPAddr
 	log.Printf("start HTTP docs at %s/swagger/index.html", addr)
@@ -96,17 +130,32 @@ func RunHTTPServer(cfg *config.EnvConfig, db *sql.DB, tx database.TxManager, tok
 
 // ================ gRPC Server ====================
 
-func RunGrpcServer(cfg *config.EnvConfig, db *sql.DB, tx database.TxManager, token token.TokenMaker) error {
+func RunGrpcServer(cfg *config.EnvConfig, db *sql.DB, tx database.TxManager, token token.TokenMaker, hasher *token.Hasher, denylist token.Denylist, fxProvider fx.FXRateProvider, currencies *currency.Registry) error {
 	tranRepo := transferrepository.NewTransferRepository(db)
 	accRepo := accountrepository.NewAccountRepository(db)
 	entRepo := entryrepository.NewEntryRepository(db)
+	feeRepo := feerepository.NewFeeRepository(db)
+	ledgerUc := ledgerusecase.NewLedgerUsecase(ledgerrepository.NewLedgerRepository(db), accRepo, entRepo)
+	userRepo := userrepository.NewUserRepository(db, hasher)
 
-	uc := transferusecase.NewTransferUsecase(tranRepo, accRepo, entRepo, tx)
+	tranUc := transferusecase.NewTransferUsecase(tranRepo, accRepo, feeRepo, ledgerUc, tx, fxProvider, currencies, cfg.Hold.TTL)
+	accUc := accountusecase.NewAccountUsecase(accRepo, entRepo, feeRepo, ledgerUc, tx, currencies)
+	userUc := userusecase.NewUserUsecase(userRepo, token, tx, denylist)
+	adminUc := adminusecase.NewAdminUsecase(userRepo, accRepo, entRepo, tranRepo, tx, currencies)
 
-	server := transfergrpc.NewTransferServer(uc)
+	server := &simpleBankServer{
+		transfer: transfergrpc.NewTransferServer(tranUc),
+		account:  accountgrpc.NewAccountServer(accUc),
+		user:     usergrpc.NewUserServer(userUc),
+		admin:    admingrpc.NewAdminServer(adminUc),
+	}
 
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(unaryServerInterceptor(token)),
+		grpc.ChainUnaryInterceptor(
+			errorInterceptor,
+			clientInterceptor,
+			unaryServerInterceptor(token, denylist),
+		),
 	)
 
 	pb.RegisterSimpleBankServer(grpcServer, server)
@@ -125,35 +174,128 @@ func RunGrpcServer(cfg *config.EnvConfig, db *sql.DB, tx database.TxManager, tok
 	return nil
 }
 
-func unaryServerInterceptor(token token.TokenMaker) grpc.UnaryServerInterceptor {
+// publicMethods are served without an access token, like the REST /auth
+// group.
+var publicMethods = map[string]bool{
+	pb.SimpleBank_Register_FullMethodName: true,
+	pb.SimpleBank_Login_FullMethodName:    true,
+}
+
+// methodRoles restricts methods to the listed roles, like RequireRole on the
+// REST /admin group. Methods not listed are open to any signed-in user.
+var methodRoles = map[string][]user.Role{
+	pb.SimpleBank_Deposit_FullMethodName:                  {user.RoleAdmin},
+	pb.SimpleBank_AdminFindUser_FullMethodName:            {user.RoleAdmin},
+	pb.SimpleBank_AdminGetUser_FullMethodName:             {user.RoleAdmin},
+	pb.SimpleBank_AdminListUserAccounts_FullMethodName:    {user.RoleAdmin},
+	pb.SimpleBank_AdminGetAccount_FullMethodName:          {user.RoleAdmin},
+	pb.SimpleBank_AdminChangeAccountStatus_FullMethodName: {user.RoleAdmin},
+	pb.SimpleBank_AdminListStatusChanges_FullMethodName:   {user.RoleAdmin},
+	pb.SimpleBank_AdminGetTransferLimits_FullMethodName:   {user.RoleAdmin},
+	pb.SimpleBank_AdminSetTransferLimits_FullMethodName:   {user.RoleAdmin},
+	pb.SimpleBank_AdminSetOverdraftLimit_FullMethodName:   {user.RoleAdmin},
+	pb.SimpleBank_AdminListEntries_FullMethodName:         {user.RoleAdmin},
+	pb.SimpleBank_AdminGetEntry_FullMethodName:            {user.RoleAdmin},
+	pb.SimpleBank_AdminListTransfers_FullMethodName:       {user.RoleAdmin},
+	pb.SimpleBank_AdminGetTransfer_FullMethodName:         {user.RoleAdmin},
+}
+
+func unaryServerInterceptor(token token.TokenMaker, denylist token.Denylist) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		if publicMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
 		md, ok := metadata.FromIncomingContext(ctx)
 		if !ok {
-			return nil, status.Errorf(codes.Unauthenticated, "metadata is not provided")
+			return nil, errs.ErrMissingAuthHeader
 		}
 
 		values := md.Get("authorization")
 		if len(values) == 0 {
-			return nil, status.Errorf(codes.Unauthenticated, "authorization token is not provided")
+			return nil, errs.ErrMissingAuthHeader
 		}
 
 		authHeader := values[0]
 		fields := strings.Fields(authHeader)
 		if len(fields) < 2 {
-			return nil, status.Errorf(codes.Unauthenticated, "invalid authorization header format")
+			return nil, errs.ErrInvalidAuthHeader
 		}
-		authType := strings.ToLower(fields[0])
-		if authType != "bearer" {
-			return nil, status.Errorf(codes.Unauthenticated, "unsupported authorization type: %s", authType)
+		if strings.ToLower(fields[0]) != "bearer" {
+			return nil, errs.ErrInvalidAuthHeader
 		}
 		accessToken := fields[1]
 
 		payload, err := token.VerifyAccessToken(accessToken)
 		if err != nil {
-			return nil, status.Errorf(codes.Unauthenticated, "access token is invalid: %v", err)
+			return nil, errs.ErrInvalidToken.Wrap(err)
+		}
+
+		denied, err := denylist.IsDenied(ctx, payload.ID)
+		if err != nil {
+			return nil, err
+		}
+		if denied {
+			return nil, errs.ErrTokenRevoked
 		}
 
 		ctx = auth.SetUserID(ctx, payload.UserID)
+		ctx = auth.SetRole(ctx, payload.Role)
+
+		if roles, ok := methodRoles[info.FullMethod]; ok && !auth.HasRole(ctx, roles...) {
+			return nil, errs.ErrNoPermission
+		}
 		return handler(ctx, req)
 	}
 }
+
+// clientInterceptor records the caller's user agent and IP for session
+// tracking. Calls proxied by the gateway carry the original HTTP values;
+// anyone else's x-forwarded-for is ignored and the peer address is used.
+func clientInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	var client auth.Client
+	if p, ok := peer.FromContext(ctx); ok {
+		client.IP = p.Addr.String()
+		if host, _, err := net.SplitHostPort(client.IP); err == nil {
+			client.IP = host
+		}
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get("grpcgateway-user-agent"); len(v) > 0 {
+			client.UserAgent = v[0]
+		} else if v := md.Get("user-agent"); len(v) > 0 {
+			client.UserAgent = v[0]
+		}
+		// Only the gateway's own hop, the last one it appends, can be
+		// trusted; anything before it came from the HTTP client
+		if v := md.Get("x-forwarded-for"); len(v) > 0 && fromGateway(md) {
+			hops := strings.Split(v[len(v)-1], ",")
+			client.IP = strings.TrimSpace(hops[len(hops)-1])
+		}
+	}
+	return handler(auth.SetClient(ctx, client), req)
+}
+
+// errorInterceptor maps every handler error onto the domain error model so
+// clients always get a stable code in ErrorInfo. Server errors are logged
+// with their cause and answered with a generic message.
+func errorInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	if err == nil {
+		return resp, nil
+	}
+
+	var e *errs.Error
+	if !errors.As(err, &e) {
+		// Statuses raised by grpc-go itself already carry a proper code.
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		e = errs.From(err)
+	}
+	if e.GRPCCode == codes.Internal {
+		log.Printf("%s failed: %v", info.FullMethod, err)
+	}
+	return nil, e
+}
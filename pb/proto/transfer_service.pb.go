@@ -7,6 +7,7 @@
 package pb
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -23,13 +24,14 @@ const (
 )
 
 type CreateTransferRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FromAccountId int64                  `protobuf:"varint,1,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"`
-	ToAccountId   int64                  `protobuf:"varint,2,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
-	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FromAccountId  int64                  `protobuf:"varint,1,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"`
+	ToAccountId    int64                  `protobuf:"varint,2,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
+	Amount         int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency       string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateTransferRequest) Reset() {
@@ -90,16 +92,31 @@ func (x *CreateTransferRequest) GetCurrency() string {
 	return ""
 }
 
+func (x *CreateTransferRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type Account struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	OwnerId       int64                  `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	Balance       int64                  `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`
-	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId          int64                  `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Balance          int64                  `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	Currency         string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Type             string                 `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`
+	BalanceDisplay   string                 `protobuf:"bytes,8,opt,name=balance_display,json=balanceDisplay,proto3" json:"balance_display,omitempty"`
+	Status           string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	HeldAmount       int64                  `protobuf:"varint,10,opt,name=held_amount,json=heldAmount,proto3" json:"held_amount,omitempty"`                   // reserved by open holds
+	AvailableBalance int64                  `protobuf:"varint,11,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"` // balance - held_amount + overdraft_limit
+	Product          string                 `protobuf:"bytes,12,opt,name=product,proto3" json:"product,omitempty"`                                            // current or savings
+	OverdraftLimit   int64                  `protobuf:"varint,13,opt,name=overdraft_limit,json=overdraftLimit,proto3" json:"overdraft_limit,omitempty"`       // how far below zero balance may go
+	OverdraftUsed    int64                  `protobuf:"varint,14,opt,name=overdraft_used,json=overdraftUsed,proto3" json:"overdraft_used,omitempty"`          // how far below zero balance is
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Account) Reset() {
@@ -174,6 +191,62 @@ func (x *Account) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Account) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Account) GetBalanceDisplay() string {
+	if x != nil {
+		return x.BalanceDisplay
+	}
+	return ""
+}
+
+func (x *Account) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Account) GetHeldAmount() int64 {
+	if x != nil {
+		return x.HeldAmount
+	}
+	return 0
+}
+
+func (x *Account) GetAvailableBalance() int64 {
+	if x != nil {
+		return x.AvailableBalance
+	}
+	return 0
+}
+
+func (x *Account) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
+}
+
+func (x *Account) GetOverdraftLimit() int64 {
+	if x != nil {
+		return x.OverdraftLimit
+	}
+	return 0
+}
+
+func (x *Account) GetOverdraftUsed() int64 {
+	if x != nil {
+		return x.OverdraftUsed
+	}
+	return 0
+}
+
 type Transfer struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -181,6 +254,11 @@ type Transfer struct {
 	ToAccountId   int64                  `protobuf:"varint,3,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
 	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ToAmount      int64                  `protobuf:"varint,6,opt,name=to_amount,json=toAmount,proto3" json:"to_amount,omitempty"`
+	ExchangeRate  string                 `protobuf:"bytes,7,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	ReversalOf    *int64                 `protobuf:"varint,8,opt,name=reversal_of,json=reversalOf,proto3,oneof" json:"reversal_of,omitempty"` // transfer this one reverses
+	ReversedBy    *int64                 `protobuf:"varint,9,opt,name=reversed_by,json=reversedBy,proto3,oneof" json:"reversed_by,omitempty"` // reversal of this transfer
+	Fee           int64                  `protobuf:"varint,10,opt,name=fee,proto3" json:"fee,omitempty"`                                      // charged on top of amount, source currency
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -250,12 +328,48 @@ func (x *Transfer) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Transfer) GetToAmount() int64 {
+	if x != nil {
+		return x.ToAmount
+	}
+	return 0
+}
+
+func (x *Transfer) GetExchangeRate() string {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return ""
+}
+
+func (x *Transfer) GetReversalOf() int64 {
+	if x != nil && x.ReversalOf != nil {
+		return *x.ReversalOf
+	}
+	return 0
+}
+
+func (x *Transfer) GetReversedBy() int64 {
+	if x != nil && x.ReversedBy != nil {
+		return *x.ReversedBy
+	}
+	return 0
+}
+
+func (x *Transfer) GetFee() int64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
 type Entry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	AccountId     int64                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
 	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	JournalId     int64                  `protobuf:"varint,5,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -318,6 +432,98 @@ func (x *Entry) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Entry) GetJournalId() int64 {
+	if x != nil {
+		return x.JournalId
+	}
+	return 0
+}
+
+// Fee breakdown: flat + percentage + adjustment = total
+type Fee struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        int64                  `protobuf:"varint,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Flat          int64                  `protobuf:"varint,3,opt,name=flat,proto3" json:"flat,omitempty"`
+	Percentage    int64                  `protobuf:"varint,4,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	Adjustment    int64                  `protobuf:"varint,5,opt,name=adjustment,proto3" json:"adjustment,omitempty"` // + raised to the minimum, - capped at the maximum
+	Total         int64                  `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Fee) Reset() {
+	*x = Fee{}
+	mi := &file_proto_transfer_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Fee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fee) ProtoMessage() {}
+
+func (x *Fee) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fee.ProtoReflect.Descriptor instead.
+func (*Fee) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Fee) GetRuleId() int64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *Fee) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Fee) GetFlat() int64 {
+	if x != nil {
+		return x.Flat
+	}
+	return 0
+}
+
+func (x *Fee) GetPercentage() int64 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+func (x *Fee) GetAdjustment() int64 {
+	if x != nil {
+		return x.Adjustment
+	}
+	return 0
+}
+
+func (x *Fee) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 type CreateTransferResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Transfer      *Transfer              `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
@@ -325,13 +531,15 @@ type CreateTransferResponse struct {
 	ToAccount     *Account               `protobuf:"bytes,3,opt,name=to_account,json=toAccount,proto3" json:"to_account,omitempty"`
 	FromEntry     *Entry                 `protobuf:"bytes,4,opt,name=from_entry,json=fromEntry,proto3" json:"from_entry,omitempty"`
 	ToEntry       *Entry                 `protobuf:"bytes,5,opt,name=to_entry,json=toEntry,proto3" json:"to_entry,omitempty"`
+	Fee           *Fee                   `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee,omitempty"`
+	FeeEntry      *Entry                 `protobuf:"bytes,7,opt,name=fee_entry,json=feeEntry,proto3" json:"fee_entry,omitempty"` // sender's fee debit; unset without a fee
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateTransferResponse) Reset() {
 	*x = CreateTransferResponse{}
-	mi := &file_proto_transfer_service_proto_msgTypes[4]
+	mi := &file_proto_transfer_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -343,7 +551,7 @@ func (x *CreateTransferResponse) String() string {
 func (*CreateTransferResponse) ProtoMessage() {}
 
 func (x *CreateTransferResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_transfer_service_proto_msgTypes[4]
+	mi := &file_proto_transfer_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -356,7 +564,7 @@ func (x *CreateTransferResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTransferResponse.ProtoReflect.Descriptor instead.
 func (*CreateTransferResponse) Descriptor() ([]byte, []int) {
-	return file_proto_transfer_service_proto_rawDescGZIP(), []int{4}
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateTransferResponse) GetTransfer() *Transfer {
@@ -394,50 +602,3081 @@ func (x *CreateTransferResponse) GetToEntry() *Entry {
 	return nil
 }
 
-var File_proto_transfer_service_proto protoreflect.FileDescriptor
+func (x *CreateTransferResponse) GetFee() *Fee {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
 
-const file_proto_transfer_service_proto_rawDesc = "" +
-	"\n" +
-	"\x1cproto/transfer_service.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\x97\x01\n" +
-	"\x15CreateTransferRequest\x12&\n" +
-	"\x0ffrom_account_id\x18\x01 \x01(\x03R\rfromAccountId\x12\"\n" +
-	"\rto_account_id\x18\x02 \x01(\x03R\vtoAccountId\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x1a\n" +
-	"\bcurrency\x18\x04 \x01(\tR\bcurrency\"\xe0\x01\n" +
-	"\aAccount\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
-	"\bowner_id\x18\x02 \x01(\x03R\aownerId\x12\x18\n" +
-	"\abalance\x18\x03 \x01(\x03R\abalance\x12\x1a\n" +
-	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x129\n" +
-	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb9\x01\n" +
-	"\bTransfer\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12&\n" +
-	"\x0ffrom_account_id\x18\x02 \x01(\x03R\rfromAccountId\x12\"\n" +
-	"\rto_account_id\x18\x03 \x01(\x03R\vtoAccountId\x12\x16\n" +
-	"\x06amount\x18\x04 \x01(\x03R\x06amount\x129\n" +
-	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x89\x01\n" +
-	"\x05Entry\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
-	"\n" +
-	"account_id\x18\x02 \x01(\x03R\taccountId\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\x03R\x06amount\x129\n" +
-	"\n" +
-	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xee\x01\n" +
-	"\x16CreateTransferResponse\x12(\n" +
-	"\btransfer\x18\x01 \x01(\v2\f.pb.TransferR\btransfer\x12.\n" +
-	"\ffrom_account\x18\x02 \x01(\v2\v.pb.AccountR\vfromAccount\x12*\n" +
-	"\n" +
-	"to_account\x18\x03 \x01(\v2\v.pb.AccountR\ttoAccount\x12(\n" +
-	"\n" +
-	"from_entry\x18\x04 \x01(\v2\t.pb.EntryR\tfromEntry\x12$\n" +
-	"\bto_entry\x18\x05 \x01(\v2\t.pb.EntryR\atoEntry2W\n" +
+func (x *CreateTransferResponse) GetFeeEntry() *Entry {
+	if x != nil {
+		return x.FeeEntry
+	}
+	return nil
+}
+
+type QuoteTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromAccountId int64                  `protobuf:"varint,1,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"`
+	ToAccountId   int64                  `protobuf:"varint,2,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteTransferRequest) Reset() {
+	*x = QuoteTransferRequest{}
+	mi := &file_proto_transfer_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteTransferRequest) ProtoMessage() {}
+
+func (x *QuoteTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteTransferRequest.ProtoReflect.Descriptor instead.
+func (*QuoteTransferRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QuoteTransferRequest) GetFromAccountId() int64 {
+	if x != nil {
+		return x.FromAccountId
+	}
+	return 0
+}
+
+func (x *QuoteTransferRequest) GetToAccountId() int64 {
+	if x != nil {
+		return x.ToAccountId
+	}
+	return 0
+}
+
+func (x *QuoteTransferRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *QuoteTransferRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type QuoteTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromAccountId int64                  `protobuf:"varint,1,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"`
+	ToAccountId   int64                  `protobuf:"varint,2,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Fee           *Fee                   `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	TotalDebit    int64                  `protobuf:"varint,6,opt,name=total_debit,json=totalDebit,proto3" json:"total_debit,omitempty"` // amount + fee
+	ToAmount      int64                  `protobuf:"varint,7,opt,name=to_amount,json=toAmount,proto3" json:"to_amount,omitempty"`
+	ToCurrency    string                 `protobuf:"bytes,8,opt,name=to_currency,json=toCurrency,proto3" json:"to_currency,omitempty"`
+	ExchangeRate  string                 `protobuf:"bytes,9,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteTransferResponse) Reset() {
+	*x = QuoteTransferResponse{}
+	mi := &file_proto_transfer_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteTransferResponse) ProtoMessage() {}
+
+func (x *QuoteTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteTransferResponse.ProtoReflect.Descriptor instead.
+func (*QuoteTransferResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QuoteTransferResponse) GetFromAccountId() int64 {
+	if x != nil {
+		return x.FromAccountId
+	}
+	return 0
+}
+
+func (x *QuoteTransferResponse) GetToAccountId() int64 {
+	if x != nil {
+		return x.ToAccountId
+	}
+	return 0
+}
+
+func (x *QuoteTransferResponse) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *QuoteTransferResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *QuoteTransferResponse) GetFee() *Fee {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *QuoteTransferResponse) GetTotalDebit() int64 {
+	if x != nil {
+		return x.TotalDebit
+	}
+	return 0
+}
+
+func (x *QuoteTransferResponse) GetToAmount() int64 {
+	if x != nil {
+		return x.ToAmount
+	}
+	return 0
+}
+
+func (x *QuoteTransferResponse) GetToCurrency() string {
+	if x != nil {
+		return x.ToCurrency
+	}
+	return ""
+}
+
+func (x *QuoteTransferResponse) GetExchangeRate() string {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return ""
+}
+
+type DepositRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepositRequest) Reset() {
+	*x = DepositRequest{}
+	mi := &file_proto_transfer_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepositRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositRequest) ProtoMessage() {}
+
+func (x *DepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositRequest.ProtoReflect.Descriptor instead.
+func (*DepositRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DepositRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *DepositRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *DepositRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type DepositResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Entry         *Entry                 `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepositResponse) Reset() {
+	*x = DepositResponse{}
+	mi := &file_proto_transfer_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepositResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositResponse) ProtoMessage() {}
+
+func (x *DepositResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositResponse.ProtoReflect.Descriptor instead.
+func (*DepositResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DepositResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *DepositResponse) GetEntry() *Entry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type WithdrawRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawRequest) Reset() {
+	*x = WithdrawRequest{}
+	mi := &file_proto_transfer_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawRequest) ProtoMessage() {}
+
+func (x *WithdrawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WithdrawRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *WithdrawRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *WithdrawRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type WithdrawResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Entry         *Entry                 `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
+	Fee           *Fee                   `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	FeeEntry      *Entry                 `protobuf:"bytes,4,opt,name=fee_entry,json=feeEntry,proto3" json:"fee_entry,omitempty"` // unset without a fee
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawResponse) Reset() {
+	*x = WithdrawResponse{}
+	mi := &file_proto_transfer_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawResponse) ProtoMessage() {}
+
+func (x *WithdrawResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WithdrawResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *WithdrawResponse) GetEntry() *Entry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *WithdrawResponse) GetFee() *Fee {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *WithdrawResponse) GetFeeEntry() *Entry {
+	if x != nil {
+		return x.FeeEntry
+	}
+	return nil
+}
+
+type QuoteWithdrawRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteWithdrawRequest) Reset() {
+	*x = QuoteWithdrawRequest{}
+	mi := &file_proto_transfer_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteWithdrawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteWithdrawRequest) ProtoMessage() {}
+
+func (x *QuoteWithdrawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteWithdrawRequest.ProtoReflect.Descriptor instead.
+func (*QuoteWithdrawRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QuoteWithdrawRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *QuoteWithdrawRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *QuoteWithdrawRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type QuoteWithdrawResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fee           *Fee                   `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteWithdrawResponse) Reset() {
+	*x = QuoteWithdrawResponse{}
+	mi := &file_proto_transfer_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteWithdrawResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteWithdrawResponse) ProtoMessage() {}
+
+func (x *QuoteWithdrawResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteWithdrawResponse.ProtoReflect.Descriptor instead.
+func (*QuoteWithdrawResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QuoteWithdrawResponse) GetFee() *Fee {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+type RegisterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	FirstName     string                 `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName      string                 `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterRequest) Reset() {
+	*x = RegisterRequest{}
+	mi := &file_proto_transfer_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterRequest) ProtoMessage() {}
+
+func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
+func (*RegisterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RegisterRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type LoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	mi := &file_proto_transfer_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LoginRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type RefreshTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
+	mi := &file_proto_transfer_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRequest) ProtoMessage() {}
+
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RefreshTokenRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type TokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenResponse) Reset() {
+	*x = TokenResponse{}
+	mi := &file_proto_transfer_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenResponse) ProtoMessage() {}
+
+func (x *TokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenResponse.ProtoReflect.Descriptor instead.
+func (*TokenResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TokenResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *TokenResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type LogoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_proto_transfer_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *LogoutRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type LogoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutResponse) Reset() {
+	*x = LogoutResponse{}
+	mi := &file_proto_transfer_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutResponse) ProtoMessage() {}
+
+func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{19}
+}
+
+type CreateAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Product       string                 `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"` // current (default) or savings
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountRequest) Reset() {
+	*x = CreateAccountRequest{}
+	mi := &file_proto_transfer_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountRequest) ProtoMessage() {}
+
+func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateAccountRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
+}
+
+type CreateAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountResponse) Reset() {
+	*x = CreateAccountResponse{}
+	mi := &file_proto_transfer_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountResponse) ProtoMessage() {}
+
+func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type GetAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountRequest) Reset() {
+	*x = GetAccountRequest{}
+	mi := &file_proto_transfer_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountRequest) ProtoMessage() {}
+
+func (x *GetAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetAccountRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type GetAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountResponse) Reset() {
+	*x = GetAccountResponse{}
+	mi := &file_proto_transfer_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountResponse) ProtoMessage() {}
+
+func (x *GetAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type Pagination struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NextCursor    string                 `protobuf:"bytes,1,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pagination) Reset() {
+	*x = Pagination{}
+	mi := &file_proto_transfer_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pagination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pagination) ProtoMessage() {}
+
+func (x *Pagination) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
+func (*Pagination) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Pagination) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *Pagination) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *Pagination) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListAccountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountsRequest) Reset() {
+	*x = ListAccountsRequest{}
+	mi := &file_proto_transfer_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountsRequest) ProtoMessage() {}
+
+func (x *ListAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListAccountsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListAccountsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAccountsRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ListAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*Account             `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountsResponse) Reset() {
+	*x = ListAccountsResponse{}
+	mi := &file_proto_transfer_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountsResponse) ProtoMessage() {}
+
+func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListAccountsResponse) GetAccounts() []*Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *ListAccountsResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	FirstName     string                 `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName      string                 `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	Role          string                 `protobuf:"bytes,6,opt,name=role,proto3" json:"role,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_proto_transfer_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *User) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *User) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *User) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type AdminFindUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminFindUserRequest) Reset() {
+	*x = AdminFindUserRequest{}
+	mi := &file_proto_transfer_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminFindUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminFindUserRequest) ProtoMessage() {}
+
+func (x *AdminFindUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminFindUserRequest.ProtoReflect.Descriptor instead.
+func (*AdminFindUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AdminFindUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type AdminFindUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminFindUserResponse) Reset() {
+	*x = AdminFindUserResponse{}
+	mi := &file_proto_transfer_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminFindUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminFindUserResponse) ProtoMessage() {}
+
+func (x *AdminFindUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminFindUserResponse.ProtoReflect.Descriptor instead.
+func (*AdminFindUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AdminFindUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type AdminGetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGetUserRequest) Reset() {
+	*x = AdminGetUserRequest{}
+	mi := &file_proto_transfer_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetUserRequest) ProtoMessage() {}
+
+func (x *AdminGetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetUserRequest.ProtoReflect.Descriptor instead.
+func (*AdminGetUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AdminGetUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type AdminGetUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGetUserResponse) Reset() {
+	*x = AdminGetUserResponse{}
+	mi := &file_proto_transfer_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetUserResponse) ProtoMessage() {}
+
+func (x *AdminGetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetUserResponse.ProtoReflect.Descriptor instead.
+func (*AdminGetUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AdminGetUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type AdminListUserAccountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListUserAccountsRequest) Reset() {
+	*x = AdminListUserAccountsRequest{}
+	mi := &file_proto_transfer_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListUserAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListUserAccountsRequest) ProtoMessage() {}
+
+func (x *AdminListUserAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListUserAccountsRequest.ProtoReflect.Descriptor instead.
+func (*AdminListUserAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AdminListUserAccountsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminListUserAccountsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *AdminListUserAccountsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *AdminListUserAccountsRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type AdminListUserAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*Account             `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListUserAccountsResponse) Reset() {
+	*x = AdminListUserAccountsResponse{}
+	mi := &file_proto_transfer_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListUserAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListUserAccountsResponse) ProtoMessage() {}
+
+func (x *AdminListUserAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListUserAccountsResponse.ProtoReflect.Descriptor instead.
+func (*AdminListUserAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AdminListUserAccountsResponse) GetAccounts() []*Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *AdminListUserAccountsResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type AdminGetAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGetAccountRequest) Reset() {
+	*x = AdminGetAccountRequest{}
+	mi := &file_proto_transfer_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetAccountRequest) ProtoMessage() {}
+
+func (x *AdminGetAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetAccountRequest.ProtoReflect.Descriptor instead.
+func (*AdminGetAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AdminGetAccountRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type AdminGetAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGetAccountResponse) Reset() {
+	*x = AdminGetAccountResponse{}
+	mi := &file_proto_transfer_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetAccountResponse) ProtoMessage() {}
+
+func (x *AdminGetAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetAccountResponse.ProtoReflect.Descriptor instead.
+func (*AdminGetAccountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AdminGetAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type AdminChangeAccountStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminChangeAccountStatusRequest) Reset() {
+	*x = AdminChangeAccountStatusRequest{}
+	mi := &file_proto_transfer_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminChangeAccountStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminChangeAccountStatusRequest) ProtoMessage() {}
+
+func (x *AdminChangeAccountStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminChangeAccountStatusRequest.ProtoReflect.Descriptor instead.
+func (*AdminChangeAccountStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AdminChangeAccountStatusRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *AdminChangeAccountStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminChangeAccountStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdminChangeAccountStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminChangeAccountStatusResponse) Reset() {
+	*x = AdminChangeAccountStatusResponse{}
+	mi := &file_proto_transfer_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminChangeAccountStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminChangeAccountStatusResponse) ProtoMessage() {}
+
+func (x *AdminChangeAccountStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminChangeAccountStatusResponse.ProtoReflect.Descriptor instead.
+func (*AdminChangeAccountStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AdminChangeAccountStatusResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type AccountStatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId     int64                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	FromStatus    string                 `protobuf:"bytes,3,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus      string                 `protobuf:"bytes,4,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	ChangedBy     int64                  `protobuf:"varint,6,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountStatusChange) Reset() {
+	*x = AccountStatusChange{}
+	mi := &file_proto_transfer_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountStatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountStatusChange) ProtoMessage() {}
+
+func (x *AccountStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountStatusChange.ProtoReflect.Descriptor instead.
+func (*AccountStatusChange) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AccountStatusChange) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AccountStatusChange) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *AccountStatusChange) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *AccountStatusChange) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *AccountStatusChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AccountStatusChange) GetChangedBy() int64 {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return 0
+}
+
+func (x *AccountStatusChange) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AdminListStatusChangesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListStatusChangesRequest) Reset() {
+	*x = AdminListStatusChangesRequest{}
+	mi := &file_proto_transfer_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListStatusChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListStatusChangesRequest) ProtoMessage() {}
+
+func (x *AdminListStatusChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListStatusChangesRequest.ProtoReflect.Descriptor instead.
+func (*AdminListStatusChangesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AdminListStatusChangesRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type AdminListStatusChangesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*AccountStatusChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListStatusChangesResponse) Reset() {
+	*x = AdminListStatusChangesResponse{}
+	mi := &file_proto_transfer_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListStatusChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListStatusChangesResponse) ProtoMessage() {}
+
+func (x *AdminListStatusChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListStatusChangesResponse.ProtoReflect.Descriptor instead.
+func (*AdminListStatusChangesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AdminListStatusChangesResponse) GetChanges() []*AccountStatusChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// Zero means no limit.
+type TransferLimits struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	MaxAmount     int64                  `protobuf:"varint,3,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	DailyAmount   int64                  `protobuf:"varint,4,opt,name=daily_amount,json=dailyAmount,proto3" json:"daily_amount,omitempty"`
+	DailyCount    int64                  `protobuf:"varint,5,opt,name=daily_count,json=dailyCount,proto3" json:"daily_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferLimits) Reset() {
+	*x = TransferLimits{}
+	mi := &file_proto_transfer_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferLimits) ProtoMessage() {}
+
+func (x *TransferLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferLimits.ProtoReflect.Descriptor instead.
+func (*TransferLimits) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TransferLimits) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *TransferLimits) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TransferLimits) GetMaxAmount() int64 {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return 0
+}
+
+func (x *TransferLimits) GetDailyAmount() int64 {
+	if x != nil {
+		return x.DailyAmount
+	}
+	return 0
+}
+
+func (x *TransferLimits) GetDailyCount() int64 {
+	if x != nil {
+		return x.DailyCount
+	}
+	return 0
+}
+
+type AdminGetTransferLimitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGetTransferLimitsRequest) Reset() {
+	*x = AdminGetTransferLimitsRequest{}
+	mi := &file_proto_transfer_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetTransferLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetTransferLimitsRequest) ProtoMessage() {}
+
+func (x *AdminGetTransferLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetTransferLimitsRequest.ProtoReflect.Descriptor instead.
+func (*AdminGetTransferLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AdminGetTransferLimitsRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type AdminGetTransferLimitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limits        *TransferLimits        `protobuf:"bytes,1,opt,name=limits,proto3" json:"limits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGetTransferLimitsResponse) Reset() {
+	*x = AdminGetTransferLimitsResponse{}
+	mi := &file_proto_transfer_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetTransferLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetTransferLimitsResponse) ProtoMessage() {}
+
+func (x *AdminGetTransferLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetTransferLimitsResponse.ProtoReflect.Descriptor instead.
+func (*AdminGetTransferLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AdminGetTransferLimitsResponse) GetLimits() *TransferLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+// Unset fields fall back to the currency limit.
+type AdminSetTransferLimitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	MaxAmount     *int64                 `protobuf:"varint,2,opt,name=max_amount,json=maxAmount,proto3,oneof" json:"max_amount,omitempty"`
+	DailyAmount   *int64                 `protobuf:"varint,3,opt,name=daily_amount,json=dailyAmount,proto3,oneof" json:"daily_amount,omitempty"`
+	DailyCount    *int64                 `protobuf:"varint,4,opt,name=daily_count,json=dailyCount,proto3,oneof" json:"daily_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminSetTransferLimitsRequest) Reset() {
+	*x = AdminSetTransferLimitsRequest{}
+	mi := &file_proto_transfer_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSetTransferLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSetTransferLimitsRequest) ProtoMessage() {}
+
+func (x *AdminSetTransferLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSetTransferLimitsRequest.ProtoReflect.Descriptor instead.
+func (*AdminSetTransferLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AdminSetTransferLimitsRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *AdminSetTransferLimitsRequest) GetMaxAmount() int64 {
+	if x != nil && x.MaxAmount != nil {
+		return *x.MaxAmount
+	}
+	return 0
+}
+
+func (x *AdminSetTransferLimitsRequest) GetDailyAmount() int64 {
+	if x != nil && x.DailyAmount != nil {
+		return *x.DailyAmount
+	}
+	return 0
+}
+
+func (x *AdminSetTransferLimitsRequest) GetDailyCount() int64 {
+	if x != nil && x.DailyCount != nil {
+		return *x.DailyCount
+	}
+	return 0
+}
+
+type AdminSetTransferLimitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limits        *TransferLimits        `protobuf:"bytes,1,opt,name=limits,proto3" json:"limits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminSetTransferLimitsResponse) Reset() {
+	*x = AdminSetTransferLimitsResponse{}
+	mi := &file_proto_transfer_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSetTransferLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSetTransferLimitsResponse) ProtoMessage() {}
+
+func (x *AdminSetTransferLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSetTransferLimitsResponse.ProtoReflect.Descriptor instead.
+func (*AdminSetTransferLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AdminSetTransferLimitsResponse) GetLimits() *TransferLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type AdminSetOverdraftLimitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Limit         int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminSetOverdraftLimitRequest) Reset() {
+	*x = AdminSetOverdraftLimitRequest{}
+	mi := &file_proto_transfer_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSetOverdraftLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSetOverdraftLimitRequest) ProtoMessage() {}
+
+func (x *AdminSetOverdraftLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSetOverdraftLimitRequest.ProtoReflect.Descriptor instead.
+func (*AdminSetOverdraftLimitRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AdminSetOverdraftLimitRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *AdminSetOverdraftLimitRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AdminSetOverdraftLimitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminSetOverdraftLimitResponse) Reset() {
+	*x = AdminSetOverdraftLimitResponse{}
+	mi := &file_proto_transfer_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSetOverdraftLimitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSetOverdraftLimitResponse) ProtoMessage() {}
+
+func (x *AdminSetOverdraftLimitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSetOverdraftLimitResponse.ProtoReflect.Descriptor instead.
+func (*AdminSetOverdraftLimitResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AdminSetOverdraftLimitResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type AdminListEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListEntriesRequest) Reset() {
+	*x = AdminListEntriesRequest{}
+	mi := &file_proto_transfer_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListEntriesRequest) ProtoMessage() {}
+
+func (x *AdminListEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListEntriesRequest.ProtoReflect.Descriptor instead.
+func (*AdminListEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AdminListEntriesRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *AdminListEntriesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *AdminListEntriesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *AdminListEntriesRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type AdminListEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*Entry               `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListEntriesResponse) Reset() {
+	*x = AdminListEntriesResponse{}
+	mi := &file_proto_transfer_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListEntriesResponse) ProtoMessage() {}
+
+func (x *AdminListEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListEntriesResponse.ProtoReflect.Descriptor instead.
+func (*AdminListEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AdminListEntriesResponse) GetEntries() []*Entry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *AdminListEntriesResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type AdminGetEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       int64                  `protobuf:"varint,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGetEntryRequest) Reset() {
+	*x = AdminGetEntryRequest{}
+	mi := &file_proto_transfer_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetEntryRequest) ProtoMessage() {}
+
+func (x *AdminGetEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetEntryRequest.ProtoReflect.Descriptor instead.
+func (*AdminGetEntryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *AdminGetEntryRequest) GetEntryId() int64 {
+	if x != nil {
+		return x.EntryId
+	}
+	return 0
+}
+
+type AdminGetEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *Entry                 `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGetEntryResponse) Reset() {
+	*x = AdminGetEntryResponse{}
+	mi := &file_proto_transfer_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetEntryResponse) ProtoMessage() {}
+
+func (x *AdminGetEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetEntryResponse.ProtoReflect.Descriptor instead.
+func (*AdminGetEntryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *AdminGetEntryResponse) GetEntry() *Entry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+// One of user_id or account_id is required; account_id wins when both are set.
+type AdminListTransfersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountId     int64                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListTransfersRequest) Reset() {
+	*x = AdminListTransfersRequest{}
+	mi := &file_proto_transfer_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListTransfersRequest) ProtoMessage() {}
+
+func (x *AdminListTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListTransfersRequest.ProtoReflect.Descriptor instead.
+func (*AdminListTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *AdminListTransfersRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminListTransfersRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *AdminListTransfersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *AdminListTransfersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *AdminListTransfersRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type AdminListTransfersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfers     []*Transfer            `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListTransfersResponse) Reset() {
+	*x = AdminListTransfersResponse{}
+	mi := &file_proto_transfer_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListTransfersResponse) ProtoMessage() {}
+
+func (x *AdminListTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListTransfersResponse.ProtoReflect.Descriptor instead.
+func (*AdminListTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AdminListTransfersResponse) GetTransfers() []*Transfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+func (x *AdminListTransfersResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type AdminGetTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferId    int64                  `protobuf:"varint,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGetTransferRequest) Reset() {
+	*x = AdminGetTransferRequest{}
+	mi := &file_proto_transfer_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetTransferRequest) ProtoMessage() {}
+
+func (x *AdminGetTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetTransferRequest.ProtoReflect.Descriptor instead.
+func (*AdminGetTransferRequest) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AdminGetTransferRequest) GetTransferId() int64 {
+	if x != nil {
+		return x.TransferId
+	}
+	return 0
+}
+
+type AdminGetTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *Transfer              `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGetTransferResponse) Reset() {
+	*x = AdminGetTransferResponse{}
+	mi := &file_proto_transfer_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetTransferResponse) ProtoMessage() {}
+
+func (x *AdminGetTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_transfer_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetTransferResponse.ProtoReflect.Descriptor instead.
+func (*AdminGetTransferResponse) Descriptor() ([]byte, []int) {
+	return file_proto_transfer_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AdminGetTransferResponse) GetTransfer() *Transfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+var File_proto_transfer_service_proto protoreflect.FileDescriptor
+
+const file_proto_transfer_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1cproto/transfer_service.proto\x12\x02pb\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc0\x01\n" +
+	"\x15CreateTransferRequest\x12&\n" +
+	"\x0ffrom_account_id\x18\x01 \x01(\x03R\rfromAccountId\x12\"\n" +
+	"\rto_account_id\x18\x02 \x01(\x03R\vtoAccountId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12'\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tR\x0eidempotencyKey\"\xed\x03\n" +
+	"\aAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\x03R\aownerId\x12\x18\n" +
+	"\abalance\x18\x03 \x01(\x03R\abalance\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
+	"\x04type\x18\a \x01(\tR\x04type\x12'\n" +
+	"\x0fbalance_display\x18\b \x01(\tR\x0ebalanceDisplay\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\x1f\n" +
+	"\vheld_amount\x18\n" +
+	" \x01(\x03R\n" +
+	"heldAmount\x12+\n" +
+	"\x11available_balance\x18\v \x01(\x03R\x10availableBalance\x12\x18\n" +
+	"\aproduct\x18\f \x01(\tR\aproduct\x12'\n" +
+	"\x0foverdraft_limit\x18\r \x01(\x03R\x0eoverdraftLimit\x12%\n" +
+	"\x0eoverdraft_used\x18\x0e \x01(\x03R\roverdraftUsed\"\xf9\x02\n" +
+	"\bTransfer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12&\n" +
+	"\x0ffrom_account_id\x18\x02 \x01(\x03R\rfromAccountId\x12\"\n" +
+	"\rto_account_id\x18\x03 \x01(\x03R\vtoAccountId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
+	"\tto_amount\x18\x06 \x01(\x03R\btoAmount\x12#\n" +
+	"\rexchange_rate\x18\a \x01(\tR\fexchangeRate\x12$\n" +
+	"\vreversal_of\x18\b \x01(\x03H\x00R\n" +
+	"reversalOf\x88\x01\x01\x12$\n" +
+	"\vreversed_by\x18\t \x01(\x03H\x01R\n" +
+	"reversedBy\x88\x01\x01\x12\x10\n" +
+	"\x03fee\x18\n" +
+	" \x01(\x03R\x03feeB\x0e\n" +
+	"\f_reversal_ofB\x0e\n" +
+	"\f_reversed_by\"\xa8\x01\n" +
+	"\x05Entry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03R\taccountId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"journal_id\x18\x05 \x01(\x03R\tjournalId\"\xa4\x01\n" +
+	"\x03Fee\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\x03R\x06ruleId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x12\n" +
+	"\x04flat\x18\x03 \x01(\x03R\x04flat\x12\x1e\n" +
+	"\n" +
+	"percentage\x18\x04 \x01(\x03R\n" +
+	"percentage\x12\x1e\n" +
+	"\n" +
+	"adjustment\x18\x05 \x01(\x03R\n" +
+	"adjustment\x12\x14\n" +
+	"\x05total\x18\x06 \x01(\x03R\x05total\"\xb1\x02\n" +
+	"\x16CreateTransferResponse\x12(\n" +
+	"\btransfer\x18\x01 \x01(\v2\f.pb.TransferR\btransfer\x12.\n" +
+	"\ffrom_account\x18\x02 \x01(\v2\v.pb.AccountR\vfromAccount\x12*\n" +
+	"\n" +
+	"to_account\x18\x03 \x01(\v2\v.pb.AccountR\ttoAccount\x12(\n" +
+	"\n" +
+	"from_entry\x18\x04 \x01(\v2\t.pb.EntryR\tfromEntry\x12$\n" +
+	"\bto_entry\x18\x05 \x01(\v2\t.pb.EntryR\atoEntry\x12\x19\n" +
+	"\x03fee\x18\x06 \x01(\v2\a.pb.FeeR\x03fee\x12&\n" +
+	"\tfee_entry\x18\a \x01(\v2\t.pb.EntryR\bfeeEntry\"\x96\x01\n" +
+	"\x14QuoteTransferRequest\x12&\n" +
+	"\x0ffrom_account_id\x18\x01 \x01(\x03R\rfromAccountId\x12\"\n" +
+	"\rto_account_id\x18\x02 \x01(\x03R\vtoAccountId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\"\xb6\x02\n" +
+	"\x15QuoteTransferResponse\x12&\n" +
+	"\x0ffrom_account_id\x18\x01 \x01(\x03R\rfromAccountId\x12\"\n" +
+	"\rto_account_id\x18\x02 \x01(\x03R\vtoAccountId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x19\n" +
+	"\x03fee\x18\x05 \x01(\v2\a.pb.FeeR\x03fee\x12\x1f\n" +
+	"\vtotal_debit\x18\x06 \x01(\x03R\n" +
+	"totalDebit\x12\x1b\n" +
+	"\tto_amount\x18\a \x01(\x03R\btoAmount\x12\x1f\n" +
+	"\vto_currency\x18\b \x01(\tR\n" +
+	"toCurrency\x12#\n" +
+	"\rexchange_rate\x18\t \x01(\tR\fexchangeRate\"c\n" +
+	"\x0eDepositRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\"Y\n" +
+	"\x0fDepositResponse\x12%\n" +
+	"\aaccount\x18\x01 \x01(\v2\v.pb.AccountR\aaccount\x12\x1f\n" +
+	"\x05entry\x18\x02 \x01(\v2\t.pb.EntryR\x05entry\"d\n" +
+	"\x0fWithdrawRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\"\x9d\x01\n" +
+	"\x10WithdrawResponse\x12%\n" +
+	"\aaccount\x18\x01 \x01(\v2\v.pb.AccountR\aaccount\x12\x1f\n" +
+	"\x05entry\x18\x02 \x01(\v2\t.pb.EntryR\x05entry\x12\x19\n" +
+	"\x03fee\x18\x03 \x01(\v2\a.pb.FeeR\x03fee\x12&\n" +
+	"\tfee_entry\x18\x04 \x01(\v2\t.pb.EntryR\bfeeEntry\"i\n" +
+	"\x14QuoteWithdrawRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\"2\n" +
+	"\x15QuoteWithdrawResponse\x12\x19\n" +
+	"\x03fee\x18\x01 \x01(\v2\a.pb.FeeR\x03fee\"\x9b\x01\n" +
+	"\x0fRegisterRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x03 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x04 \x01(\tR\blastName\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\"@\n" +
+	"\fLoginRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\":\n" +
+	"\x13RefreshTokenRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"W\n" +
+	"\rTokenResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"4\n" +
+	"\rLogoutRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"\x10\n" +
+	"\x0eLogoutResponse\"L\n" +
+	"\x14CreateAccountRequest\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x18\n" +
+	"\aproduct\x18\x02 \x01(\tR\aproduct\">\n" +
+	"\x15CreateAccountResponse\x12%\n" +
+	"\aaccount\x18\x01 \x01(\v2\v.pb.AccountR\aaccount\"2\n" +
+	"\x11GetAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\";\n" +
+	"\x12GetAccountResponse\x12%\n" +
+	"\aaccount\x18\x01 \x01(\v2\v.pb.AccountR\aaccount\"e\n" +
+	"\n" +
+	"Pagination\x12\x1f\n" +
+	"\vnext_cursor\x18\x01 \x01(\tR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"U\n" +
+	"\x13ListAccountsRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\"o\n" +
+	"\x14ListAccountsResponse\x12'\n" +
+	"\baccounts\x18\x01 \x03(\v2\v.pb.AccountR\baccounts\x12.\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x0e.pb.PaginationR\n" +
+	"pagination\"\x8e\x02\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x03 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x04 \x01(\tR\blastName\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x06 \x01(\tR\x04role\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\",\n" +
+	"\x14AdminFindUserRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"5\n" +
+	"\x15AdminFindUserResponse\x12\x1c\n" +
+	"\x04user\x18\x01 \x01(\v2\b.pb.UserR\x04user\".\n" +
+	"\x13AdminGetUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"4\n" +
+	"\x14AdminGetUserResponse\x12\x1c\n" +
+	"\x04user\x18\x01 \x01(\v2\b.pb.UserR\x04user\"w\n" +
+	"\x1cAdminListUserAccountsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x05R\x04size\"x\n" +
+	"\x1dAdminListUserAccountsResponse\x12'\n" +
+	"\baccounts\x18\x01 \x03(\v2\v.pb.AccountR\baccounts\x12.\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x0e.pb.PaginationR\n" +
+	"pagination\"7\n" +
+	"\x16AdminGetAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\"@\n" +
+	"\x17AdminGetAccountResponse\x12%\n" +
+	"\aaccount\x18\x01 \x01(\v2\v.pb.AccountR\aaccount\"p\n" +
+	"\x1fAdminChangeAccountStatusRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"I\n" +
+	" AdminChangeAccountStatusResponse\x12%\n" +
+	"\aaccount\x18\x01 \x01(\v2\v.pb.AccountR\aaccount\"\xf4\x01\n" +
+	"\x13AccountStatusChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03R\taccountId\x12\x1f\n" +
+	"\vfrom_status\x18\x03 \x01(\tR\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x04 \x01(\tR\btoStatus\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\x06 \x01(\x03R\tchangedBy\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\">\n" +
+	"\x1dAdminListStatusChangesRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\"S\n" +
+	"\x1eAdminListStatusChangesResponse\x121\n" +
+	"\achanges\x18\x01 \x03(\v2\x17.pb.AccountStatusChangeR\achanges\"\xae\x01\n" +
+	"\x0eTransferLimits\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x1d\n" +
+	"\n" +
+	"max_amount\x18\x03 \x01(\x03R\tmaxAmount\x12!\n" +
+	"\fdaily_amount\x18\x04 \x01(\x03R\vdailyAmount\x12\x1f\n" +
+	"\vdaily_count\x18\x05 \x01(\x03R\n" +
+	"dailyCount\">\n" +
+	"\x1dAdminGetTransferLimitsRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\"L\n" +
+	"\x1eAdminGetTransferLimitsResponse\x12*\n" +
+	"\x06limits\x18\x01 \x01(\v2\x12.pb.TransferLimitsR\x06limits\"\xe0\x01\n" +
+	"\x1dAdminSetTransferLimitsRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\"\n" +
+	"\n" +
+	"max_amount\x18\x02 \x01(\x03H\x00R\tmaxAmount\x88\x01\x01\x12&\n" +
+	"\fdaily_amount\x18\x03 \x01(\x03H\x01R\vdailyAmount\x88\x01\x01\x12$\n" +
+	"\vdaily_count\x18\x04 \x01(\x03H\x02R\n" +
+	"dailyCount\x88\x01\x01B\r\n" +
+	"\v_max_amountB\x0f\n" +
+	"\r_daily_amountB\x0e\n" +
+	"\f_daily_count\"L\n" +
+	"\x1eAdminSetTransferLimitsResponse\x12*\n" +
+	"\x06limits\x18\x01 \x01(\v2\x12.pb.TransferLimitsR\x06limits\"T\n" +
+	"\x1dAdminSetOverdraftLimitRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\"G\n" +
+	"\x1eAdminSetOverdraftLimitResponse\x12%\n" +
+	"\aaccount\x18\x01 \x01(\v2\v.pb.AccountR\aaccount\"x\n" +
+	"\x17AdminListEntriesRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x05R\x04size\"o\n" +
+	"\x18AdminListEntriesResponse\x12#\n" +
+	"\aentries\x18\x01 \x03(\v2\t.pb.EntryR\aentries\x12.\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x0e.pb.PaginationR\n" +
+	"pagination\"1\n" +
+	"\x14AdminGetEntryRequest\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\x03R\aentryId\"8\n" +
+	"\x15AdminGetEntryResponse\x12\x1f\n" +
+	"\x05entry\x18\x01 \x01(\v2\t.pb.EntryR\x05entry\"\x93\x01\n" +
+	"\x19AdminListTransfersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03R\taccountId\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x05R\x04size\"x\n" +
+	"\x1aAdminListTransfersResponse\x12*\n" +
+	"\ttransfers\x18\x01 \x03(\v2\f.pb.TransferR\ttransfers\x12.\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x0e.pb.PaginationR\n" +
+	"pagination\":\n" +
+	"\x17AdminGetTransferRequest\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\x03R\n" +
+	"transferId\"D\n" +
+	"\x18AdminGetTransferResponse\x12(\n" +
+	"\btransfer\x18\x01 \x01(\v2\f.pb.TransferR\btransfer2\xf7\x16\n" +
+	"\n" +
+	"SimpleBank\x12e\n" +
+	"\x0eCreateTransfer\x12\x19.pb.CreateTransferRequest\x1a\x1a.pb.CreateTransferResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/v1/transfers\x12h\n" +
+	"\rQuoteTransfer\x12\x18.pb.QuoteTransferRequest\x1a\x19.pb.QuoteTransferResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/transfers/quote\x12e\n" +
+	"\aDeposit\x12\x12.pb.DepositRequest\x1a\x13.pb.DepositResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/accounts/{account_id}/deposits\x12k\n" +
+	"\bWithdraw\x12\x13.pb.WithdrawRequest\x1a\x14.pb.WithdrawResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/api/v1/accounts/{account_id}/withdrawals\x12\x80\x01\n" +
+	"\rQuoteWithdraw\x12\x18.pb.QuoteWithdrawRequest\x1a\x19.pb.QuoteWithdrawResponse\":\x82\xd3\xe4\x93\x024:\x01*\"//api/v1/accounts/{account_id}/withdrawals/quote\x12T\n" +
+	"\bRegister\x12\x13.pb.RegisterRequest\x1a\x11.pb.TokenResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/auth/register\x12K\n" +
+	"\x05Login\x12\x10.pb.LoginRequest\x1a\x11.pb.TokenResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/auth/login\x12b\n" +
+	"\fRefreshToken\x12\x17.pb.RefreshTokenRequest\x1a\x11.pb.TokenResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/users/refresh-token\x12P\n" +
+	"\x06Logout\x12\x11.pb.LogoutRequest\x1a\x12.pb.LogoutResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/users/logout\x12a\n" +
+	"\rCreateAccount\x12\x18.pb.CreateAccountRequest\x1a\x19.pb.CreateAccountResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/v1/accounts\x12b\n" +
 	"\n" +
-	"SimpleBank\x12I\n" +
-	"\x0eCreateTransfer\x12\x19.pb.CreateTransferRequest\x1a\x1a.pb.CreateTransferResponse\"\x00B#Z!github.com/codepnw/simple-bank/pbb\x06proto3"
+	"GetAccount\x12\x15.pb.GetAccountRequest\x1a\x16.pb.GetAccountResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/accounts/{account_id}\x12[\n" +
+	"\fListAccounts\x12\x17.pb.ListAccountsRequest\x1a\x18.pb.ListAccountsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/accounts\x12a\n" +
+	"\rAdminFindUser\x12\x18.pb.AdminFindUserRequest\x1a\x19.pb.AdminFindUserResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/admin/users\x12h\n" +
+	"\fAdminGetUser\x12\x17.pb.AdminGetUserRequest\x1a\x18.pb.AdminGetUserResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/admin/users/{user_id}\x12\x8c\x01\n" +
+	"\x15AdminListUserAccounts\x12 .pb.AdminListUserAccountsRequest\x1a!.pb.AdminListUserAccountsResponse\".\x82\xd3\xe4\x93\x02(\x12&/api/v1/admin/users/{user_id}/accounts\x12w\n" +
+	"\x0fAdminGetAccount\x12\x1a.pb.AdminGetAccountRequest\x1a\x1b.pb.AdminGetAccountResponse\"+\x82\xd3\xe4\x93\x02%\x12#/api/v1/admin/accounts/{account_id}\x12\x9c\x01\n" +
+	"\x18AdminChangeAccountStatus\x12#.pb.AdminChangeAccountStatusRequest\x1a$.pb.AdminChangeAccountStatusResponse\"5\x82\xd3\xe4\x93\x02/:\x01*2*/api/v1/admin/accounts/{account_id}/status\x12\x9b\x01\n" +
+	"\x16AdminListStatusChanges\x12!.pb.AdminListStatusChangesRequest\x1a\".pb.AdminListStatusChangesResponse\":\x82\xd3\xe4\x93\x024\x122/api/v1/admin/accounts/{account_id}/status-changes\x12\x93\x01\n" +
+	"\x16AdminGetTransferLimits\x12!.pb.AdminGetTransferLimitsRequest\x1a\".pb.AdminGetTransferLimitsResponse\"2\x82\xd3\xe4\x93\x02,\x12*/api/v1/admin/accounts/{account_id}/limits\x12\x96\x01\n" +
+	"\x16AdminSetTransferLimits\x12!.pb.AdminSetTransferLimitsRequest\x1a\".pb.AdminSetTransferLimitsResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\x1a*/api/v1/admin/accounts/{account_id}/limits\x12\x99\x01\n" +
+	"\x16AdminSetOverdraftLimit\x12!.pb.AdminSetOverdraftLimitRequest\x1a\".pb.AdminSetOverdraftLimitResponse\"8\x82\xd3\xe4\x93\x022:\x01*\x1a-/api/v1/admin/accounts/{account_id}/overdraft\x12\x82\x01\n" +
+	"\x10AdminListEntries\x12\x1b.pb.AdminListEntriesRequest\x1a\x1c.pb.AdminListEntriesResponse\"3\x82\xd3\xe4\x93\x02-\x12+/api/v1/admin/accounts/{account_id}/entries\x12n\n" +
+	"\rAdminGetEntry\x12\x18.pb.AdminGetEntryRequest\x1a\x19.pb.AdminGetEntryResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/admin/entries/{entry_id}\x12t\n" +
+	"\x12AdminListTransfers\x12\x1d.pb.AdminListTransfersRequest\x1a\x1e.pb.AdminListTransfersResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/admin/transfers\x12|\n" +
+	"\x10AdminGetTransfer\x12\x1b.pb.AdminGetTransferRequest\x1a\x1c.pb.AdminGetTransferResponse\"-\x82\xd3\xe4\x93\x02'\x12%/api/v1/admin/transfers/{transfer_id}B#Z!github.com/codepnw/simple-bank/pbb\x06proto3"
 
 var (
 	file_proto_transfer_service_proto_rawDescOnce sync.Once
@@ -451,32 +3690,164 @@ func file_proto_transfer_service_proto_rawDescGZIP() []byte {
 	return file_proto_transfer_service_proto_rawDescData
 }
 
-var file_proto_transfer_service_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_transfer_service_proto_msgTypes = make([]protoimpl.MessageInfo, 56)
 var file_proto_transfer_service_proto_goTypes = []any{
-	(*CreateTransferRequest)(nil),  // 0: pb.CreateTransferRequest
-	(*Account)(nil),                // 1: pb.Account
-	(*Transfer)(nil),               // 2: pb.Transfer
-	(*Entry)(nil),                  // 3: pb.Entry
-	(*CreateTransferResponse)(nil), // 4: pb.CreateTransferResponse
-	(*timestamppb.Timestamp)(nil),  // 5: google.protobuf.Timestamp
+	(*CreateTransferRequest)(nil),            // 0: pb.CreateTransferRequest
+	(*Account)(nil),                          // 1: pb.Account
+	(*Transfer)(nil),                         // 2: pb.Transfer
+	(*Entry)(nil),                            // 3: pb.Entry
+	(*Fee)(nil),                              // 4: pb.Fee
+	(*CreateTransferResponse)(nil),           // 5: pb.CreateTransferResponse
+	(*QuoteTransferRequest)(nil),             // 6: pb.QuoteTransferRequest
+	(*QuoteTransferResponse)(nil),            // 7: pb.QuoteTransferResponse
+	(*DepositRequest)(nil),                   // 8: pb.DepositRequest
+	(*DepositResponse)(nil),                  // 9: pb.DepositResponse
+	(*WithdrawRequest)(nil),                  // 10: pb.WithdrawRequest
+	(*WithdrawResponse)(nil),                 // 11: pb.WithdrawResponse
+	(*QuoteWithdrawRequest)(nil),             // 12: pb.QuoteWithdrawRequest
+	(*QuoteWithdrawResponse)(nil),            // 13: pb.QuoteWithdrawResponse
+	(*RegisterRequest)(nil),                  // 14: pb.RegisterRequest
+	(*LoginRequest)(nil),                     // 15: pb.LoginRequest
+	(*RefreshTokenRequest)(nil),              // 16: pb.RefreshTokenRequest
+	(*TokenResponse)(nil),                    // 17: pb.TokenResponse
+	(*LogoutRequest)(nil),                    // 18: pb.LogoutRequest
+	(*LogoutResponse)(nil),                   // 19: pb.LogoutResponse
+	(*CreateAccountRequest)(nil),             // 20: pb.CreateAccountRequest
+	(*CreateAccountResponse)(nil),            // 21: pb.CreateAccountResponse
+	(*GetAccountRequest)(nil),                // 22: pb.GetAccountRequest
+	(*GetAccountResponse)(nil),               // 23: pb.GetAccountResponse
+	(*Pagination)(nil),                       // 24: pb.Pagination
+	(*ListAccountsRequest)(nil),              // 25: pb.ListAccountsRequest
+	(*ListAccountsResponse)(nil),             // 26: pb.ListAccountsResponse
+	(*User)(nil),                             // 27: pb.User
+	(*AdminFindUserRequest)(nil),             // 28: pb.AdminFindUserRequest
+	(*AdminFindUserResponse)(nil),            // 29: pb.AdminFindUserResponse
+	(*AdminGetUserRequest)(nil),              // 30: pb.AdminGetUserRequest
+	(*AdminGetUserResponse)(nil),             // 31: pb.AdminGetUserResponse
+	(*AdminListUserAccountsRequest)(nil),     // 32: pb.AdminListUserAccountsRequest
+	(*AdminListUserAccountsResponse)(nil),    // 33: pb.AdminListUserAccountsResponse
+	(*AdminGetAccountRequest)(nil),           // 34: pb.AdminGetAccountRequest
+	(*AdminGetAccountResponse)(nil),          // 35: pb.AdminGetAccountResponse
+	(*AdminChangeAccountStatusRequest)(nil),  // 36: pb.AdminChangeAccountStatusRequest
+	(*AdminChangeAccountStatusResponse)(nil), // 37: pb.AdminChangeAccountStatusResponse
+	(*AccountStatusChange)(nil),              // 38: pb.AccountStatusChange
+	(*AdminListStatusChangesRequest)(nil),    // 39: pb.AdminListStatusChangesRequest
+	(*AdminListStatusChangesResponse)(nil),   // 40: pb.AdminListStatusChangesResponse
+	(*TransferLimits)(nil),                   // 41: pb.TransferLimits
+	(*AdminGetTransferLimitsRequest)(nil),    // 42: pb.AdminGetTransferLimitsRequest
+	(*AdminGetTransferLimitsResponse)(nil),   // 43: pb.AdminGetTransferLimitsResponse
+	(*AdminSetTransferLimitsRequest)(nil),    // 44: pb.AdminSetTransferLimitsRequest
+	(*AdminSetTransferLimitsResponse)(nil),   // 45: pb.AdminSetTransferLimitsResponse
+	(*AdminSetOverdraftLimitRequest)(nil),    // 46: pb.AdminSetOverdraftLimitRequest
+	(*AdminSetOverdraftLimitResponse)(nil),   // 47: pb.AdminSetOverdraftLimitResponse
+	(*AdminListEntriesRequest)(nil),          // 48: pb.AdminListEntriesRequest
+	(*AdminListEntriesResponse)(nil),         // 49: pb.AdminListEntriesResponse
+	(*AdminGetEntryRequest)(nil),             // 50: pb.AdminGetEntryRequest
+	(*AdminGetEntryResponse)(nil),            // 51: pb.AdminGetEntryResponse
+	(*AdminListTransfersRequest)(nil),        // 52: pb.AdminListTransfersRequest
+	(*AdminListTransfersResponse)(nil),       // 53: pb.AdminListTransfersResponse
+	(*AdminGetTransferRequest)(nil),          // 54: pb.AdminGetTransferRequest
+	(*AdminGetTransferResponse)(nil),         // 55: pb.AdminGetTransferResponse
+	(*timestamppb.Timestamp)(nil),            // 56: google.protobuf.Timestamp
 }
 var file_proto_transfer_service_proto_depIdxs = []int32{
-	5,  // 0: pb.Account.created_at:type_name -> google.protobuf.Timestamp
-	5,  // 1: pb.Account.updated_at:type_name -> google.protobuf.Timestamp
-	5,  // 2: pb.Transfer.created_at:type_name -> google.protobuf.Timestamp
-	5,  // 3: pb.Entry.created_at:type_name -> google.protobuf.Timestamp
+	56, // 0: pb.Account.created_at:type_name -> google.protobuf.Timestamp
+	56, // 1: pb.Account.updated_at:type_name -> google.protobuf.Timestamp
+	56, // 2: pb.Transfer.created_at:type_name -> google.protobuf.Timestamp
+	56, // 3: pb.Entry.created_at:type_name -> google.protobuf.Timestamp
 	2,  // 4: pb.CreateTransferResponse.transfer:type_name -> pb.Transfer
 	1,  // 5: pb.CreateTransferResponse.from_account:type_name -> pb.Account
 	1,  // 6: pb.CreateTransferResponse.to_account:type_name -> pb.Account
 	3,  // 7: pb.CreateTransferResponse.from_entry:type_name -> pb.Entry
 	3,  // 8: pb.CreateTransferResponse.to_entry:type_name -> pb.Entry
-	0,  // 9: pb.SimpleBank.CreateTransfer:input_type -> pb.CreateTransferRequest
-	4,  // 10: pb.SimpleBank.CreateTransfer:output_type -> pb.CreateTransferResponse
-	10, // [10:11] is the sub-list for method output_type
-	9,  // [9:10] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	4,  // 9: pb.CreateTransferResponse.fee:type_name -> pb.Fee
+	3,  // 10: pb.CreateTransferResponse.fee_entry:type_name -> pb.Entry
+	4,  // 11: pb.QuoteTransferResponse.fee:type_name -> pb.Fee
+	1,  // 12: pb.DepositResponse.account:type_name -> pb.Account
+	3,  // 13: pb.DepositResponse.entry:type_name -> pb.Entry
+	1,  // 14: pb.WithdrawResponse.account:type_name -> pb.Account
+	3,  // 15: pb.WithdrawResponse.entry:type_name -> pb.Entry
+	4,  // 16: pb.WithdrawResponse.fee:type_name -> pb.Fee
+	3,  // 17: pb.WithdrawResponse.fee_entry:type_name -> pb.Entry
+	4,  // 18: pb.QuoteWithdrawResponse.fee:type_name -> pb.Fee
+	1,  // 19: pb.CreateAccountResponse.account:type_name -> pb.Account
+	1,  // 20: pb.GetAccountResponse.account:type_name -> pb.Account
+	1,  // 21: pb.ListAccountsResponse.accounts:type_name -> pb.Account
+	24, // 22: pb.ListAccountsResponse.pagination:type_name -> pb.Pagination
+	56, // 23: pb.User.created_at:type_name -> google.protobuf.Timestamp
+	56, // 24: pb.User.updated_at:type_name -> google.protobuf.Timestamp
+	27, // 25: pb.AdminFindUserResponse.user:type_name -> pb.User
+	27, // 26: pb.AdminGetUserResponse.user:type_name -> pb.User
+	1,  // 27: pb.AdminListUserAccountsResponse.accounts:type_name -> pb.Account
+	24, // 28: pb.AdminListUserAccountsResponse.pagination:type_name -> pb.Pagination
+	1,  // 29: pb.AdminGetAccountResponse.account:type_name -> pb.Account
+	1,  // 30: pb.AdminChangeAccountStatusResponse.account:type_name -> pb.Account
+	56, // 31: pb.AccountStatusChange.created_at:type_name -> google.protobuf.Timestamp
+	38, // 32: pb.AdminListStatusChangesResponse.changes:type_name -> pb.AccountStatusChange
+	41, // 33: pb.AdminGetTransferLimitsResponse.limits:type_name -> pb.TransferLimits
+	41, // 34: pb.AdminSetTransferLimitsResponse.limits:type_name -> pb.TransferLimits
+	1,  // 35: pb.AdminSetOverdraftLimitResponse.account:type_name -> pb.Account
+	3,  // 36: pb.AdminListEntriesResponse.entries:type_name -> pb.Entry
+	24, // 37: pb.AdminListEntriesResponse.pagination:type_name -> pb.Pagination
+	3,  // 38: pb.AdminGetEntryResponse.entry:type_name -> pb.Entry
+	2,  // 39: pb.AdminListTransfersResponse.transfers:type_name -> pb.Transfer
+	24, // 40: pb.AdminListTransfersResponse.pagination:type_name -> pb.Pagination
+	2,  // 41: pb.AdminGetTransferResponse.transfer:type_name -> pb.Transfer
+	0,  // 42: pb.SimpleBank.CreateTransfer:input_type -> pb.CreateTransferRequest
+	6,  // 43: pb.SimpleBank.QuoteTransfer:input_type -> pb.QuoteTransferRequest
+	8,  // 44: pb.SimpleBank.Deposit:input_type -> pb.DepositRequest
+	10, // 45: pb.SimpleBank.Withdraw:input_type -> pb.WithdrawRequest
+	12, // 46: pb.SimpleBank.QuoteWithdraw:input_type -> pb.QuoteWithdrawRequest
+	14, // 47: pb.SimpleBank.Register:input_type -> pb.RegisterRequest
+	15, // 48: pb.SimpleBank.Login:input_type -> pb.LoginRequest
+	16, // 49: pb.SimpleBank.RefreshToken:input_type -> pb.RefreshTokenRequest
+	18, // 50: pb.SimpleBank.Logout:input_type -> pb.LogoutRequest
+	20, // 51: pb.SimpleBank.CreateAccount:input_type -> pb.CreateAccountRequest
+	22, // 52: pb.SimpleBank.GetAccount:input_type -> pb.GetAccountRequest
+	25, // 53: pb.SimpleBank.ListAccounts:input_type -> pb.ListAccountsRequest
+	28, // 54: pb.SimpleBank.AdminFindUser:input_type -> pb.AdminFindUserRequest
+	30, // 55: pb.SimpleBank.AdminGetUser:input_type -> pb.AdminGetUserRequest
+	32, // 56: pb.SimpleBank.AdminListUserAccounts:input_type -> pb.AdminListUserAccountsRequest
+	34, // 57: pb.SimpleBank.AdminGetAccount:input_type -> pb.AdminGetAccountRequest
+	36, // 58: pb.SimpleBank.AdminChangeAccountStatus:input_type -> pb.AdminChangeAccountStatusRequest
+	39, // 59: pb.SimpleBank.AdminListStatusChanges:input_type -> pb.AdminListStatusChangesRequest
+	42, // 60: pb.SimpleBank.AdminGetTransferLimits:input_type -> pb.AdminGetTransferLimitsRequest
+	44, // 61: pb.SimpleBank.AdminSetTransferLimits:input_type -> pb.AdminSetTransferLimitsRequest
+	46, // 62: pb.SimpleBank.AdminSetOverdraftLimit:input_type -> pb.AdminSetOverdraftLimitRequest
+	48, // 63: pb.SimpleBank.AdminListEntries:input_type -> pb.AdminListEntriesRequest
+	50, // 64: pb.SimpleBank.AdminGetEntry:input_type -> pb.AdminGetEntryRequest
+	52, // 65: pb.SimpleBank.AdminListTransfers:input_type -> pb.AdminListTransfersRequest
+	54, // 66: pb.SimpleBank.AdminGetTransfer:input_type -> pb.AdminGetTransferRequest
+	5,  // 67: pb.SimpleBank.CreateTransfer:output_type -> pb.CreateTransferResponse
+	7,  // 68: pb.SimpleBank.QuoteTransfer:output_type -> pb.QuoteTransferResponse
+	9,  // 69: pb.SimpleBank.Deposit:output_type -> pb.DepositResponse
+	11, // 70: pb.SimpleBank.Withdraw:output_type -> pb.WithdrawResponse
+	13, // 71: pb.SimpleBank.QuoteWithdraw:output_type -> pb.QuoteWithdrawResponse
+	17, // 72: pb.SimpleBank.Register:output_type -> pb.TokenResponse
+	17, // 73: pb.SimpleBank.Login:output_type -> pb.TokenResponse
+	17, // 74: pb.SimpleBank.RefreshToken:output_type -> pb.TokenResponse
+	19, // 75: pb.SimpleBank.Logout:output_type -> pb.LogoutResponse
+	21, // 76: pb.SimpleBank.CreateAccount:output_type -> pb.CreateAccountResponse
+	23, // 77: pb.SimpleBank.GetAccount:output_type -> pb.GetAccountResponse
+	26, // 78: pb.SimpleBank.ListAccounts:output_type -> pb.ListAccountsResponse
+	29, // 79: pb.SimpleBank.AdminFindUser:output_type -> pb.AdminFindUserResponse
+	31, // 80: pb.SimpleBank.AdminGetUser:output_type -> pb.AdminGetUserResponse
+	33, // 81: pb.SimpleBank.AdminListUserAccounts:output_type -> pb.AdminListUserAccountsResponse
+	35, // 82: pb.SimpleBank.AdminGetAccount:output_type -> pb.AdminGetAccountResponse
+	37, // 83: pb.SimpleBank.AdminChangeAccountStatus:output_type -> pb.AdminChangeAccountStatusResponse
+	40, // 84: pb.SimpleBank.AdminListStatusChanges:output_type -> pb.AdminListStatusChangesResponse
+	43, // 85: pb.SimpleBank.AdminGetTransferLimits:output_type -> pb.AdminGetTransferLimitsResponse
+	45, // 86: pb.SimpleBank.AdminSetTransferLimits:output_type -> pb.AdminSetTransferLimitsResponse
+	47, // 87: pb.SimpleBank.AdminSetOverdraftLimit:output_type -> pb.AdminSetOverdraftLimitResponse
+	49, // 88: pb.SimpleBank.AdminListEntries:output_type -> pb.AdminListEntriesResponse
+	51, // 89: pb.SimpleBank.AdminGetEntry:output_type -> pb.AdminGetEntryResponse
+	53, // 90: pb.SimpleBank.AdminListTransfers:output_type -> pb.AdminListTransfersResponse
+	55, // 91: pb.SimpleBank.AdminGetTransfer:output_type -> pb.AdminGetTransferResponse
+	67, // [67:92] is the sub-list for method output_type
+	42, // [42:67] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
 }
 
 func init() { file_proto_transfer_service_proto_init() }
@@ -484,13 +3855,15 @@ func file_proto_transfer_service_proto_init() {
 	if File_proto_transfer_service_proto != nil {
 		return
 	}
+	file_proto_transfer_service_proto_msgTypes[2].OneofWrappers = []any{}
+	file_proto_transfer_service_proto_msgTypes[44].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_transfer_service_proto_rawDesc), len(file_proto_transfer_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   56,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,7 +19,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SimpleBank_CreateTransfer_FullMethodName = "/pb.SimpleBank/CreateTransfer"
+	SimpleBank_CreateTransfer_FullMethodName           = "/pb.SimpleBank/CreateTransfer"
+	SimpleBank_QuoteTransfer_FullMethodName            = "/pb.SimpleBank/QuoteTransfer"
+	SimpleBank_Deposit_FullMethodName                  = "/pb.SimpleBank/Deposit"
+	SimpleBank_Withdraw_FullMethodName                 = "/pb.SimpleBank/Withdraw"
+	SimpleBank_QuoteWithdraw_FullMethodName            = "/pb.SimpleBank/QuoteWithdraw"
+	SimpleBank_Register_FullMethodName                 = "/pb.SimpleBank/Register"
+	SimpleBank_Login_FullMethodName                    = "/pb.SimpleBank/Login"
+	SimpleBank_RefreshToken_FullMethodName             = "/pb.SimpleBank/RefreshToken"
+	SimpleBank_Logout_FullMethodName                   = "/pb.SimpleBank/Logout"
+	SimpleBank_CreateAccount_FullMethodName            = "/pb.SimpleBank/CreateAccount"
+	SimpleBank_GetAccount_FullMethodName               = "/pb.SimpleBank/GetAccount"
+	SimpleBank_ListAccounts_FullMethodName             = "/pb.SimpleBank/ListAccounts"
+	SimpleBank_AdminFindUser_FullMethodName            = "/pb.SimpleBank/AdminFindUser"
+	SimpleBank_AdminGetUser_FullMethodName             = "/pb.SimpleBank/AdminGetUser"
+	SimpleBank_AdminListUserAccounts_FullMethodName    = "/pb.SimpleBank/AdminListUserAccounts"
+	SimpleBank_AdminGetAccount_FullMethodName          = "/pb.SimpleBank/AdminGetAccount"
+	SimpleBank_AdminChangeAccountStatus_FullMethodName = "/pb.SimpleBank/AdminChangeAccountStatus"
+	SimpleBank_AdminListStatusChanges_FullMethodName   = "/pb.SimpleBank/AdminListStatusChanges"
+	SimpleBank_AdminGetTransferLimits_FullMethodName   = "/pb.SimpleBank/AdminGetTransferLimits"
+	SimpleBank_AdminSetTransferLimits_FullMethodName   = "/pb.SimpleBank/AdminSetTransferLimits"
+	SimpleBank_AdminSetOverdraftLimit_FullMethodName   = "/pb.SimpleBank/AdminSetOverdraftLimit"
+	SimpleBank_AdminListEntries_FullMethodName         = "/pb.SimpleBank/AdminListEntries"
+	SimpleBank_AdminGetEntry_FullMethodName            = "/pb.SimpleBank/AdminGetEntry"
+	SimpleBank_AdminListTransfers_FullMethodName       = "/pb.SimpleBank/AdminListTransfers"
+	SimpleBank_AdminGetTransfer_FullMethodName         = "/pb.SimpleBank/AdminGetTransfer"
 )
 
 // SimpleBankClient is the client API for SimpleBank service.
@@ -27,6 +51,32 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SimpleBankClient interface {
 	CreateTransfer(ctx context.Context, in *CreateTransferRequest, opts ...grpc.CallOption) (*CreateTransferResponse, error)
+	QuoteTransfer(ctx context.Context, in *QuoteTransferRequest, opts ...grpc.CallOption) (*QuoteTransferResponse, error)
+	Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositResponse, error)
+	Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
+	QuoteWithdraw(ctx context.Context, in *QuoteWithdrawRequest, opts ...grpc.CallOption) (*QuoteWithdrawResponse, error)
+	// Public
+	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*TokenResponse, error)
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*TokenResponse, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*TokenResponse, error)
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
+	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
+	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error)
+	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
+	// Admin (role: admin)
+	AdminFindUser(ctx context.Context, in *AdminFindUserRequest, opts ...grpc.CallOption) (*AdminFindUserResponse, error)
+	AdminGetUser(ctx context.Context, in *AdminGetUserRequest, opts ...grpc.CallOption) (*AdminGetUserResponse, error)
+	AdminListUserAccounts(ctx context.Context, in *AdminListUserAccountsRequest, opts ...grpc.CallOption) (*AdminListUserAccountsResponse, error)
+	AdminGetAccount(ctx context.Context, in *AdminGetAccountRequest, opts ...grpc.CallOption) (*AdminGetAccountResponse, error)
+	AdminChangeAccountStatus(ctx context.Context, in *AdminChangeAccountStatusRequest, opts ...grpc.CallOption) (*AdminChangeAccountStatusResponse, error)
+	AdminListStatusChanges(ctx context.Context, in *AdminListStatusChangesRequest, opts ...grpc.CallOption) (*AdminListStatusChangesResponse, error)
+	AdminGetTransferLimits(ctx context.Context, in *AdminGetTransferLimitsRequest, opts ...grpc.CallOption) (*AdminGetTransferLimitsResponse, error)
+	AdminSetTransferLimits(ctx context.Context, in *AdminSetTransferLimitsRequest, opts ...grpc.CallOption) (*AdminSetTransferLimitsResponse, error)
+	AdminSetOverdraftLimit(ctx context.Context, in *AdminSetOverdraftLimitRequest, opts ...grpc.CallOption) (*AdminSetOverdraftLimitResponse, error)
+	AdminListEntries(ctx context.Context, in *AdminListEntriesRequest, opts ...grpc.CallOption) (*AdminListEntriesResponse, error)
+	AdminGetEntry(ctx context.Context, in *AdminGetEntryRequest, opts ...grpc.CallOption) (*AdminGetEntryResponse, error)
+	AdminListTransfers(ctx context.Context, in *AdminListTransfersRequest, opts ...grpc.CallOption) (*AdminListTransfersResponse, error)
+	AdminGetTransfer(ctx context.Context, in *AdminGetTransferRequest, opts ...grpc.CallOption) (*AdminGetTransferResponse, error)
 }
 
 type simpleBankClient struct {
@@ -47,11 +97,277 @@ func (c *simpleBankClient) CreateTransfer(ctx context.Context, in *CreateTransfe
 	return out, nil
 }
 
+func (c *simpleBankClient) QuoteTransfer(ctx context.Context, in *QuoteTransferRequest, opts ...grpc.CallOption) (*QuoteTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteTransferResponse)
+	err := c.cc.Invoke(ctx, SimpleBank_QuoteTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankClient) Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DepositResponse)
+	err := c.cc.Invoke(ctx, SimpleBank_Deposit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankClient) Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*WithdrawResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WithdrawResponse)
+	err := c.cc.Invoke(ctx, SimpleBank_Withdraw_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankClient) QuoteWithdraw(ctx context.Context, in *QuoteWithdrawRequest, opts ...grpc.CallOption) (*QuoteWithdrawResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteWithdrawResponse)
+	err := c.cc.Invoke(ctx, SimpleBank_QuoteWithdraw_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*TokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TokenResponse)
+	err := c.cc.Invoke(ctx, SimpleBank_Register_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*TokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TokenResponse)
+	err := c.cc.Invoke(ctx, SimpleBank_Login_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*TokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TokenResponse)
+	err := c.cc.Invoke(ctx, SimpleBank_RefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogoutResponse)
+	err := c.cc.Invoke(ctx, SimpleBank_Logout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankClient) CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAccountResponse)
+	err := c.cc.Invoke(ctx, SimpleBank_CreateAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankClient) GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountResponse)
+	err := c.cc.Invoke(ctx, SimpleBank_GetAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountsResponse)
+	err := c.cc.Invoke(ctx, SimpleBank_ListAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankClient) AdminFindUser(ctx context.Context, in *AdminFindUserRequest, opts ...grpc.CallOption) (*AdminFindUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminFindUserResponse)
+	err := c.cc.Invoke(ctx, SimpleBank_AdminFindUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankClient) AdminGetUser(ctx context.Context, in *AdminGetUserRequest, opts ...grpc.CallOption) (*AdminGetUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminGetUserResponse)
+	err := c.cc.Invoke(ctx, SimpleBank_AdminGetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankClient) AdminListUserAccounts(ctx context.Context, in *AdminListUserAccountsRequest, opts ...grpc.CallOption) (*AdminListUserAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminListUserAccountsResponse)
+	err := c.cc.Invoke(ctx, SimpleBank_AdminListUserAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankClient) AdminGetAccount(ctx context.Context, in *AdminGetAccountRequest, opts ...grpc.CallOption) (*AdminGetAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminGetAccountResponse)
+	err := c.cc.Invoke(ctx, SimpleBank_AdminGetAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankClient) AdminChangeAccountStatus(ctx context.Context, in *AdminChangeAccountStatusRequest, opts ...grpc.CallOption) (*AdminChangeAccountStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminChangeAccountStatusResponse)
+	err := c.cc.Invoke(ctx, SimpleBank_AdminChangeAccountStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankClient) AdminListStatusChanges(ctx context.Context, in *AdminListStatusChangesRequest, opts ...grpc.CallOption) (*AdminListStatusChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminListStatusChangesResponse)
+	err := c.cc.Invoke(ctx, SimpleBank_AdminListStatusChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankClient) AdminGetTransferLimits(ctx context.Context, in *AdminGetTransferLimitsRequest, opts ...grpc.CallOption) (*AdminGetTransferLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminGetTransferLimitsResponse)
+	err := c.cc.Invoke(ctx, SimpleBank_AdminGetTransferLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankClient) AdminSetTransferLimits(ctx context.Context, in *AdminSetTransferLimitsRequest, opts ...grpc.CallOption) (*AdminSetTransferLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminSetTransferLimitsResponse)
+	err := c.cc.Invoke(ctx, SimpleBank_AdminSetTransferLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankClient) AdminSetOverdraftLimit(ctx context.Context, in *AdminSetOverdraftLimitRequest, opts ...grpc.CallOption) (*AdminSetOverdraftLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminSetOverdraftLimitResponse)
+	err := c.cc.Invoke(ctx, SimpleBank_AdminSetOverdraftLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankClient) AdminListEntries(ctx context.Context, in *AdminListEntriesRequest, opts ...grpc.CallOption) (*AdminListEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminListEntriesResponse)
+	err := c.cc.Invoke(ctx, SimpleBank_AdminListEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankClient) AdminGetEntry(ctx context.Context, in *AdminGetEntryRequest, opts ...grpc.CallOption) (*AdminGetEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminGetEntryResponse)
+	err := c.cc.Invoke(ctx, SimpleBank_AdminGetEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankClient) AdminListTransfers(ctx context.Context, in *AdminListTransfersRequest, opts ...grpc.CallOption) (*AdminListTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminListTransfersResponse)
+	err := c.cc.Invoke(ctx, SimpleBank_AdminListTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankClient) AdminGetTransfer(ctx context.Context, in *AdminGetTransferRequest, opts ...grpc.CallOption) (*AdminGetTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminGetTransferResponse)
+	err := c.cc.Invoke(ctx, SimpleBank_AdminGetTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SimpleBankServer is the server API for SimpleBank service.
 // All implementations must embed UnimplementedSimpleBankServer
 // for forward compatibility.
 type SimpleBankServer interface {
 	CreateTransfer(context.Context, *CreateTransferRequest) (*CreateTransferResponse, error)
+	QuoteTransfer(context.Context, *QuoteTransferRequest) (*QuoteTransferResponse, error)
+	Deposit(context.Context, *DepositRequest) (*DepositResponse, error)
+	Withdraw(context.Context, *WithdrawRequest) (*WithdrawResponse, error)
+	QuoteWithdraw(context.Context, *QuoteWithdrawRequest) (*QuoteWithdrawResponse, error)
+	// Public
+	Register(context.Context, *RegisterRequest) (*TokenResponse, error)
+	Login(context.Context, *LoginRequest) (*TokenResponse, error)
+	RefreshToken(context.Context, *RefreshTokenRequest) (*TokenResponse, error)
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
+	GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error)
+	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
+	// Admin (role: admin)
+	AdminFindUser(context.Context, *AdminFindUserRequest) (*AdminFindUserResponse, error)
+	AdminGetUser(context.Context, *AdminGetUserRequest) (*AdminGetUserResponse, error)
+	AdminListUserAccounts(context.Context, *AdminListUserAccountsRequest) (*AdminListUserAccountsResponse, error)
+	AdminGetAccount(context.Context, *AdminGetAccountRequest) (*AdminGetAccountResponse, error)
+	AdminChangeAccountStatus(context.Context, *AdminChangeAccountStatusRequest) (*AdminChangeAccountStatusResponse, error)
+	AdminListStatusChanges(context.Context, *AdminListStatusChangesRequest) (*AdminListStatusChangesResponse, error)
+	AdminGetTransferLimits(context.Context, *AdminGetTransferLimitsRequest) (*AdminGetTransferLimitsResponse, error)
+	AdminSetTransferLimits(context.Context, *AdminSetTransferLimitsRequest) (*AdminSetTransferLimitsResponse, error)
+	AdminSetOverdraftLimit(context.Context, *AdminSetOverdraftLimitRequest) (*AdminSetOverdraftLimitResponse, error)
+	AdminListEntries(context.Context, *AdminListEntriesRequest) (*AdminListEntriesResponse, error)
+	AdminGetEntry(context.Context, *AdminGetEntryRequest) (*AdminGetEntryResponse, error)
+	AdminListTransfers(context.Context, *AdminListTransfersRequest) (*AdminListTransfersResponse, error)
+	AdminGetTransfer(context.Context, *AdminGetTransferRequest) (*AdminGetTransferResponse, error)
 	mustEmbedUnimplementedSimpleBankServer()
 }
 
@@ -65,6 +381,78 @@ type UnimplementedSimpleBankServer struct{}
 func (UnimplementedSimpleBankServer) CreateTransfer(context.Context, *CreateTransferRequest) (*CreateTransferResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateTransfer not implemented")
 }
+func (UnimplementedSimpleBankServer) QuoteTransfer(context.Context, *QuoteTransferRequest) (*QuoteTransferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method QuoteTransfer not implemented")
+}
+func (UnimplementedSimpleBankServer) Deposit(context.Context, *DepositRequest) (*DepositResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Deposit not implemented")
+}
+func (UnimplementedSimpleBankServer) Withdraw(context.Context, *WithdrawRequest) (*WithdrawResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Withdraw not implemented")
+}
+func (UnimplementedSimpleBankServer) QuoteWithdraw(context.Context, *QuoteWithdrawRequest) (*QuoteWithdrawResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method QuoteWithdraw not implemented")
+}
+func (UnimplementedSimpleBankServer) Register(context.Context, *RegisterRequest) (*TokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedSimpleBankServer) Login(context.Context, *LoginRequest) (*TokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedSimpleBankServer) RefreshToken(context.Context, *RefreshTokenRequest) (*TokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedSimpleBankServer) Logout(context.Context, *LogoutRequest) (*LogoutResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedSimpleBankServer) CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAccount not implemented")
+}
+func (UnimplementedSimpleBankServer) GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (UnimplementedSimpleBankServer) ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAccounts not implemented")
+}
+func (UnimplementedSimpleBankServer) AdminFindUser(context.Context, *AdminFindUserRequest) (*AdminFindUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminFindUser not implemented")
+}
+func (UnimplementedSimpleBankServer) AdminGetUser(context.Context, *AdminGetUserRequest) (*AdminGetUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminGetUser not implemented")
+}
+func (UnimplementedSimpleBankServer) AdminListUserAccounts(context.Context, *AdminListUserAccountsRequest) (*AdminListUserAccountsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminListUserAccounts not implemented")
+}
+func (UnimplementedSimpleBankServer) AdminGetAccount(context.Context, *AdminGetAccountRequest) (*AdminGetAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminGetAccount not implemented")
+}
+func (UnimplementedSimpleBankServer) AdminChangeAccountStatus(context.Context, *AdminChangeAccountStatusRequest) (*AdminChangeAccountStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminChangeAccountStatus not implemented")
+}
+func (UnimplementedSimpleBankServer) AdminListStatusChanges(context.Context, *AdminListStatusChangesRequest) (*AdminListStatusChangesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminListStatusChanges not implemented")
+}
+func (UnimplementedSimpleBankServer) AdminGetTransferLimits(context.Context, *AdminGetTransferLimitsRequest) (*AdminGetTransferLimitsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminGetTransferLimits not implemented")
+}
+func (UnimplementedSimpleBankServer) AdminSetTransferLimits(context.Context, *AdminSetTransferLimitsRequest) (*AdminSetTransferLimitsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminSetTransferLimits not implemented")
+}
+func (UnimplementedSimpleBankServer) AdminSetOverdraftLimit(context.Context, *AdminSetOverdraftLimitRequest) (*AdminSetOverdraftLimitResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminSetOverdraftLimit not implemented")
+}
+func (UnimplementedSimpleBankServer) AdminListEntries(context.Context, *AdminListEntriesRequest) (*AdminListEntriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminListEntries not implemented")
+}
+func (UnimplementedSimpleBankServer) AdminGetEntry(context.Context, *AdminGetEntryRequest) (*AdminGetEntryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminGetEntry not implemented")
+}
+func (UnimplementedSimpleBankServer) AdminListTransfers(context.Context, *AdminListTransfersRequest) (*AdminListTransfersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminListTransfers not implemented")
+}
+func (UnimplementedSimpleBankServer) AdminGetTransfer(context.Context, *AdminGetTransferRequest) (*AdminGetTransferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminGetTransfer not implemented")
+}
 func (UnimplementedSimpleBankServer) mustEmbedUnimplementedSimpleBankServer() {}
 func (UnimplementedSimpleBankServer) testEmbeddedByValue()                    {}
 
@@ -104,16 +492,544 @@ func _SimpleBank_CreateTransfer_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-// SimpleBank_ServiceDesc is the grpc.ServiceDesc for SimpleBank service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var SimpleBank_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "pb.SimpleBank",
-	HandlerType: (*SimpleBankServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateTransfer",
-			Handler:    _SimpleBank_CreateTransfer_Handler,
+func _SimpleBank_QuoteTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankServer).QuoteTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBank_QuoteTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankServer).QuoteTransfer(ctx, req.(*QuoteTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBank_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankServer).Deposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBank_Deposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankServer).Deposit(ctx, req.(*DepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBank_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankServer).Withdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBank_Withdraw_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankServer).Withdraw(ctx, req.(*WithdrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBank_QuoteWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteWithdrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankServer).QuoteWithdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBank_QuoteWithdraw_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankServer).QuoteWithdraw(ctx, req.(*QuoteWithdrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBank_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBank_Register_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBank_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBank_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBank_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBank_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBank_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBank_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBank_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBank_CreateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBank_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBank_GetAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankServer).GetAccount(ctx, req.(*GetAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBank_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankServer).ListAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBank_ListAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankServer).ListAccounts(ctx, req.(*ListAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBank_AdminFindUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminFindUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankServer).AdminFindUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBank_AdminFindUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankServer).AdminFindUser(ctx, req.(*AdminFindUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBank_AdminGetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankServer).AdminGetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBank_AdminGetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankServer).AdminGetUser(ctx, req.(*AdminGetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBank_AdminListUserAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListUserAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankServer).AdminListUserAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBank_AdminListUserAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankServer).AdminListUserAccounts(ctx, req.(*AdminListUserAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBank_AdminGetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGetAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankServer).AdminGetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBank_AdminGetAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankServer).AdminGetAccount(ctx, req.(*AdminGetAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBank_AdminChangeAccountStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminChangeAccountStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankServer).AdminChangeAccountStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBank_AdminChangeAccountStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankServer).AdminChangeAccountStatus(ctx, req.(*AdminChangeAccountStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBank_AdminListStatusChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListStatusChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankServer).AdminListStatusChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBank_AdminListStatusChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankServer).AdminListStatusChanges(ctx, req.(*AdminListStatusChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBank_AdminGetTransferLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGetTransferLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankServer).AdminGetTransferLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBank_AdminGetTransferLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankServer).AdminGetTransferLimits(ctx, req.(*AdminGetTransferLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBank_AdminSetTransferLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminSetTransferLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankServer).AdminSetTransferLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBank_AdminSetTransferLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankServer).AdminSetTransferLimits(ctx, req.(*AdminSetTransferLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBank_AdminSetOverdraftLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminSetOverdraftLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankServer).AdminSetOverdraftLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBank_AdminSetOverdraftLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankServer).AdminSetOverdraftLimit(ctx, req.(*AdminSetOverdraftLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBank_AdminListEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankServer).AdminListEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBank_AdminListEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankServer).AdminListEntries(ctx, req.(*AdminListEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBank_AdminGetEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGetEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankServer).AdminGetEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBank_AdminGetEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankServer).AdminGetEntry(ctx, req.(*AdminGetEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBank_AdminListTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankServer).AdminListTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBank_AdminListTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankServer).AdminListTransfers(ctx, req.(*AdminListTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBank_AdminGetTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGetTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankServer).AdminGetTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBank_AdminGetTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankServer).AdminGetTransfer(ctx, req.(*AdminGetTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SimpleBank_ServiceDesc is the grpc.ServiceDesc for SimpleBank service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SimpleBank_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.SimpleBank",
+	HandlerType: (*SimpleBankServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTransfer",
+			Handler:    _SimpleBank_CreateTransfer_Handler,
+		},
+		{
+			MethodName: "QuoteTransfer",
+			Handler:    _SimpleBank_QuoteTransfer_Handler,
+		},
+		{
+			MethodName: "Deposit",
+			Handler:    _SimpleBank_Deposit_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _SimpleBank_Withdraw_Handler,
+		},
+		{
+			MethodName: "QuoteWithdraw",
+			Handler:    _SimpleBank_QuoteWithdraw_Handler,
+		},
+		{
+			MethodName: "Register",
+			Handler:    _SimpleBank_Register_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _SimpleBank_Login_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _SimpleBank_RefreshToken_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _SimpleBank_Logout_Handler,
+		},
+		{
+			MethodName: "CreateAccount",
+			Handler:    _SimpleBank_CreateAccount_Handler,
+		},
+		{
+			MethodName: "GetAccount",
+			Handler:    _SimpleBank_GetAccount_Handler,
+		},
+		{
+			MethodName: "ListAccounts",
+			Handler:    _SimpleBank_ListAccounts_Handler,
+		},
+		{
+			MethodName: "AdminFindUser",
+			Handler:    _SimpleBank_AdminFindUser_Handler,
+		},
+		{
+			MethodName: "AdminGetUser",
+			Handler:    _SimpleBank_AdminGetUser_Handler,
+		},
+		{
+			MethodName: "AdminListUserAccounts",
+			Handler:    _SimpleBank_AdminListUserAccounts_Handler,
+		},
+		{
+			MethodName: "AdminGetAccount",
+			Handler:    _SimpleBank_AdminGetAccount_Handler,
+		},
+		{
+			MethodName: "AdminChangeAccountStatus",
+			Handler:    _SimpleBank_AdminChangeAccountStatus_Handler,
+		},
+		{
+			MethodName: "AdminListStatusChanges",
+			Handler:    _SimpleBank_AdminListStatusChanges_Handler,
+		},
+		{
+			MethodName: "AdminGetTransferLimits",
+			Handler:    _SimpleBank_AdminGetTransferLimits_Handler,
+		},
+		{
+			MethodName: "AdminSetTransferLimits",
+			Handler:    _SimpleBank_AdminSetTransferLimits_Handler,
+		},
+		{
+			MethodName: "AdminSetOverdraftLimit",
+			Handler:    _SimpleBank_AdminSetOverdraftLimit_Handler,
+		},
+		{
+			MethodName: "AdminListEntries",
+			Handler:    _SimpleBank_AdminListEntries_Handler,
+		},
+		{
+			MethodName: "AdminGetEntry",
+			Handler:    _SimpleBank_AdminGetEntry_Handler,
+		},
+		{
+			MethodName: "AdminListTransfers",
+			Handler:    _SimpleBank_AdminListTransfers_Handler,
+		},
+		{
+			MethodName: "AdminGetTransfer",
+			Handler:    _SimpleBank_AdminGetTransfer_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
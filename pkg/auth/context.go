@@ -33,3 +33,38 @@ func GetUserID(ctx context.Context) int64 {
 func SetUserID(ctx context.Context, userID int64) context.Context {
 	return context.WithValue(ctx, consts.ContextUserIDKey, userID)
 }
+
+func GetRole(ctx context.Context) user.Role {
+	role, _ := ctx.Value(consts.ContextUserRoleKey).(user.Role)
+	return role
+}
+
+func SetRole(ctx context.Context, role user.Role) context.Context {
+	return context.WithValue(ctx, consts.ContextUserRoleKey, role)
+}
+
+// HasRole reports whether the caller holds any of roles.
+func HasRole(ctx context.Context, roles ...user.Role) bool {
+	role := GetRole(ctx)
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// Client describes the device behind a request, recorded on its session.
+type Client struct {
+	UserAgent string
+	IP        string
+}
+
+func GetClient(ctx context.Context) Client {
+	client, _ := ctx.Value(consts.ContextClientKey).(Client)
+	return client
+}
+
+func SetClient(ctx context.Context, client Client) context.Context {
+	return context.WithValue(ctx, consts.ContextClientKey, client)
+}
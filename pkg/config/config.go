@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/caarlos0/env/v11"
 	"github.com/codepnw/simple-bank/pkg/utils/helper"
@@ -9,16 +10,25 @@ import (
 )
 
 type EnvConfig struct {
-	Server ServerConfig `envPrefix:"SERVER_"`
-	DB     DBConfig     `envPrefix:"DB_"`
-	JWT    JWTConfig    `envPrefix:"JWT_"`
-	Paseto PasetoConfig `envPrefix:"PASETO_"`
+	Server    ServerConfig    `envPrefix:"SERVER_"`
+	DB        DBConfig        `envPrefix:"DB_"`
+	JWT       JWTConfig       `envPrefix:"JWT_"`
+	Paseto    PasetoConfig    `envPrefix:"PASETO_"`
+	Auth      AuthConfig      `envPrefix:"AUTH_"`
+	FX        FXConfig        `envPrefix:"FX_"`
+	Currency  CurrencyConfig  `envPrefix:"CURRENCY_"`
+	Scheduler SchedulerConfig `envPrefix:"SCHEDULER_"`
+	Hold      HoldConfig      `envPrefix:"HOLD_"`
+	Reconcile ReconcileConfig `envPrefix:"RECONCILE_"`
+	Interest  InterestConfig  `envPrefix:"INTEREST_"`
 }
 
 type ServerConfig struct {
 	HTTPAddr   string `env:"HTTP_ADDRESS" envDefault:"localhost:8080"`
 	HTTPPrefix string `env:"HTTP_PREFIX" envDefault:"/api/v1"`
 	GrpcAddr   string `env:"GRPC_ADDRESS" envDefault:"localhost:9090"`
+	// Optional JSON gateway for the gRPC service; empty keeps it off.
+	GatewayAddr string `env:"GATEWAY_ADDRESS"`
 }
 
 type DBConfig struct {
@@ -39,6 +49,11 @@ type PasetoConfig struct {
 	SymmetricKey string `env:"SYMMETRIC_KEY" validate:"required,len=32"`
 }
 
+type AuthConfig struct {
+	// HMAC key for the refresh token hashes stored in sessions.
+	RefreshTokenSecret string `env:"REFRESH_TOKEN_SECRET" validate:"required,min=32"`
+}
+
 func LoadEnv(path string) (*EnvConfig, error) {
 	godotenv.Load(path)
 
@@ -52,3 +67,50 @@ func LoadEnv(path string) (*EnvConfig, error) {
 	}
 	return cfg, nil
 }
+
+type FXConfig struct {
+	// JSON file of rates, e.g. {"USD/THB": "36.5"}. Empty uses built-in rates.
+	RatesFile string `env:"RATES_FILE"`
+}
+
+type CurrencyConfig struct {
+	// JSON file of currencies. Empty loads the currencies table.
+	File string `env:"FILE"`
+}
+
+type SchedulerConfig struct {
+	// Run the scheduled transfers worker in this process. Instances can all
+	// run it; work is claimed with SKIP LOCKED. Hold expiry has its own
+	// always-on job (HoldConfig) and doesn't depend on Enabled.
+	Enabled     bool          `env:"ENABLED" envDefault:"true"`
+	Interval    time.Duration `env:"INTERVAL" envDefault:"30s" validate:"min=1s"`
+	BatchSize   int           `env:"BATCH_SIZE" envDefault:"20" validate:"min=1"`
+	MaxAttempts int           `env:"MAX_ATTEMPTS" envDefault:"3" validate:"min=1"`
+	// Attempt n of a failed occurrence waits n * RetryDelay.
+	RetryDelay time.Duration `env:"RETRY_DELAY" envDefault:"5m"`
+}
+
+type HoldConfig struct {
+	// How long an uncaptured hold reserves funds. Expired holds are released
+	// every ExpiryInterval; the job always runs, so reserved funds come back
+	// whether or not the scheduler is enabled.
+	TTL            time.Duration `env:"TTL" envDefault:"168h" validate:"min=1m"`
+	ExpiryInterval time.Duration `env:"EXPIRY_INTERVAL" envDefault:"1m" validate:"min=1s"`
+	BatchSize      int           `env:"BATCH_SIZE" envDefault:"100" validate:"min=1"`
+}
+
+type ReconcileConfig struct {
+	// Check balances against entries every Interval and log discrepancies.
+	// Zero keeps the job off; corrections are only made by cmd/reconcile.
+	Interval time.Duration `env:"INTERVAL" envDefault:"0"`
+}
+
+type InterestConfig struct {
+	// Accrue daily interest on savings accounts and post last month's every
+	// Interval; zero keeps the job off. Each run also accrues the
+	// CatchUpDays before today that are still missing, so a stopped worker
+	// doesn't skip days.
+	Interval    time.Duration `env:"INTERVAL" envDefault:"1h"`
+	CatchUpDays int           `env:"CATCH_UP_DAYS" envDefault:"7" validate:"min=1"`
+	BatchSize   int           `env:"BATCH_SIZE" envDefault:"100" validate:"min=1"`
+}
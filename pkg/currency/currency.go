@@ -0,0 +1,119 @@
+package currency
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+)
+
+// Currency describes an ISO 4217 currency. Exponent is the number of minor
+// unit digits, so amounts are stored as integers (THB 2: 100 = 1.00 THB).
+type Currency struct {
+	Code     string `json:"code"`
+	Exponent int    `json:"exponent"`
+	Symbol   string `json:"symbol"`
+	Enabled  bool   `json:"enabled"`
+}
+
+const maxExponent = 4
+
+// Registry is the read-only set of currencies the bank knows about.
+type Registry struct {
+	byCode map[string]*Currency
+}
+
+func NewRegistry(list []*Currency) (*Registry, error) {
+	r := &Registry{byCode: make(map[string]*Currency, len(list))}
+
+	for _, c := range list {
+		code := strings.ToUpper(c.Code)
+		if len(code) != 3 || strings.Trim(code, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+			return nil, fmt.Errorf("invalid currency code %q", c.Code)
+		}
+		if c.Exponent < 0 || c.Exponent > maxExponent {
+			return nil, fmt.Errorf("invalid exponent %d for %s", c.Exponent, code)
+		}
+		if _, ok := r.byCode[code]; ok {
+			return nil, fmt.Errorf("duplicate currency %s", code)
+		}
+
+		cur := *c
+		cur.Code = code
+		r.byCode[code] = &cur
+	}
+	return r, nil
+}
+
+// Lookup returns an enabled currency; unknown and disabled codes are both
+// reported as errs.ErrInvalidCurrency.
+func (r *Registry) Lookup(code string) (*Currency, error) {
+	c, ok := r.byCode[strings.ToUpper(code)]
+	if !ok || !c.Enabled {
+		return nil, errs.ErrInvalidCurrency
+	}
+	return c, nil
+}
+
+// Get returns a currency even when it is disabled, for formatting existing
+// balances.
+func (r *Registry) Get(code string) (*Currency, bool) {
+	c, ok := r.byCode[strings.ToUpper(code)]
+	return c, ok
+}
+
+// Codes lists the enabled currency codes in alphabetical order.
+func (r *Registry) Codes() []string {
+	codes := make([]string, 0, len(r.byCode))
+	for code, c := range r.byCode {
+		if c.Enabled {
+			codes = append(codes, code)
+		}
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// Format renders an amount in minor units, e.g. 500000 THB -> "฿5,000.00".
+// Unknown codes fall back to the raw amount followed by the code.
+func (r *Registry) Format(amount int64, code string) string {
+	c, ok := r.Get(code)
+	if !ok {
+		return fmt.Sprintf("%d %s", amount, code)
+	}
+	return c.Symbol + c.FormatAmount(amount)
+}
+
+// FormatAmount renders an amount in minor units without the symbol.
+func (c *Currency) FormatAmount(amount int64) string {
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	scale := c.scale()
+	whole := fmt.Sprintf("%d", amount/scale)
+
+	var b strings.Builder
+	b.WriteString(sign)
+	for i, ch := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(ch)
+	}
+	if c.Exponent > 0 {
+		fmt.Fprintf(&b, ".%0*d", c.Exponent, amount%scale)
+	}
+	return b.String()
+}
+
+func (c *Currency) scale() int64 {
+	s := int64(1)
+	for i := 0; i < c.Exponent; i++ {
+		s *= 10
+	}
+	return s
+}
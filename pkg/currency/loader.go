@@ -0,0 +1,82 @@
+package currency
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// LoadFile builds a registry from a JSON array of currencies, e.g.
+// [{"code": "THB", "exponent": 2, "symbol": "฿", "enabled": true}].
+func LoadFile(path string) (*Registry, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read currencies failed: %w", err)
+	}
+
+	list := make([]*Currency, 0)
+	if err = json.Unmarshal(b, &list); err != nil {
+		return nil, fmt.Errorf("parse currencies failed: %w", err)
+	}
+	return NewRegistry(list)
+}
+
+// LoadDB builds a registry from the currencies table.
+func LoadDB(ctx context.Context, db *sql.DB) (*Registry, error) {
+	list, err := queryDB(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return NewRegistry(list)
+}
+
+// CheckDB fails when an enabled currency is missing from the currencies
+// table. Accounts reference that table, so a code loaded from a file alone
+// would pass Lookup and then fail the first account insert.
+func (r *Registry) CheckDB(ctx context.Context, db *sql.DB) error {
+	list, err := queryDB(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	known := make(map[string]bool, len(list))
+	for _, c := range list {
+		known[strings.ToUpper(c.Code)] = true
+	}
+	missing := make([]string, 0)
+	for _, code := range r.Codes() {
+		if !known[code] {
+			missing = append(missing, code)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("currencies missing from the currencies table: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func queryDB(ctx context.Context, db *sql.DB) ([]*Currency, error) {
+	query := `SELECT code, exponent, symbol, enabled FROM currencies ORDER BY code`
+
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("query currencies failed: %w", err)
+	}
+	defer rows.Close()
+
+	list := make([]*Currency, 0)
+	for rows.Next() {
+		c := new(Currency)
+		if err = rows.Scan(&c.Code, &c.Exponent, &c.Symbol, &c.Enabled); err != nil {
+			return nil, err
+		}
+		list = append(list, c)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
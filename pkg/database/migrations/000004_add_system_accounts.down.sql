@@ -0,0 +1,8 @@
+DELETE FROM accounts WHERE type <> 'customer';
+DELETE FROM users WHERE username = 'system';
+
+DROP INDEX IF EXISTS idx_accounts_system_type_currency;
+DROP INDEX IF EXISTS idx_accounts_owner_currency;
+CREATE UNIQUE INDEX idx_accounts_owner_currency ON accounts (owner_id, currency);
+
+ALTER TABLE accounts DROP COLUMN IF EXISTS type;
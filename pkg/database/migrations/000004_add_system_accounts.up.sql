@@ -0,0 +1,27 @@
+-- Account Type: customer accounts and internal system accounts
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS type VARCHAR(20) NOT NULL DEFAULT 'customer';
+
+-- Rule: 1 owner 1 currency (customer accounts only)
+DROP INDEX IF EXISTS idx_accounts_owner_currency;
+CREATE UNIQUE INDEX idx_accounts_owner_currency ON accounts (owner_id, currency) WHERE type = 'customer';
+-- Rule: 1 system account per type and currency
+CREATE UNIQUE INDEX idx_accounts_system_type_currency ON accounts (type, currency) WHERE type <> 'customer';
+
+-- System owner for internal accounts (login disabled). A real user already
+-- named 'system' would own the uncapped system accounts, so stop instead.
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM users WHERE username = 'system') THEN
+        RAISE EXCEPTION 'username "system" is already taken; rename that user before migrating';
+    END IF;
+END $$;
+
+INSERT INTO users (username, password, first_name, last_name, email)
+VALUES ('system', '!', 'Simple', 'Bank', 'system@simplebank.local');
+
+-- Cash / Clearing accounts back deposits and withdrawals
+INSERT INTO accounts (owner_id, balance, currency, type)
+SELECT id, 0, c.currency, 'cash'
+FROM users, (VALUES ('THB'), ('USD')) AS c(currency)
+WHERE username = 'system'
+ON CONFLICT DO NOTHING;
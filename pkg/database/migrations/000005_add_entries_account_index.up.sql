@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS idx_entries_account_created ON entries (account_id, created_at);
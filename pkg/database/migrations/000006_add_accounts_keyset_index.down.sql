@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_accounts_owner_created;
@@ -0,0 +1,2 @@
+-- Keyset pagination: ORDER BY created_at, id within an owner
+CREATE INDEX IF NOT EXISTS idx_accounts_owner_created ON accounts (owner_id, created_at, id);
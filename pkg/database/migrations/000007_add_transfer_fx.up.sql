@@ -0,0 +1,8 @@
+-- Cross-currency transfers: amount is in the source currency, to_amount in the destination currency
+ALTER TABLE transfers ADD COLUMN IF NOT EXISTS to_amount BIGINT;
+ALTER TABLE transfers ADD COLUMN IF NOT EXISTS exchange_rate NUMERIC(18, 6) NOT NULL DEFAULT 1;
+
+UPDATE transfers SET to_amount = amount WHERE to_amount IS NULL;
+
+ALTER TABLE transfers ALTER COLUMN to_amount SET NOT NULL;
+ALTER TABLE transfers ADD CONSTRAINT transfers_to_amount_check CHECK (to_amount > 0);
@@ -0,0 +1,15 @@
+-- Currency registry (ISO 4217); exponent = number of minor unit digits
+CREATE TABLE IF NOT EXISTS currencies (
+    code VARCHAR(3) PRIMARY KEY CHECK (code ~ '^[A-Z]{3}$'),
+    exponent SMALLINT NOT NULL CHECK (exponent BETWEEN 0 AND 4),
+    symbol VARCHAR(8) NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO currencies (code, exponent, symbol) VALUES
+('THB', 2, '฿'),
+('USD', 2, '$')
+ON CONFLICT (code) DO NOTHING;
+
+ALTER TABLE accounts ADD CONSTRAINT accounts_currency_fkey FOREIGN KEY (currency) REFERENCES currencies(code);
@@ -0,0 +1,14 @@
+DROP INDEX IF EXISTS idx_sessions_user_id;
+
+ALTER TABLE sessions
+    DROP COLUMN IF EXISTS last_used_at,
+    DROP COLUMN IF EXISTS client_ip,
+    DROP COLUMN IF EXISTS user_agent;
+
+-- Keep only the latest session per user so user_id can be unique again
+DELETE FROM sessions s
+WHERE EXISTS (SELECT 1 FROM sessions n WHERE n.user_id = s.user_id AND n.id > s.id);
+
+ALTER INDEX IF EXISTS idx_sessions_token RENAME TO idx_auth_token;
+ALTER TABLE sessions ADD CONSTRAINT auth_user_id_key UNIQUE (user_id);
+ALTER TABLE sessions RENAME TO auth;
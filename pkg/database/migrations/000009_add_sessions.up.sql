@@ -0,0 +1,11 @@
+-- One row per device: drop the one-token-per-user rule on auth
+ALTER TABLE auth RENAME TO sessions;
+ALTER TABLE sessions DROP CONSTRAINT IF EXISTS auth_user_id_key;
+ALTER INDEX IF EXISTS idx_auth_token RENAME TO idx_sessions_token;
+
+ALTER TABLE sessions
+    ADD COLUMN user_agent TEXT NOT NULL DEFAULT '',
+    ADD COLUMN client_ip VARCHAR(45) NOT NULL DEFAULT '',
+    ADD COLUMN last_used_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+CREATE INDEX IF NOT EXISTS idx_sessions_user_id ON sessions (user_id);
@@ -0,0 +1,21 @@
+-- Refresh tokens rotated out of a session. A session is a token family:
+-- presenting one of these again means the token was replayed.
+CREATE TABLE IF NOT EXISTS rotated_refresh_tokens (
+    token TEXT PRIMARY KEY,
+    session_id BIGINT NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+    rotated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_rotated_refresh_tokens_session ON rotated_refresh_tokens (session_id);
+
+CREATE TABLE IF NOT EXISTS security_events (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    session_id BIGINT REFERENCES sessions(id) ON DELETE SET NULL,
+    type VARCHAR(50) NOT NULL,
+    user_agent TEXT NOT NULL DEFAULT '',
+    client_ip VARCHAR(45) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_security_events_user ON security_events (user_id, created_at);
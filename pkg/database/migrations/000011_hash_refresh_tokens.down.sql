@@ -0,0 +1,11 @@
+-- Hashes cannot be reversed: sessions that were already hashed are deleted.
+TRUNCATE rotated_refresh_tokens;
+ALTER TABLE rotated_refresh_tokens ALTER COLUMN token_hash TYPE TEXT;
+ALTER TABLE rotated_refresh_tokens RENAME COLUMN token_hash TO token;
+
+DELETE FROM sessions WHERE legacy_token IS NULL;
+DROP INDEX IF EXISTS idx_sessions_token_hash;
+ALTER TABLE sessions DROP COLUMN token_hash;
+ALTER TABLE sessions ALTER COLUMN legacy_token SET NOT NULL;
+ALTER TABLE sessions RENAME COLUMN legacy_token TO token;
+CREATE INDEX IF NOT EXISTS idx_sessions_token ON sessions(token);
@@ -0,0 +1,15 @@
+-- Refresh tokens are stored as HMAC-SHA256 hashes. The key lives in the app
+-- config, so existing rows are hashed by the app on startup: the raw value
+-- moves to legacy_token and is cleared once token_hash is filled in.
+ALTER TABLE sessions RENAME COLUMN token TO legacy_token;
+ALTER TABLE sessions ALTER COLUMN legacy_token DROP NOT NULL;
+ALTER TABLE sessions ADD COLUMN token_hash CHAR(64);
+
+DROP INDEX IF EXISTS idx_sessions_token;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_sessions_token_hash ON sessions (token_hash);
+
+-- Rotated tokens only feed reuse detection; old plaintext rows are dropped
+-- rather than carried over.
+TRUNCATE rotated_refresh_tokens;
+ALTER TABLE rotated_refresh_tokens RENAME COLUMN token TO token_hash;
+ALTER TABLE rotated_refresh_tokens ALTER COLUMN token_hash TYPE CHAR(64);
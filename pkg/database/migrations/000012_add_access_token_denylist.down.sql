@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS revoked_access_tokens;
+
+ALTER TABLE sessions
+    DROP COLUMN IF EXISTS access_expires_at,
+    DROP COLUMN IF EXISTS access_jti;
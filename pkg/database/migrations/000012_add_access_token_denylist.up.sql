@@ -0,0 +1,13 @@
+-- Current access token of each session, denied when the session is revoked
+ALTER TABLE sessions
+    ADD COLUMN access_jti VARCHAR(64) NOT NULL DEFAULT '',
+    ADD COLUMN access_expires_at TIMESTAMPTZ;
+
+-- Access tokens revoked before they expire (shared by all instances)
+CREATE TABLE IF NOT EXISTS revoked_access_tokens (
+    jti VARCHAR(64) PRIMARY KEY,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_revoked_access_tokens_expires ON revoked_access_tokens (expires_at);
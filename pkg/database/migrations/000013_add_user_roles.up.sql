@@ -0,0 +1,5 @@
+-- Role of each user; admins are granted by hand, e.g.
+--   UPDATE users SET role = 'admin' WHERE email = '...';
+ALTER TABLE users
+    ADD COLUMN role VARCHAR(20) NOT NULL DEFAULT 'customer'
+    CONSTRAINT users_role_check CHECK (role IN ('customer', 'admin'));
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS account_status_changes;
+
+DROP INDEX IF EXISTS idx_accounts_owner_currency;
+CREATE UNIQUE INDEX idx_accounts_owner_currency ON accounts (owner_id, currency) WHERE type = 'customer';
+
+ALTER TABLE accounts DROP COLUMN IF EXISTS status;
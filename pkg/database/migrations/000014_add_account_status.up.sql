@@ -0,0 +1,21 @@
+-- Account lifecycle: only active accounts move money, closed is final
+ALTER TABLE accounts
+    ADD COLUMN status VARCHAR(10) NOT NULL DEFAULT 'active'
+    CONSTRAINT accounts_status_check CHECK (status IN ('active', 'frozen', 'closed'));
+
+-- A closed account no longer holds its owner's currency slot
+DROP INDEX IF EXISTS idx_accounts_owner_currency;
+CREATE UNIQUE INDEX idx_accounts_owner_currency ON accounts (owner_id, currency) WHERE type = 'customer' AND status <> 'closed';
+
+-- Audit trail of status changes
+CREATE TABLE IF NOT EXISTS account_status_changes (
+    id BIGSERIAL PRIMARY KEY,
+    account_id BIGINT NOT NULL REFERENCES accounts(id),
+    from_status VARCHAR(10) NOT NULL,
+    to_status VARCHAR(10) NOT NULL,
+    reason TEXT NOT NULL,
+    changed_by BIGINT NOT NULL REFERENCES users(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_account_status_changes_account ON account_status_changes (account_id, created_at);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_transfers_from_created;
+
+DROP TABLE IF EXISTS account_transfer_limits;
+DROP TABLE IF EXISTS transfer_limits;
@@ -0,0 +1,26 @@
+-- Velocity controls per currency; NULL means no limit
+CREATE TABLE IF NOT EXISTS transfer_limits (
+    currency VARCHAR(3) PRIMARY KEY REFERENCES currencies(code),
+    max_amount BIGINT CHECK (max_amount > 0), -- per transfer
+    daily_amount BIGINT CHECK (daily_amount > 0), -- rolling 24h outgoing total
+    daily_count INT CHECK (daily_count > 0), -- rolling 24h outgoing transfers
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO transfer_limits (currency, max_amount, daily_amount, daily_count) VALUES
+('THB', 200000000, 500000000, 50),
+('USD', 5000000, 15000000, 50)
+ON CONFLICT (currency) DO NOTHING;
+
+-- Per-account overrides; a NULL column falls back to the currency limit
+CREATE TABLE IF NOT EXISTS account_transfer_limits (
+    account_id BIGINT PRIMARY KEY REFERENCES accounts(id),
+    max_amount BIGINT CHECK (max_amount > 0),
+    daily_amount BIGINT CHECK (daily_amount > 0),
+    daily_count INT CHECK (daily_count > 0),
+    updated_by BIGINT NOT NULL REFERENCES users(id),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Rolling window lookups of an account's outgoing transfers
+CREATE INDEX IF NOT EXISTS idx_transfers_from_created ON transfers (from_account_id, created_at);
@@ -0,0 +1,36 @@
+-- Standing orders: one-off (schedule = '') or cron recurring
+CREATE TABLE IF NOT EXISTS scheduled_transfers (
+    id BIGSERIAL PRIMARY KEY,
+    owner_id BIGINT NOT NULL REFERENCES users(id),
+    from_account_id BIGINT NOT NULL REFERENCES accounts(id),
+    to_account_id BIGINT NOT NULL REFERENCES accounts(id),
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    currency VARCHAR(3) NOT NULL REFERENCES currencies(code),
+    schedule VARCHAR(100) NOT NULL DEFAULT '',
+    status VARCHAR(10) NOT NULL DEFAULT 'active'
+        CHECK (status IN ('active', 'paused', 'completed', 'cancelled', 'failed')),
+    scheduled_for TIMESTAMPTZ, -- occurrence being executed; NULL once finished
+    next_run_at TIMESTAMPTZ, -- when the worker picks it up, later than scheduled_for on retries
+    attempts INT NOT NULL DEFAULT 0, -- failed attempts at scheduled_for
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Worker: due active jobs
+CREATE INDEX IF NOT EXISTS idx_scheduled_transfers_due ON scheduled_transfers (next_run_at) WHERE status = 'active';
+-- Keyset pagination within an owner
+CREATE INDEX IF NOT EXISTS idx_scheduled_transfers_owner_created ON scheduled_transfers (owner_id, created_at, id);
+
+-- Outcome of every execution attempt
+CREATE TABLE IF NOT EXISTS scheduled_transfer_runs (
+    id BIGSERIAL PRIMARY KEY,
+    scheduled_transfer_id BIGINT NOT NULL REFERENCES scheduled_transfers(id),
+    scheduled_for TIMESTAMPTZ NOT NULL,
+    attempt INT NOT NULL,
+    status VARCHAR(10) NOT NULL CHECK (status IN ('succeeded', 'failed')),
+    transfer_id BIGINT REFERENCES transfers(id),
+    error_code VARCHAR(64) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_scheduled_transfer_runs_job ON scheduled_transfer_runs (scheduled_transfer_id, created_at);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_transfers_reversal_of;
+ALTER TABLE transfers DROP COLUMN IF EXISTS reversal_of;
@@ -0,0 +1,6 @@
+-- A reversal is a compensating transfer from the original recipient back to
+-- the sender, linked to the transfer it undoes
+ALTER TABLE transfers ADD COLUMN IF NOT EXISTS reversal_of BIGINT REFERENCES transfers(id);
+
+-- At most one reversal per transfer, also under concurrent requests
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transfers_reversal_of ON transfers (reversal_of) WHERE reversal_of IS NOT NULL;
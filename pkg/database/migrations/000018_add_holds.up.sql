@@ -0,0 +1,23 @@
+-- Funds reserved by open holds; available balance = balance - held_amount
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS held_amount BIGINT NOT NULL DEFAULT 0 CHECK (held_amount >= 0);
+
+-- Authorizations: reserve funds now, capture into a transfer or void later
+CREATE TABLE IF NOT EXISTS holds (
+    id BIGSERIAL PRIMARY KEY,
+    account_id BIGINT NOT NULL REFERENCES accounts(id),
+    to_account_id BIGINT NOT NULL REFERENCES accounts(id),
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    currency VARCHAR(3) NOT NULL REFERENCES currencies(code),
+    status VARCHAR(10) NOT NULL DEFAULT 'authorized'
+        CHECK (status IN ('authorized', 'captured', 'voided', 'expired')),
+    captured_amount BIGINT NOT NULL DEFAULT 0,
+    transfer_id BIGINT REFERENCES transfers(id), -- set on capture
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Worker: open holds past their expiry
+CREATE INDEX IF NOT EXISTS idx_holds_expiring ON holds (expires_at) WHERE status = 'authorized';
+-- Keyset pagination within an account
+CREATE INDEX IF NOT EXISTS idx_holds_account_created ON holds (account_id, created_at, id);
@@ -0,0 +1,10 @@
+DROP TRIGGER IF EXISTS entries_append_only ON entries;
+DROP FUNCTION IF EXISTS reject_entry_change();
+DROP TRIGGER IF EXISTS entries_journal_balanced ON entries;
+DROP FUNCTION IF EXISTS check_journal_balanced();
+
+-- The opening adjustments and suspense balances stay; they match the
+-- balances they were derived from
+DROP INDEX IF EXISTS idx_entries_journal;
+ALTER TABLE entries DROP COLUMN IF EXISTS journal_id;
+DROP TABLE IF EXISTS journal_transactions;
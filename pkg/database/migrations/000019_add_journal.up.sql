@@ -0,0 +1,89 @@
+-- System accounts: FX positions for cross-currency transfers, fee revenue and
+-- suspense for unexplained differences
+INSERT INTO accounts (owner_id, balance, currency, type)
+SELECT u.id, 0, c.code, t.type
+FROM users u, currencies c, (VALUES ('fx'), ('fees'), ('suspense')) AS t(type)
+WHERE u.username = 'system'
+ON CONFLICT DO NOTHING;
+
+-- Journal: one balanced posting that owns its entry lines
+CREATE TABLE IF NOT EXISTS journal_transactions (
+    id BIGSERIAL PRIMARY KEY,
+    kind VARCHAR(20) NOT NULL
+        CHECK (kind IN ('opening', 'transfer', 'reversal', 'deposit', 'withdrawal')),
+    transfer_id BIGINT REFERENCES transfers(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_journal_transactions_transfer ON journal_transactions (transfer_id) WHERE transfer_id IS NOT NULL;
+
+ALTER TABLE entries ADD COLUMN IF NOT EXISTS journal_id BIGINT REFERENCES journal_transactions(id);
+
+-- Backfill: existing entries move into one opening journal. Balances that
+-- were seeded without entries get an adjusting entry, and each currency is
+-- brought to zero against its suspense account, so from here on every
+-- balance equals the sum of its entries.
+DO $$
+DECLARE
+    opening_id BIGINT;
+BEGIN
+    INSERT INTO journal_transactions (kind) VALUES ('opening') RETURNING id INTO opening_id;
+
+    UPDATE entries SET journal_id = opening_id WHERE journal_id IS NULL;
+
+    INSERT INTO entries (account_id, amount, journal_id)
+    SELECT a.id, a.balance - COALESCE(SUM(e.amount), 0), opening_id
+    FROM accounts a LEFT JOIN entries e ON e.account_id = a.id
+    WHERE a.type <> 'suspense'
+    GROUP BY a.id
+    HAVING a.balance <> COALESCE(SUM(e.amount), 0);
+
+    INSERT INTO entries (account_id, amount, journal_id)
+    SELECT s.id, -SUM(e.amount), opening_id
+    FROM entries e
+    JOIN accounts a ON a.id = e.account_id
+    JOIN accounts s ON s.currency = a.currency AND s.type = 'suspense'
+    WHERE e.journal_id = opening_id
+    GROUP BY s.id
+    HAVING SUM(e.amount) <> 0;
+
+    UPDATE accounts s SET balance = (SELECT COALESCE(SUM(amount), 0) FROM entries WHERE account_id = s.id)
+    WHERE s.type = 'suspense';
+END $$;
+
+ALTER TABLE entries ALTER COLUMN journal_id SET NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_entries_journal ON entries (journal_id);
+
+-- Rule: the entries of a journal sum to zero in each currency. Checked at
+-- commit, once every line of the journal is in.
+CREATE OR REPLACE FUNCTION check_journal_balanced() RETURNS TRIGGER AS $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM entries e JOIN accounts a ON a.id = e.account_id
+        WHERE e.journal_id = NEW.journal_id
+        GROUP BY a.currency
+        HAVING SUM(e.amount) <> 0
+    ) THEN
+        RAISE EXCEPTION 'journal % does not balance', NEW.journal_id
+            USING ERRCODE = 'check_violation', CONSTRAINT = 'entries_journal_balanced';
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER entries_journal_balanced
+AFTER INSERT ON entries
+DEFERRABLE INITIALLY DEFERRED
+FOR EACH ROW EXECUTE FUNCTION check_journal_balanced();
+
+-- Rule: posted entries are never changed; corrections are new journals
+CREATE OR REPLACE FUNCTION reject_entry_change() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'entries are append-only'
+        USING ERRCODE = 'restrict_violation';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER entries_append_only
+BEFORE UPDATE OR DELETE ON entries
+FOR EACH ROW EXECUTE FUNCTION reject_entry_change();
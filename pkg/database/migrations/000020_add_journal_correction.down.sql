@@ -0,0 +1,8 @@
+ALTER TABLE journal_transactions DROP COLUMN IF EXISTS created_by;
+
+-- Entries are append-only, so correction journals stay; they are kept as
+-- opening adjustments under the old kinds
+UPDATE journal_transactions SET kind = 'opening' WHERE kind = 'correction';
+ALTER TABLE journal_transactions DROP CONSTRAINT IF EXISTS journal_transactions_kind_check;
+ALTER TABLE journal_transactions ADD CONSTRAINT journal_transactions_kind_check
+    CHECK (kind IN ('opening', 'transfer', 'reversal', 'deposit', 'withdrawal'));
@@ -0,0 +1,7 @@
+-- Correction journals book reconciliation differences against suspense and
+-- record the admin who opened them
+ALTER TABLE journal_transactions DROP CONSTRAINT IF EXISTS journal_transactions_kind_check;
+ALTER TABLE journal_transactions ADD CONSTRAINT journal_transactions_kind_check
+    CHECK (kind IN ('opening', 'transfer', 'reversal', 'deposit', 'withdrawal', 'correction'));
+
+ALTER TABLE journal_transactions ADD COLUMN IF NOT EXISTS created_by BIGINT REFERENCES users(id);
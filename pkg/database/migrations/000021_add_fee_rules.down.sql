@@ -0,0 +1,2 @@
+ALTER TABLE transfers DROP COLUMN IF EXISTS fee;
+DROP TABLE IF EXISTS fee_rules;
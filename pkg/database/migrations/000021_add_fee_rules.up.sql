@@ -0,0 +1,20 @@
+-- Fee schedules per operation and currency. The rows of one schedule are
+-- tiers: an amount is priced by the row with the highest min_amount it
+-- reaches. No row means no fee.
+CREATE TABLE IF NOT EXISTS fee_rules (
+    id BIGSERIAL PRIMARY KEY,
+    operation VARCHAR(20) NOT NULL CHECK (operation IN ('transfer', 'withdrawal')),
+    currency VARCHAR(3) NOT NULL REFERENCES currencies(code),
+    min_amount BIGINT NOT NULL DEFAULT 0 CHECK (min_amount >= 0),
+    flat BIGINT NOT NULL DEFAULT 0 CHECK (flat >= 0),
+    rate_bps INT NOT NULL DEFAULT 0 CHECK (rate_bps BETWEEN 0 AND 10000), -- 1 bps = 0.01%
+    min_fee BIGINT NOT NULL DEFAULT 0 CHECK (min_fee >= 0), -- 0 = no minimum
+    max_fee BIGINT NOT NULL DEFAULT 0 CHECK (max_fee >= 0), -- 0 = no cap
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (operation, currency, min_amount),
+    CHECK (max_fee = 0 OR max_fee >= min_fee)
+);
+
+-- Fee charged to the sender on top of amount, in the source currency
+ALTER TABLE transfers ADD COLUMN IF NOT EXISTS fee BIGINT NOT NULL DEFAULT 0 CHECK (fee >= 0);
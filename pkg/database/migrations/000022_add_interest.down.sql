@@ -0,0 +1,16 @@
+-- Entries are append-only, so interest journals and the interest accounts
+-- stay; the journals are kept as opening adjustments under the old kinds
+UPDATE journal_transactions SET kind = 'opening' WHERE kind = 'interest';
+ALTER TABLE journal_transactions DROP CONSTRAINT IF EXISTS journal_transactions_kind_check;
+ALTER TABLE journal_transactions ADD CONSTRAINT journal_transactions_kind_check
+    CHECK (kind IN ('opening', 'transfer', 'reversal', 'deposit', 'withdrawal', 'correction'));
+
+DROP TABLE IF EXISTS interest_accruals;
+DROP TABLE IF EXISTS interest_rates;
+
+-- Fails while an owner has an open current and savings account in the same
+-- currency; close one of them first
+DROP INDEX IF EXISTS idx_accounts_owner_currency;
+CREATE UNIQUE INDEX idx_accounts_owner_currency ON accounts (owner_id, currency) WHERE type = 'customer' AND status <> 'closed';
+
+ALTER TABLE accounts DROP COLUMN IF EXISTS product;
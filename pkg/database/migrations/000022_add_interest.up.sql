@@ -0,0 +1,50 @@
+-- Account products: current accounts pay no interest, savings accounts
+-- earn the annual rate of their currency. An owner can hold one open
+-- account per currency and product.
+ALTER TABLE accounts
+    ADD COLUMN IF NOT EXISTS product VARCHAR(20) NOT NULL DEFAULT 'current'
+    CONSTRAINT accounts_product_check CHECK (product IN ('current', 'savings'));
+
+DROP INDEX IF EXISTS idx_accounts_owner_currency;
+CREATE UNIQUE INDEX idx_accounts_owner_currency ON accounts (owner_id, currency, product) WHERE type = 'customer' AND status <> 'closed';
+
+-- Annual rates per product and currency. No row means no interest.
+CREATE TABLE IF NOT EXISTS interest_rates (
+    product VARCHAR(20) NOT NULL CHECK (product IN ('current', 'savings')),
+    currency VARCHAR(3) NOT NULL REFERENCES currencies(code),
+    rate_bps INT NOT NULL CHECK (rate_bps BETWEEN 0 AND 10000), -- 1 bps = 0.01% a year
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (product, currency)
+);
+
+INSERT INTO interest_rates (product, currency, rate_bps) VALUES
+('savings', 'THB', 150),
+('savings', 'USD', 100)
+ON CONFLICT DO NOTHING;
+
+-- One accrual per account per day, on its end-of-day balance (UTC), in
+-- millionths of a minor unit. posted_at is set when a monthly posting pays
+-- it out and journal_id links that posting.
+CREATE TABLE IF NOT EXISTS interest_accruals (
+    account_id BIGINT NOT NULL REFERENCES accounts(id),
+    accrual_date DATE NOT NULL,
+    balance BIGINT NOT NULL,
+    rate_bps INT NOT NULL,
+    amount_micros BIGINT NOT NULL CHECK (amount_micros >= 0),
+    posted_at TIMESTAMPTZ,
+    journal_id BIGINT REFERENCES journal_transactions(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (account_id, accrual_date)
+);
+CREATE INDEX IF NOT EXISTS idx_interest_accruals_unposted ON interest_accruals (account_id, accrual_date) WHERE posted_at IS NULL;
+
+-- Interest is paid out of a house interest-expense account per currency
+INSERT INTO accounts (owner_id, balance, currency, type)
+SELECT u.id, 0, c.code, 'interest'
+FROM users u, currencies c
+WHERE u.username = 'system'
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE journal_transactions DROP CONSTRAINT IF EXISTS journal_transactions_kind_check;
+ALTER TABLE journal_transactions ADD CONSTRAINT journal_transactions_kind_check
+    CHECK (kind IN ('opening', 'transfer', 'reversal', 'deposit', 'withdrawal', 'correction', 'interest'));
@@ -0,0 +1,2 @@
+-- Overdrawn balances stay negative; only the facility goes
+ALTER TABLE accounts DROP COLUMN IF EXISTS overdraft_limit;
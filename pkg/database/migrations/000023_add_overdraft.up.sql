@@ -0,0 +1,5 @@
+-- Overdraft facility: a customer account's balance may go down to
+-- -overdraft_limit. Zero, the default, keeps it from going negative.
+ALTER TABLE accounts
+    ADD COLUMN IF NOT EXISTS overdraft_limit BIGINT NOT NULL DEFAULT 0
+    CONSTRAINT accounts_overdraft_limit_check CHECK (overdraft_limit >= 0);
@@ -0,0 +1,3 @@
+-- Open holds keep their fee reserved in accounts.held_amount; void them
+-- before rolling back
+ALTER TABLE holds DROP COLUMN IF EXISTS fee;
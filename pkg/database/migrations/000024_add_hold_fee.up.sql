@@ -0,0 +1,5 @@
+-- The transfer fee of a hold is priced when it is authorized and reserved
+-- with it, then charged when it is captured.
+ALTER TABLE holds
+    ADD COLUMN IF NOT EXISTS fee BIGINT NOT NULL DEFAULT 0
+    CONSTRAINT holds_fee_check CHECK (fee >= 0);
@@ -0,0 +1,109 @@
+package fx
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+)
+
+// FXRateProvider quotes how many units of `to` one unit of `from` buys.
+type FXRateProvider interface {
+	Rate(ctx context.Context, from, to string) (Rate, error)
+}
+
+// Rate is an exchange rate in fixed point with RateDecimals digits, so
+// conversions never go through float64 (36.5 is stored as 36_500_000).
+type Rate int64
+
+const (
+	RateDecimals = 6
+	RateScale    = 1_000_000
+
+	// Identity is the rate applied between accounts of the same currency.
+	Identity Rate = RateScale
+)
+
+// ParseRate reads a positive decimal string such as "36.5" or "0.027397".
+func ParseRate(s string) (Rate, error) {
+	r, err := parseDecimal(s)
+	if err != nil || r <= 0 {
+		return 0, errs.ErrInvalidExchangeRate
+	}
+	return r, nil
+}
+
+func parseDecimal(s string) (Rate, error) {
+	whole, frac, _ := strings.Cut(strings.TrimSpace(s), ".")
+	if whole == "" || len(frac) > RateDecimals {
+		return 0, errs.ErrInvalidExchangeRate
+	}
+	frac += strings.Repeat("0", RateDecimals-len(frac))
+
+	n, err := strconv.ParseUint(whole+frac, 10, 63)
+	if err != nil {
+		return 0, errs.ErrInvalidExchangeRate
+	}
+	return Rate(n), nil
+}
+
+func (r Rate) String() string {
+	s := fmt.Sprintf("%0*d", RateDecimals+1, int64(r))
+	return s[:len(s)-RateDecimals] + "." + s[len(s)-RateDecimals:]
+}
+
+// Convert applies the rate to an amount in minor units, shifting between the
+// currencies' minor-unit exponents (USD 2 -> JPY 0). It rounds down so the
+// bank never pays out more than it received.
+func (r Rate) Convert(amount int64, fromExp, toExp int) int64 {
+	n := new(big.Int).Mul(big.NewInt(amount), big.NewInt(int64(r)))
+	d := big.NewInt(RateScale)
+
+	ten := big.NewInt(10)
+	for ; toExp > fromExp; toExp-- {
+		n.Mul(n, ten)
+	}
+	for ; fromExp > toExp; fromExp-- {
+		d.Mul(d, ten)
+	}
+	return n.Quo(n, d).Int64()
+}
+
+// Inverse returns the rate for the opposite direction.
+func (r Rate) Inverse() Rate {
+	return Rate(int64(RateScale) * RateScale / int64(r))
+}
+
+// MarshalJSON writes the rate as a JSON number without a float round trip.
+func (r Rate) MarshalJSON() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+func (r *Rate) UnmarshalJSON(b []byte) error {
+	rate, err := parseDecimal(strings.Trim(string(b), `"`))
+	if err != nil {
+		return err
+	}
+	*r = rate
+	return nil
+}
+
+// Value stores the rate in a NUMERIC column.
+func (r Rate) Value() (driver.Value, error) {
+	return r.String(), nil
+}
+
+func (r *Rate) Scan(src any) error {
+	switch v := src.(type) {
+	case []byte:
+		return r.UnmarshalJSON(v)
+	case string:
+		return r.UnmarshalJSON([]byte(v))
+	default:
+		return fmt.Errorf("cannot scan %T into fx.Rate", src)
+	}
+}
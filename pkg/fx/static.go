@@ -0,0 +1,88 @@
+package fx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+)
+
+// DefaultRates is used when no rates file is configured.
+var DefaultRates = map[string]string{
+	"USD/THB": "36.5",
+}
+
+type staticProvider struct {
+	rates map[string]Rate
+}
+
+// NewStaticProvider builds a provider from "FROM/TO" pairs. The reverse
+// direction is derived when it is not listed explicitly.
+func NewStaticProvider(rates map[string]string) (FXRateProvider, error) {
+	p := &staticProvider{rates: make(map[string]Rate, len(rates)*2)}
+
+	for pair, value := range rates {
+		from, to, ok := strings.Cut(strings.ToUpper(pair), "/")
+		if !ok || from == "" || to == "" {
+			return nil, fmt.Errorf("invalid currency pair %q", pair)
+		}
+		rate, err := ParseRate(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate for %s: %w", pair, err)
+		}
+		p.rates[pairKey(from, to)] = rate
+	}
+
+	for key, rate := range p.rates {
+		from, to, _ := strings.Cut(key, "/")
+		if _, ok := p.rates[pairKey(to, from)]; !ok {
+			p.rates[pairKey(to, from)] = rate.Inverse()
+		}
+	}
+	return p, nil
+}
+
+// LoadStaticProvider reads a JSON object of pairs, e.g. {"USD/THB": "36.5"}.
+// An empty path falls back to DefaultRates.
+func LoadStaticProvider(path string) (FXRateProvider, error) {
+	if path == "" {
+		return NewStaticProvider(DefaultRates)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read fx rates failed: %w", err)
+	}
+
+	// json.Number accepts both 36.5 and "36.5"
+	raw := make(map[string]json.Number)
+	if err = json.Unmarshal(b, &raw); err != nil {
+		return nil, fmt.Errorf("parse fx rates failed: %w", err)
+	}
+
+	rates := make(map[string]string, len(raw))
+	for pair, value := range raw {
+		rates[pair] = value.String()
+	}
+	return NewStaticProvider(rates)
+}
+
+func (p *staticProvider) Rate(ctx context.Context, from, to string) (Rate, error) {
+	from, to = strings.ToUpper(from), strings.ToUpper(to)
+	if from == to {
+		return Identity, nil
+	}
+
+	rate, ok := p.rates[pairKey(from, to)]
+	if !ok {
+		return 0, errs.ErrExchangeRateNotFound
+	}
+	return rate, nil
+}
+
+func pairKey(from, to string) string {
+	return from + "/" + to
+}
@@ -0,0 +1,44 @@
+package denylist
+
+import (
+	"context"
+	"time"
+
+	"github.com/codepnw/simple-bank/internal/consts"
+	"github.com/codepnw/simple-bank/pkg/token"
+)
+
+// Layered answers from memory when it can and falls back to the shared
+// store, so tokens denied by another instance are still caught. Hits from
+// the shared store are cached locally until the token expires.
+type Layered struct {
+	cache  *Memory
+	shared token.Denylist
+}
+
+func NewLayered(cache *Memory, shared token.Denylist) *Layered {
+	return &Layered{cache: cache, shared: shared}
+}
+
+var _ token.Denylist = (*Layered)(nil)
+
+func (l *Layered) Deny(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	if err := l.shared.Deny(ctx, tokenID, expiresAt); err != nil {
+		return err
+	}
+	return l.cache.Deny(ctx, tokenID, expiresAt)
+}
+
+func (l *Layered) IsDenied(ctx context.Context, tokenID string) (bool, error) {
+	if denied, _ := l.cache.IsDenied(ctx, tokenID); denied {
+		return true, nil
+	}
+
+	denied, err := l.shared.IsDenied(ctx, tokenID)
+	if err != nil || !denied {
+		return false, err
+	}
+	// The exact expiry lives in the shared store; access tokens never outlive
+	// this, so it is a safe upper bound for the cache entry.
+	return true, l.cache.Deny(ctx, tokenID, time.Now().Add(consts.TokenAccessDuration))
+}
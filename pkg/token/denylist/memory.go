@@ -0,0 +1,48 @@
+package denylist
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/codepnw/simple-bank/pkg/token"
+)
+
+// Memory is a process-local denylist. Entries are dropped once the token
+// they deny has expired anyway.
+type Memory struct {
+	mu      sync.RWMutex
+	entries map[string]time.Time
+}
+
+func NewMemory() *Memory {
+	return &Memory{
+		entries: make(map[string]time.Time),
+	}
+}
+
+var _ token.Denylist = (*Memory)(nil)
+
+func (m *Memory) Deny(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	for id, exp := range m.entries {
+		if !exp.After(now) {
+			delete(m.entries, id)
+		}
+	}
+	if expiresAt.After(now) {
+		m.entries[tokenID] = expiresAt
+	}
+	return nil
+}
+
+func (m *Memory) IsDenied(ctx context.Context, tokenID string) (bool, error) {
+	m.mu.RLock()
+	exp, ok := m.entries[tokenID]
+	m.mu.RUnlock()
+
+	return ok && exp.After(time.Now()), nil
+}
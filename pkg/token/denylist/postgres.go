@@ -0,0 +1,48 @@
+package denylist
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/codepnw/simple-bank/pkg/token"
+)
+
+// Postgres shares the denylist between instances through the
+// revoked_access_tokens table.
+type Postgres struct {
+	db *sql.DB
+}
+
+func NewPostgres(db *sql.DB) *Postgres {
+	return &Postgres{db: db}
+}
+
+var _ token.Denylist = (*Postgres)(nil)
+
+func (p *Postgres) Deny(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	query := `
+		INSERT INTO revoked_access_tokens (jti, expires_at) VALUES ($1, $2)
+		ON CONFLICT (jti) DO NOTHING
+	`
+	if _, err := p.db.ExecContext(ctx, query, tokenID, expiresAt); err != nil {
+		return err
+	}
+
+	// Expired rows deny nothing; clear them while we're here.
+	_, err := p.db.ExecContext(ctx, `DELETE FROM revoked_access_tokens WHERE expires_at <= NOW()`)
+	return err
+}
+
+func (p *Postgres) IsDenied(ctx context.Context, tokenID string) (bool, error) {
+	var denied bool
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM revoked_access_tokens WHERE jti = $1 AND expires_at > NOW()
+		)
+	`
+	if err := p.db.QueryRowContext(ctx, query, tokenID).Scan(&denied); err != nil {
+		return false, err
+	}
+	return denied, nil
+}
@@ -0,0 +1,24 @@
+package token
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// Hasher turns refresh tokens into the keyed hash that is stored and looked
+// up instead of the token itself, so a database dump yields no usable tokens.
+type Hasher struct {
+	key []byte
+}
+
+func NewHasher(secret string) *Hasher {
+	return &Hasher{key: []byte(secret)}
+}
+
+// Hash returns the hex HMAC-SHA256 of token (64 characters).
+func (h *Hasher) Hash(token string) string {
+	mac := hmac.New(sha256.New, h.key)
+	mac.Write([]byte(token))
+	return hex.EncodeToString(mac.Sum(nil))
+}
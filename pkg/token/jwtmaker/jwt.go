@@ -30,24 +30,33 @@ func NewJWTMaker(cfg *config.JWTConfig) (token.TokenMaker, error) {
 type userClaims struct {
 	UserID int64
 	Email  string
+	Role   user.Role
 	*jwt.RegisteredClaims
 }
 
-func (j *JWTToken) GenerateAccessToken(u *user.User) (string, error) {
+func (j *JWTToken) GenerateAccessToken(u *user.User) (string, *token.Payload, error) {
 	return j.generateToken(j.secretKey, u, consts.TokenAccessDuration)
 }
 
 func (j *JWTToken) GenerateRefreshToken(u *user.User) (string, error) {
-	return j.generateToken(j.refreshKey, u, consts.TokenRefreshDuration)
+	token, _, err := j.generateToken(j.refreshKey, u, consts.TokenRefreshDuration)
+	return token, err
 }
 
-func (j *JWTToken) generateToken(key string, u *user.User, duration time.Duration) (string, error) {
+func (j *JWTToken) generateToken(key string, u *user.User, duration time.Duration) (string, *token.Payload, error) {
+	payload, err := token.NewTokenPayload(u, duration)
+	if err != nil {
+		return "", nil, err
+	}
+
 	claims := &userClaims{
-		UserID: u.ID,
-		Email:  u.Email,
+		UserID: payload.UserID,
+		Email:  payload.Email,
+		Role:   payload.Role,
 		RegisteredClaims: &jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(duration)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ID:        payload.ID,
+			ExpiresAt: jwt.NewNumericDate(payload.ExpiredAt),
+			IssuedAt:  jwt.NewNumericDate(payload.IssuedAt),
 			Issuer:    "simple-bank-api",
 		},
 	}
@@ -55,9 +64,9 @@ func (j *JWTToken) generateToken(key string, u *user.User, duration time.Duratio
 
 	ss, err := token.SignedString([]byte(key))
 	if err != nil {
-		return "", fmt.Errorf("signed token failed: %w", err)
+		return "", nil, fmt.Errorf("signed token failed: %w", err)
 	}
-	return ss, nil
+	return ss, payload, nil
 }
 
 func (j *JWTToken) VerifyAccessToken(tokenStr string) (*token.Payload, error) {
@@ -82,8 +91,10 @@ func (j *JWTToken) verifyToken(key, tokenStr string) (*token.Payload, error) {
 	}
 
 	payload := &token.Payload{
+		ID:        claims.ID,
 		UserID:    claims.UserID,
 		Email:     claims.Email,
+		Role:      claims.Role,
 		IssuedAt:  claims.IssuedAt.Time,
 		ExpiredAt: claims.ExpiresAt.Time,
 	}
@@ -27,12 +27,13 @@ func NewPasetoMaker(symmetricKey string) (token.TokenMaker, error) {
 	}, nil
 }
 
-func (p *PasetoMaker) GenerateAccessToken(u *user.User) (string, error) {
+func (p *PasetoMaker) GenerateAccessToken(u *user.User) (string, *token.Payload, error) {
 	return p.generateToken(u, consts.TokenAccessDuration)
 }
 
 func (p *PasetoMaker) GenerateRefreshToken(u *user.User) (string, error) {
-	return p.generateToken(u, consts.TokenRefreshDuration)
+	token, _, err := p.generateToken(u, consts.TokenRefreshDuration)
+	return token, err
 }
 
 func (p *PasetoMaker) VerifyAccessToken(tokenStr string) (*token.Payload, error) {
@@ -43,14 +44,17 @@ func (p *PasetoMaker) VerifyRefreshToken(tokenStr string) (*token.Payload, error
 	return p.verifyToken(tokenStr)
 }
 
-func (p *PasetoMaker) generateToken(u *user.User, duration time.Duration) (string, error) {
+func (p *PasetoMaker) generateToken(u *user.User, duration time.Duration) (string, *token.Payload, error) {
 	payload, err := token.NewTokenPayload(u, duration)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 
 	token, err := p.paseto.Encrypt(p.symmetricKey, payload, nil)
-	return token, err
+	if err != nil {
+		return "", nil, err
+	}
+	return token, payload, nil
 }
 
 func (p *PasetoMaker) verifyToken(tokenStr string) (*token.Payload, error) {
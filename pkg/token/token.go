@@ -1,38 +1,68 @@
 package token
 
 import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/codepnw/simple-bank/internal/features/user"
 )
 
 type TokenMaker interface {
-	GenerateAccessToken(u *user.User) (string, error)
+	// GenerateAccessToken also returns the payload so callers can track the
+	// token ID for revocation.
+	GenerateAccessToken(u *user.User) (string, *Payload, error)
 	GenerateRefreshToken(u *user.User) (string, error)
 	VerifyAccessToken(tokenStr string) (*Payload, error)
 	VerifyRefreshToken(tokenStr string) (*Payload, error)
 }
 
 type Payload struct {
+	ID        string    `json:"jti"`
 	UserID    int64     `json:"user_id"`
 	Email     string    `json:"email"`
+	Role      user.Role `json:"role"`
 	IssuedAt  time.Time `json:"issued_at"`
 	ExpiredAt time.Time `json:"expires_at"`
 }
 
 func NewTokenPayload(u *user.User, duration time.Duration) (*Payload, error) {
+	id, err := NewTokenID()
+	if err != nil {
+		return nil, err
+	}
+
 	return &Payload{
+		ID:        id,
 		UserID:    u.ID,
 		Email:     u.Email,
+		Role:      u.Role,
 		IssuedAt:  time.Now(),
 		ExpiredAt: time.Now().Add(duration),
 	}, nil
 }
 
+// NewTokenID returns a random 128-bit token ID (jti) in hex.
+func NewTokenID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("gen token id failed: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
 func (payload *Payload) Valid() error {
 	if time.Now().After(payload.ExpiredAt) {
 		return errors.New("token has expired")
 	}
 	return nil
 }
+
+// Denylist holds access token IDs revoked before they expire.
+type Denylist interface {
+	Deny(ctx context.Context, tokenID string, expiresAt time.Time) error
+	IsDenied(ctx context.Context, tokenID string) (bool, error)
+}
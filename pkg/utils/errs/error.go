@@ -0,0 +1,154 @@
+package errs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorDomain is reported in gRPC ErrorInfo details.
+const ErrorDomain = "simplebank"
+
+// Error is a domain error with a stable machine code and its HTTP and gRPC
+// mapping. Message is safe to return to clients; the wrapped cause is only
+// for logs.
+type Error struct {
+	Code       string
+	Message    string
+	HTTPStatus int
+	GRPCCode   codes.Code
+	Fields     []FieldViolation
+	// Metadata carries machine-readable context, e.g. a remaining allowance.
+	Metadata map[string]string
+
+	cause error
+}
+
+type FieldViolation struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+func New(code string, httpStatus int, grpcCode codes.Code, message string) *Error {
+	return &Error{
+		Code:       code,
+		Message:    message,
+		HTTPStatus: httpStatus,
+		GRPCCode:   grpcCode,
+	}
+}
+
+func (e *Error) Error() string {
+	if e.cause != nil {
+		return e.Message + ": " + e.cause.Error()
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// Is matches on Code, so copies made by Wrap still satisfy errors.Is against
+// the sentinel they came from.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code
+}
+
+// Wrap returns a copy of e carrying cause.
+func (e *Error) Wrap(cause error) *Error {
+	c := *e
+	c.cause = cause
+	return &c
+}
+
+// WithFields returns a copy of e carrying per-field validation details.
+func (e *Error) WithFields(fields ...FieldViolation) *Error {
+	c := *e
+	c.Fields = append(append([]FieldViolation(nil), e.Fields...), fields...)
+	return &c
+}
+
+// WithMetadata returns a copy of e carrying md, merged over any metadata
+// already set.
+func (e *Error) WithMetadata(md map[string]string) *Error {
+	c := *e
+	c.Metadata = make(map[string]string, len(e.Metadata)+len(md))
+	for k, v := range e.Metadata {
+		c.Metadata[k] = v
+	}
+	for k, v := range md {
+		c.Metadata[k] = v
+	}
+	return &c
+}
+
+// GRPCStatus lets grpc-go turn the error into a status with ErrorInfo and,
+// for validation errors, BadRequest details.
+func (e *Error) GRPCStatus() *status.Status {
+	st := status.New(e.GRPCCode, e.Message)
+
+	info := &errdetails.ErrorInfo{Reason: e.Code, Domain: ErrorDomain, Metadata: e.Metadata}
+	if len(e.Fields) == 0 {
+		if withDetails, err := st.WithDetails(info); err == nil {
+			return withDetails
+		}
+		return st
+	}
+
+	br := new(errdetails.BadRequest)
+	for _, f := range e.Fields {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       f.Field,
+			Description: f.Description,
+		})
+	}
+	if withDetails, err := st.WithDetails(info, br); err == nil {
+		return withDetails
+	}
+	return st
+}
+
+// From returns the domain error in err's chain. Anything else becomes
+// ErrInternal so raw messages never reach clients.
+func From(err error) *Error {
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+	return ErrInternal.Wrap(err)
+}
+
+// InvalidInput converts a request binding error into ErrInvalidInput with
+// one violation per failed field.
+func InvalidInput(err error) *Error {
+	var (
+		verrs   validator.ValidationErrors
+		typeErr *json.UnmarshalTypeError
+	)
+	switch {
+	case errors.As(err, &verrs):
+		fields := make([]FieldViolation, 0, len(verrs))
+		for _, fe := range verrs {
+			desc := fmt.Sprintf("failed on '%s'", fe.Tag())
+			if fe.Param() != "" {
+				desc = fmt.Sprintf("failed on '%s=%s'", fe.Tag(), fe.Param())
+			}
+			fields = append(fields, FieldViolation{Field: fe.Field(), Description: desc})
+		}
+		return ErrInvalidInput.Wrap(err).WithFields(fields...)
+	case errors.As(err, &typeErr):
+		return ErrInvalidInput.Wrap(err).WithFields(FieldViolation{
+			Field:       typeErr.Field,
+			Description: "must be " + typeErr.Type.String(),
+		})
+	default:
+		return ErrInvalidInput.Wrap(err)
+	}
+}
@@ -1,35 +1,122 @@
 package errs
 
-import "errors"
+import (
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+)
+
+// Common
+var (
+	ErrInternal     = New("INTERNAL", http.StatusInternalServerError, codes.Internal, "internal server error")
+	ErrInvalidInput = New("INVALID_INPUT", http.StatusBadRequest, codes.InvalidArgument, "invalid input")
+	ErrInvalidID    = New("INVALID_ID", http.StatusBadRequest, codes.InvalidArgument, "invalid id")
+	ErrInvalidTime  = New("INVALID_TIME", http.StatusBadRequest, codes.InvalidArgument, "invalid time: use RFC3339 or YYYY-MM-DD")
+)
 
 // User
 var (
-	ErrUserNotFound          = errors.New("user not found")
-	ErrEmailAlreadyExists    = errors.New("email already exists")
-	ErrUsernameAlreadyExists = errors.New("username already exists")
-	ErrInvalidCredentials    = errors.New("invalid email or password")
+	ErrUserNotFound          = New("USER_NOT_FOUND", http.StatusNotFound, codes.NotFound, "user not found")
+	ErrEmailAlreadyExists    = New("USER_EMAIL_EXISTS", http.StatusConflict, codes.AlreadyExists, "email already exists")
+	ErrUsernameAlreadyExists = New("USER_USERNAME_EXISTS", http.StatusConflict, codes.AlreadyExists, "username already exists")
+	ErrInvalidCredentials    = New("AUTH_INVALID_CREDENTIALS", http.StatusUnauthorized, codes.Unauthenticated, "invalid email or password")
 )
 
 // Account
 var (
-	ErrAccountNotFound       = errors.New("account not found")
-	ErrCurrencyAlreadyExists = errors.New("account with this currency already exists")
-	ErrInvalidCurrency       = errors.New("invalid account currency ['THB', 'USD']")
+	ErrAccountNotFound       = New("ACCOUNT_NOT_FOUND", http.StatusNotFound, codes.NotFound, "account not found")
+	ErrCurrencyAlreadyExists = New("ACCOUNT_CURRENCY_EXISTS", http.StatusConflict, codes.AlreadyExists, "account with this currency and product already exists")
+	ErrInvalidCurrency       = New("CURRENCY_INVALID", http.StatusBadRequest, codes.InvalidArgument, "invalid or unsupported currency")
+	ErrInvalidAmount         = New("AMOUNT_INVALID", http.StatusBadRequest, codes.InvalidArgument, "amount must be greater than zero")
+	ErrSystemAccountNotFound = New("ACCOUNT_SYSTEM_NOT_FOUND", http.StatusInternalServerError, codes.Internal, "system account not found")
+	ErrAccountFrozen         = New("ACCOUNT_FROZEN", http.StatusConflict, codes.FailedPrecondition, "account is frozen")
+	ErrAccountClosed         = New("ACCOUNT_CLOSED", http.StatusConflict, codes.FailedPrecondition, "account is closed")
+	ErrInvalidAccountStatus  = New("ACCOUNT_STATUS_INVALID", http.StatusBadRequest, codes.InvalidArgument, "invalid account status ['active', 'frozen', 'closed']")
+	ErrStatusTransition      = New("ACCOUNT_STATUS_TRANSITION", http.StatusConflict, codes.FailedPrecondition, "account status change not allowed")
+	ErrBalanceNotZero        = New("ACCOUNT_BALANCE_NOT_ZERO", http.StatusConflict, codes.FailedPrecondition, "account balance must be zero to close")
+	ErrAccountHasHolds       = New("ACCOUNT_HOLDS_OPEN", http.StatusConflict, codes.FailedPrecondition, "account has open holds; void them before closing")
+	ErrReasonRequired        = New("ACCOUNT_STATUS_REASON_REQUIRED", http.StatusBadRequest, codes.InvalidArgument, "a reason is required to change account status")
+	ErrInvalidProduct        = New("ACCOUNT_PRODUCT_INVALID", http.StatusBadRequest, codes.InvalidArgument, "invalid account product ['current', 'savings']")
+	ErrInvalidOverdraftLimit = New("ACCOUNT_OVERDRAFT_LIMIT_INVALID", http.StatusBadRequest, codes.InvalidArgument, "overdraft limit must not be negative")
 )
 
 // Auth
 var (
-	ErrNoUserID      = errors.New("no user id")
-	ErrNoPermission  = errors.New("no permission")
-	ErrTokenNotFound = errors.New("token not found")
-	ErrTokenRevoked  = errors.New("token revoked")
-	ErrTokenExpires  = errors.New("token is expires")
-	ErrUnauthorized  = errors.New("unauthorized")
+	ErrNoUserID          = New("AUTH_UNAUTHENTICATED", http.StatusUnauthorized, codes.Unauthenticated, "authentication required")
+	ErrNoPermission      = New("AUTH_FORBIDDEN", http.StatusForbidden, codes.PermissionDenied, "no permission")
+	ErrTokenNotFound     = New("AUTH_TOKEN_NOT_FOUND", http.StatusNotFound, codes.NotFound, "token not found")
+	ErrTokenRevoked      = New("AUTH_TOKEN_REVOKED", http.StatusUnauthorized, codes.Unauthenticated, "token revoked")
+	ErrTokenExpires      = New("AUTH_TOKEN_EXPIRED", http.StatusUnauthorized, codes.Unauthenticated, "token is expired")
+	ErrTokenReused       = New("AUTH_TOKEN_REUSED", http.StatusUnauthorized, codes.Unauthenticated, "refresh token reuse detected, please sign in again")
+	ErrUnauthorized      = New("AUTH_UNAUTHORIZED", http.StatusUnauthorized, codes.Unauthenticated, "unauthorized")
+	ErrMissingAuthHeader = New("AUTH_HEADER_MISSING", http.StatusUnauthorized, codes.Unauthenticated, "authorization header is missing")
+	ErrInvalidAuthHeader = New("AUTH_HEADER_INVALID", http.StatusUnauthorized, codes.Unauthenticated, "invalid authorization header format")
+	ErrInvalidToken      = New("AUTH_TOKEN_INVALID", http.StatusUnauthorized, codes.Unauthenticated, "access token is invalid")
+	ErrSessionNotFound   = New("AUTH_SESSION_NOT_FOUND", http.StatusNotFound, codes.NotFound, "session not found")
+)
+
+// Entry
+var (
+	ErrEntryNotFound = New("ENTRY_NOT_FOUND", http.StatusNotFound, codes.NotFound, "entry not found")
+)
+
+// Ledger
+var (
+	ErrUnbalancedJournal   = New("JOURNAL_UNBALANCED", http.StatusInternalServerError, codes.Internal, "journal entries don't sum to zero")
+	ErrInvalidReportFormat = New("REPORT_FORMAT_INVALID", http.StatusBadRequest, codes.InvalidArgument, "invalid report format ['json', 'csv']")
+)
+
+// FX
+var (
+	ErrExchangeRateNotFound = New("FX_RATE_NOT_FOUND", http.StatusBadRequest, codes.FailedPrecondition, "exchange rate not found")
+	ErrInvalidExchangeRate  = New("FX_RATE_INVALID", http.StatusInternalServerError, codes.Internal, "invalid exchange rate")
+)
+
+// Statement
+var (
+	ErrInvalidStatementFormat = New("STATEMENT_FORMAT_INVALID", http.StatusBadRequest, codes.InvalidArgument, "invalid statement format ['csv', 'jsonl', 'pdf']")
+)
+
+// Filter
+var (
+	ErrInvalidTimeRange    = New("FILTER_TIME_RANGE_INVALID", http.StatusBadRequest, codes.InvalidArgument, "invalid time range: 'from' must be before 'to'")
+	ErrInvalidAmountRange  = New("FILTER_AMOUNT_RANGE_INVALID", http.StatusBadRequest, codes.InvalidArgument, "invalid amount range: 'min_amount' must not exceed 'max_amount'")
+	ErrInvalidCursor       = New("PAGINATION_CURSOR_INVALID", http.StatusBadRequest, codes.InvalidArgument, "invalid cursor")
+	ErrFilterScopeRequired = New("FILTER_SCOPE_REQUIRED", http.StatusBadRequest, codes.InvalidArgument, "user_id or account_id is required")
 )
 
 // Transfer
 var (
-	ErrCurrencyMismatch = errors.New("currency mismatch")
-	ErrMoneyNotEnough   = errors.New("money not enough")
-	ErrTransferToSelf   = errors.New("transfer to self")
+	ErrCurrencyMismatch = New("TRANSFER_CURRENCY_MISMATCH", http.StatusBadRequest, codes.InvalidArgument, "currency mismatch")
+	ErrMoneyNotEnough   = New("TRANSFER_INSUFFICIENT_FUNDS", http.StatusBadRequest, codes.FailedPrecondition, "money not enough")
+	ErrTransferToSelf   = New("TRANSFER_TO_SELF", http.StatusBadRequest, codes.InvalidArgument, "transfer to self")
+	ErrTransferNotFound = New("TRANSFER_NOT_FOUND", http.StatusNotFound, codes.NotFound, "transfer not found")
+
+	ErrTransferLimitExceeded = New("TRANSFER_LIMIT_EXCEEDED", http.StatusBadRequest, codes.FailedPrecondition, "transfer limit exceeded")
+	ErrInvalidTransferLimit  = New("TRANSFER_LIMIT_INVALID", http.StatusBadRequest, codes.InvalidArgument, "transfer limits must be greater than zero")
+
+	ErrTransferAlreadyReversed = New("TRANSFER_ALREADY_REVERSED", http.StatusConflict, codes.AlreadyExists, "transfer has already been reversed")
+	ErrTransferNotReversible   = New("TRANSFER_NOT_REVERSIBLE", http.StatusConflict, codes.FailedPrecondition, "a reversal can't be reversed")
+	ErrInvalidReversalAmount   = New("TRANSFER_REVERSAL_AMOUNT_INVALID", http.StatusBadRequest, codes.InvalidArgument, "reversal amount must be between 1 and the amount received")
+
+	ErrHoldNotFound         = New("HOLD_NOT_FOUND", http.StatusNotFound, codes.NotFound, "hold not found")
+	ErrHoldNotAuthorized    = New("HOLD_NOT_AUTHORIZED", http.StatusConflict, codes.FailedPrecondition, "hold is already captured, voided or expired")
+	ErrHoldExpired          = New("HOLD_EXPIRED", http.StatusConflict, codes.FailedPrecondition, "hold has expired")
+	ErrInvalidCaptureAmount = New("HOLD_CAPTURE_AMOUNT_INVALID", http.StatusBadRequest, codes.InvalidArgument, "capture amount must be between 1 and the amount held")
+
+	ErrInvalidIdempotencyKey  = New("IDEMPOTENCY_KEY_INVALID", http.StatusBadRequest, codes.InvalidArgument, "invalid idempotency key")
+	ErrIdempotencyKeyConflict = New("IDEMPOTENCY_KEY_CONFLICT", http.StatusConflict, codes.AlreadyExists, "idempotency key already used with a different request")
+	ErrIdempotencyKeyNotFound = New("IDEMPOTENCY_KEY_NOT_FOUND", http.StatusNotFound, codes.NotFound, "idempotency key not found")
+	ErrIdempotencyKeyExists   = New("IDEMPOTENCY_KEY_EXISTS", http.StatusConflict, codes.AlreadyExists, "idempotency key already exists")
+)
+
+// Scheduled Transfer
+var (
+	ErrScheduledTransferNotFound = New("SCHEDULED_TRANSFER_NOT_FOUND", http.StatusNotFound, codes.NotFound, "scheduled transfer not found")
+	ErrInvalidSchedule           = New("SCHEDULE_INVALID", http.StatusBadRequest, codes.InvalidArgument, "invalid schedule: use a 5-field cron expression or @daily, @weekly, @monthly")
+	ErrRunAtRequired             = New("SCHEDULE_RUN_AT_REQUIRED", http.StatusBadRequest, codes.InvalidArgument, "run_at is required for a one-off transfer")
+	ErrRunAtInPast               = New("SCHEDULE_RUN_AT_IN_PAST", http.StatusBadRequest, codes.InvalidArgument, "run_at must be in the future")
+	ErrScheduleFinished          = New("SCHEDULE_FINISHED", http.StatusConflict, codes.FailedPrecondition, "scheduled transfer is already completed, cancelled or failed")
+	ErrInvalidScheduleStatus     = New("SCHEDULE_STATUS_INVALID", http.StatusBadRequest, codes.InvalidArgument, "invalid status ['active', 'paused']")
+	ErrScheduleRunPending        = New("SCHEDULE_RUN_PENDING", http.StatusConflict, codes.FailedPrecondition, "the current occurrence is due or being retried; change the amount after it has run")
 )
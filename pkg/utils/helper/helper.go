@@ -3,7 +3,9 @@ package helper
 import (
 	"log"
 	"strconv"
+	"time"
 
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
 	"github.com/go-playground/validator/v10"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -47,3 +49,38 @@ func ParseInt(key string) int {
 	}
 	return val
 }
+
+const dateLayout = "2006-01-02"
+
+// ParseTimeRange parses optional 'from' and 'to' query values as RFC3339 or
+// YYYY-MM-DD. A date-only 'to' covers the whole day.
+func ParseTimeRange(from, to string) (*time.Time, *time.Time, error) {
+	fromTime, _, err := parseTime(from)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	toTime, dateOnly, err := parseTime(to)
+	if err != nil {
+		return nil, nil, err
+	}
+	if toTime != nil && dateOnly {
+		end := toTime.Add(24*time.Hour - time.Microsecond)
+		toTime = &end
+	}
+	return fromTime, toTime, nil
+}
+
+func parseTime(value string) (*time.Time, bool, error) {
+	if value == "" {
+		return nil, false, nil
+	}
+	if t, err := time.Parse(dateLayout, value); err == nil {
+		return &t, true, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, false, errs.ErrInvalidTime.Wrap(err)
+	}
+	return &t, false, nil
+}
@@ -0,0 +1,97 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+)
+
+const (
+	DefaultPageSize = 10
+	MaxPageSize     = 100
+)
+
+// Cursor is the keyset position (created_at, id) of the last row of a page.
+type Cursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        int64     `json:"id"`
+}
+
+// Encode returns the opaque token handed to clients as next_cursor.
+func (c Cursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func DecodeCursor(token string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errs.ErrInvalidCursor
+	}
+
+	c := new(Cursor)
+	if err = json.Unmarshal(b, c); err != nil || c.ID == 0 {
+		return nil, errs.ErrInvalidCursor
+	}
+	return c, nil
+}
+
+// Page is what repositories receive. After selects keyset mode; otherwise
+// Offset is used (legacy ?page= requests).
+type Page struct {
+	Limit  int
+	Offset int
+	After  *Cursor
+}
+
+// NewPage builds a Page from query values. A cursor always wins; a positive
+// pageID keeps the old LIMIT/OFFSET behaviour; neither starts a keyset walk.
+func NewPage(pageID, pageSize int, cursor string) (*Page, error) {
+	if pageSize < 1 {
+		pageSize = DefaultPageSize
+	}
+	if pageSize > MaxPageSize {
+		pageSize = MaxPageSize
+	}
+
+	page := &Page{Limit: pageSize}
+
+	switch {
+	case cursor != "":
+		after, err := DecodeCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		page.After = after
+	case pageID > 1:
+		page.Offset = (pageID - 1) * pageSize
+	}
+	return page, nil
+}
+
+// FetchLimit asks for one extra row so has_more is known without a COUNT.
+func (p *Page) FetchLimit() int {
+	return p.Limit + 1
+}
+
+type Meta struct {
+	NextCursor string `json:"next_cursor,omitempty"`
+	HasMore    bool   `json:"has_more"`
+	PageSize   int    `json:"page_size"`
+}
+
+// Trim drops the look-ahead row fetched by FetchLimit and builds the Meta.
+func Trim[T any](items []T, page *Page, cursorOf func(T) Cursor) ([]T, *Meta) {
+	meta := &Meta{PageSize: page.Limit}
+
+	if len(items) > page.Limit {
+		items = items[:page.Limit]
+		meta.HasMore = true
+	}
+	if meta.HasMore && len(items) > 0 {
+		meta.NextCursor = cursorOf(items[len(items)-1]).Encode()
+	}
+	return items, meta
+}
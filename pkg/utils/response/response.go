@@ -1,14 +1,22 @@
 package response
 
 import (
+	"log"
 	"net/http"
 
+	"github.com/codepnw/simple-bank/pkg/utils/errs"
+	"github.com/codepnw/simple-bank/pkg/utils/pagination"
 	"github.com/gin-gonic/gin"
 )
 
 // ErrorResponse : swagger response
 type ErrorResponse struct {
-	Error string `json:"error"`
+	Code    int                   `json:"code" example:"400"`
+	Type    string                `json:"type" example:"TRANSFER_INSUFFICIENT_FUNDS"`
+	Message string                `json:"message" example:"money not enough"`
+	Details []errs.FieldViolation `json:"details,omitempty"`
+	// Metadata holds extra context for some errors, e.g. TRANSFER_LIMIT_EXCEEDED
+	Metadata map[string]string `json:"metadata,omitempty" example:"limit:daily_amount,remaining:150000"`
 }
 // NoContentResponse : swagger response
 type NoContentResponse struct{}
@@ -24,6 +32,26 @@ func Success(c *gin.Context, message string, data any) {
 	})
 }
 
+// PageResponse : swagger response
+type PageResponse struct {
+	Code       int              `json:"code"`
+	Message    string           `json:"message"`
+	Data       any              `json:"data"`
+	Pagination *pagination.Meta `json:"pagination"`
+}
+
+func SuccessPage(c *gin.Context, message string, data any, meta *pagination.Meta) {
+	if message == "" {
+		message = "successfully"
+	}
+	c.JSON(http.StatusOK, PageResponse{
+		Code:       http.StatusOK,
+		Message:    message,
+		Data:       data,
+		Pagination: meta,
+	})
+}
+
 func Created(c *gin.Context, message string, data any) {
 	if message == "" {
 		message = "created"
@@ -39,34 +67,19 @@ func NoContent(c *gin.Context) {
 	c.JSON(http.StatusNoContent, nil)
 }
 
-func BadRequest(c *gin.Context, message string) {
-	c.JSON(http.StatusBadRequest, gin.H{
-		"code":    http.StatusBadRequest,
-		"type":    "BAD_REQUEST",
-		"message": message,
-	})
-}
-
-func Unauthorized(c *gin.Context, message string) {
-	c.JSON(http.StatusUnauthorized, gin.H{
-		"code":    http.StatusUnauthorized,
-		"type":    "UNAUTHORIZED",
-		"message": message,
-	})
-}
-
-func NotFound(c *gin.Context, message string) {
-	c.JSON(http.StatusNotFound, gin.H{
-		"code":    http.StatusNotFound,
-		"type":    "NOT_FOUND",
-		"message": message,
-	})
-}
+// Error writes any error as an ErrorResponse. Errors outside the domain model
+// are logged and answered with a generic message.
+func Error(c *gin.Context, err error) {
+	e := errs.From(err)
+	if e.HTTPStatus >= http.StatusInternalServerError {
+		log.Printf("%s %s failed: %v", c.Request.Method, c.FullPath(), err)
+	}
 
-func InternalServerError(c *gin.Context, err error) {
-	c.JSON(http.StatusInternalServerError, gin.H{
-		"code":  http.StatusInternalServerError,
-		"type":  "INTERNAL_SERVER_ERROR",
-		"error": err.Error(),
+	c.JSON(e.HTTPStatus, ErrorResponse{
+		Code:     e.HTTPStatus,
+		Type:     e.Code,
+		Message:  e.Message,
+		Details:  e.Fields,
+		Metadata: e.Metadata,
 	})
 }
@@ -6,44 +6,108 @@ CREATE TABLE users (
     first_name VARCHAR(50) NOT NULL,
     last_name VARCHAR(50) NOT NULL,
     email VARCHAR UNIQUE NOT NULL,
+    role VARCHAR(20) NOT NULL DEFAULT 'customer' CHECK (role IN ('customer', 'admin')),
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
 );
 
--- Table Auth
-CREATE TABLE IF NOT EXISTS auth (
+-- Table Sessions (one refresh token per device, stored as an HMAC-SHA256 hash)
+CREATE TABLE IF NOT EXISTS sessions (
     id BIGSERIAL PRIMARY KEY,
-    user_id BIGINT NOT NULL UNIQUE REFERENCES users(id) ON DELETE CASCADE,
-    token TEXT NOT NULL,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token_hash CHAR(64) NOT NULL,
+    user_agent TEXT NOT NULL DEFAULT '',
+    client_ip VARCHAR(45) NOT NULL DEFAULT '',
+    access_jti VARCHAR(64) NOT NULL DEFAULT '', -- current access token, denied on revoke
+    access_expires_at TIMESTAMPTZ,
     revoked BOOLEAN NOT NULL DEFAULT FALSE,
     expires_at TIMESTAMPTZ NOT NULL,
+    last_used_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
 );
 
-CREATE INDEX IF NOT EXISTS idx_auth_token ON auth(token);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_sessions_token_hash ON sessions (token_hash);
+CREATE INDEX IF NOT EXISTS idx_sessions_user_id ON sessions (user_id);
+
+-- Table Rotated Refresh Tokens (a session is a token family; replaying one of these revokes it)
+CREATE TABLE IF NOT EXISTS rotated_refresh_tokens (
+    token_hash CHAR(64) PRIMARY KEY,
+    session_id BIGINT NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+    rotated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_rotated_refresh_tokens_session ON rotated_refresh_tokens (session_id);
+
+-- Table Security Events
+CREATE TABLE IF NOT EXISTS security_events (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    session_id BIGINT REFERENCES sessions(id) ON DELETE SET NULL,
+    type VARCHAR(50) NOT NULL,
+    user_agent TEXT NOT NULL DEFAULT '',
+    client_ip VARCHAR(45) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_security_events_user ON security_events (user_id, created_at);
+
+-- Table Revoked Access Tokens (denylist shared by all instances)
+CREATE TABLE IF NOT EXISTS revoked_access_tokens (
+    jti VARCHAR(64) PRIMARY KEY,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_revoked_access_tokens_expires ON revoked_access_tokens (expires_at);
+
+-- Table Currencies (ISO 4217); exponent = number of minor unit digits
+CREATE TABLE IF NOT EXISTS currencies (
+    code VARCHAR(3) PRIMARY KEY CHECK (code ~ '^[A-Z]{3}$'),
+    exponent SMALLINT NOT NULL CHECK (exponent BETWEEN 0 AND 4),
+    symbol VARCHAR(8) NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO currencies (code, exponent, symbol) VALUES
+('THB', 2, '฿'),
+('USD', 2, '$');
 
 -- Table Accounts
 CREATE TABLE accounts (
     id BIGSERIAL PRIMARY KEY,
     owner_id BIGSERIAL NOT NULL REFERENCES users(id),
-    balance BIGINT NOT NULL,
-    currency VARCHAR(3) NOT NULL,
+    balance BIGINT NOT NULL, -- ledger balance
+    held_amount BIGINT NOT NULL DEFAULT 0 CHECK (held_amount >= 0), -- reserved by open holds
+    overdraft_limit BIGINT NOT NULL DEFAULT 0 CHECK (overdraft_limit >= 0), -- how far below zero a customer balance may go
+    currency VARCHAR(3) NOT NULL REFERENCES currencies(code),
+    type VARCHAR(20) NOT NULL DEFAULT 'customer',
+    status VARCHAR(10) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'frozen', 'closed')),
+    product VARCHAR(20) NOT NULL DEFAULT 'current' CHECK (product IN ('current', 'savings')),
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
 );
 -- Index
 CREATE INDEX idx_accounts_owner_id ON accounts (owner_id);
--- Rule: 1 owner 1 currency
-CREATE UNIQUE INDEX idx_accounts_owner_currency ON accounts (owner_id, currency);
+-- Keyset pagination: ORDER BY created_at, id within an owner
+CREATE INDEX idx_accounts_owner_created ON accounts (owner_id, created_at, id);
+-- Rule: 1 owner 1 currency per product (open customer accounts only)
+CREATE UNIQUE INDEX idx_accounts_owner_currency ON accounts (owner_id, currency, product) WHERE type = 'customer' AND status <> 'closed';
+-- Rule: 1 system account per type and currency
+CREATE UNIQUE INDEX idx_accounts_system_type_currency ON accounts (type, currency) WHERE type <> 'customer';
 
--- Table Entries
-CREATE TABLE entries (
+-- Table Account Status Changes (audit trail)
+CREATE TABLE IF NOT EXISTS account_status_changes (
     id BIGSERIAL PRIMARY KEY,
     account_id BIGINT NOT NULL REFERENCES accounts(id),
-    amount BIGINT NOT NULL, -- (+) Deposit, (-) Withdraw
+    from_status VARCHAR(10) NOT NULL,
+    to_status VARCHAR(10) NOT NULL,
+    reason TEXT NOT NULL,
+    changed_by BIGINT NOT NULL REFERENCES users(id),
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
 );
+CREATE INDEX idx_account_status_changes_account ON account_status_changes (account_id, created_at);
 
 -- Table Transfers
 CREATE TABLE transfers (
@@ -51,9 +115,200 @@ CREATE TABLE transfers (
     from_account_id BIGINT NOT NULL REFERENCES accounts(id),
     to_account_id BIGINT NOT NULL REFERENCES accounts(id),
     amount BIGINT NOT NULL CHECK (amount > 0),
+    to_amount BIGINT NOT NULL CHECK (to_amount > 0),
+    exchange_rate NUMERIC(18, 6) NOT NULL DEFAULT 1,
+    reversal_of BIGINT REFERENCES transfers(id), -- set on the compensating transfer
+    fee BIGINT NOT NULL DEFAULT 0 CHECK (fee >= 0), -- charged on top of amount, source currency
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
 );
 -- Index
 CREATE INDEX idx_transfers_from ON transfers (from_account_id);
 CREATE INDEX idx_transfers_to ON transfers (to_account_id);
 CREATE INDEX idx_transfers_from_to ON transfers (from_account_id, to_account_id);
+CREATE INDEX idx_transfers_from_created ON transfers (from_account_id, created_at);
+CREATE UNIQUE INDEX idx_transfers_reversal_of ON transfers (reversal_of) WHERE reversal_of IS NOT NULL;
+
+-- Table Journal Transactions (one balanced posting, owns its entries)
+CREATE TABLE journal_transactions (
+    id BIGSERIAL PRIMARY KEY,
+    kind VARCHAR(20) NOT NULL
+        CHECK (kind IN ('opening', 'transfer', 'reversal', 'deposit', 'withdrawal', 'correction', 'interest')),
+    transfer_id BIGINT REFERENCES transfers(id),
+    created_by BIGINT REFERENCES users(id), -- admin who posted a deposit or opened a correction
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+-- Index
+CREATE INDEX idx_journal_transactions_transfer ON journal_transactions (transfer_id) WHERE transfer_id IS NOT NULL;
+
+-- Table Entries
+CREATE TABLE entries (
+    id BIGSERIAL PRIMARY KEY,
+    journal_id BIGINT NOT NULL REFERENCES journal_transactions(id),
+    account_id BIGINT NOT NULL REFERENCES accounts(id),
+    amount BIGINT NOT NULL, -- (+) Deposit, (-) Withdraw
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+-- Index
+CREATE INDEX idx_entries_account_created ON entries (account_id, created_at);
+CREATE INDEX idx_entries_journal ON entries (journal_id);
+
+-- Rule: the entries of a journal sum to zero in each currency (at commit)
+CREATE OR REPLACE FUNCTION check_journal_balanced() RETURNS TRIGGER AS $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM entries e JOIN accounts a ON a.id = e.account_id
+        WHERE e.journal_id = NEW.journal_id
+        GROUP BY a.currency
+        HAVING SUM(e.amount) <> 0
+    ) THEN
+        RAISE EXCEPTION 'journal % does not balance', NEW.journal_id
+            USING ERRCODE = 'check_violation', CONSTRAINT = 'entries_journal_balanced';
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER entries_journal_balanced
+AFTER INSERT ON entries
+DEFERRABLE INITIALLY DEFERRED
+FOR EACH ROW EXECUTE FUNCTION check_journal_balanced();
+
+-- Rule: posted entries are never changed; corrections are new journals
+CREATE OR REPLACE FUNCTION reject_entry_change() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'entries are append-only'
+        USING ERRCODE = 'restrict_violation';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER entries_append_only
+BEFORE UPDATE OR DELETE ON entries
+FOR EACH ROW EXECUTE FUNCTION reject_entry_change();
+
+-- Table Transfer Limits (per currency; NULL = no limit)
+CREATE TABLE IF NOT EXISTS transfer_limits (
+    currency VARCHAR(3) PRIMARY KEY REFERENCES currencies(code),
+    max_amount BIGINT CHECK (max_amount > 0), -- per transfer
+    daily_amount BIGINT CHECK (daily_amount > 0), -- rolling 24h outgoing total
+    daily_count INT CHECK (daily_count > 0), -- rolling 24h outgoing transfers
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO transfer_limits (currency, max_amount, daily_amount, daily_count) VALUES
+('THB', 200000000, 500000000, 50),
+('USD', 5000000, 15000000, 50);
+
+-- Table Account Transfer Limits (overrides; NULL falls back to the currency)
+CREATE TABLE IF NOT EXISTS account_transfer_limits (
+    account_id BIGINT PRIMARY KEY REFERENCES accounts(id),
+    max_amount BIGINT CHECK (max_amount > 0),
+    daily_amount BIGINT CHECK (daily_amount > 0),
+    daily_count INT CHECK (daily_count > 0),
+    updated_by BIGINT NOT NULL REFERENCES users(id),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Table Fee Rules (tiers per operation and currency; no row = no fee)
+CREATE TABLE IF NOT EXISTS fee_rules (
+    id BIGSERIAL PRIMARY KEY,
+    operation VARCHAR(20) NOT NULL CHECK (operation IN ('transfer', 'withdrawal')),
+    currency VARCHAR(3) NOT NULL REFERENCES currencies(code),
+    min_amount BIGINT NOT NULL DEFAULT 0 CHECK (min_amount >= 0), -- tier applies from this amount
+    flat BIGINT NOT NULL DEFAULT 0 CHECK (flat >= 0),
+    rate_bps INT NOT NULL DEFAULT 0 CHECK (rate_bps BETWEEN 0 AND 10000), -- 1 bps = 0.01%
+    min_fee BIGINT NOT NULL DEFAULT 0 CHECK (min_fee >= 0), -- 0 = no minimum
+    max_fee BIGINT NOT NULL DEFAULT 0 CHECK (max_fee >= 0), -- 0 = no cap
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (operation, currency, min_amount),
+    CHECK (max_fee = 0 OR max_fee >= min_fee)
+);
+
+-- Table Interest Rates (annual, per product and currency; no row = no interest)
+CREATE TABLE IF NOT EXISTS interest_rates (
+    product VARCHAR(20) NOT NULL CHECK (product IN ('current', 'savings')),
+    currency VARCHAR(3) NOT NULL REFERENCES currencies(code),
+    rate_bps INT NOT NULL CHECK (rate_bps BETWEEN 0 AND 10000), -- 1 bps = 0.01% a year
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (product, currency)
+);
+
+-- Table Interest Accruals (1 per account per day, paid out monthly)
+CREATE TABLE IF NOT EXISTS interest_accruals (
+    account_id BIGINT NOT NULL REFERENCES accounts(id),
+    accrual_date DATE NOT NULL, -- UTC day of the end-of-day balance
+    balance BIGINT NOT NULL,
+    rate_bps INT NOT NULL,
+    amount_micros BIGINT NOT NULL CHECK (amount_micros >= 0), -- millionths of a minor unit
+    posted_at TIMESTAMPTZ,
+    journal_id BIGINT REFERENCES journal_transactions(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (account_id, accrual_date)
+);
+CREATE INDEX idx_interest_accruals_unposted ON interest_accruals (account_id, accrual_date) WHERE posted_at IS NULL;
+
+-- Table Transfer Idempotency Keys
+CREATE TABLE IF NOT EXISTS transfer_idempotency_keys (
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    idempotency_key VARCHAR(255) NOT NULL,
+    request_hash CHAR(64) NOT NULL,
+    transfer_id BIGINT NOT NULL REFERENCES transfers(id),
+    response JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, idempotency_key)
+);
+
+-- Table Scheduled Transfers (one-off when schedule = '', else cron)
+CREATE TABLE IF NOT EXISTS scheduled_transfers (
+    id BIGSERIAL PRIMARY KEY,
+    owner_id BIGINT NOT NULL REFERENCES users(id),
+    from_account_id BIGINT NOT NULL REFERENCES accounts(id),
+    to_account_id BIGINT NOT NULL REFERENCES accounts(id),
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    currency VARCHAR(3) NOT NULL REFERENCES currencies(code),
+    schedule VARCHAR(100) NOT NULL DEFAULT '',
+    status VARCHAR(10) NOT NULL DEFAULT 'active'
+        CHECK (status IN ('active', 'paused', 'completed', 'cancelled', 'failed')),
+    scheduled_for TIMESTAMPTZ, -- occurrence being executed; NULL once finished
+    next_run_at TIMESTAMPTZ, -- when the worker picks it up, later than scheduled_for on retries
+    attempts INT NOT NULL DEFAULT 0, -- failed attempts at scheduled_for
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+-- Index
+CREATE INDEX idx_scheduled_transfers_due ON scheduled_transfers (next_run_at) WHERE status = 'active';
+CREATE INDEX idx_scheduled_transfers_owner_created ON scheduled_transfers (owner_id, created_at, id);
+
+-- Table Scheduled Transfer Runs (one row per execution attempt)
+CREATE TABLE IF NOT EXISTS scheduled_transfer_runs (
+    id BIGSERIAL PRIMARY KEY,
+    scheduled_transfer_id BIGINT NOT NULL REFERENCES scheduled_transfers(id),
+    scheduled_for TIMESTAMPTZ NOT NULL,
+    attempt INT NOT NULL,
+    status VARCHAR(10) NOT NULL CHECK (status IN ('succeeded', 'failed')),
+    transfer_id BIGINT REFERENCES transfers(id),
+    error_code VARCHAR(64) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX idx_scheduled_transfer_runs_job ON scheduled_transfer_runs (scheduled_transfer_id, created_at);
+
+-- Table Holds (authorize now, capture into a transfer or void later)
+CREATE TABLE IF NOT EXISTS holds (
+    id BIGSERIAL PRIMARY KEY,
+    account_id BIGINT NOT NULL REFERENCES accounts(id),
+    to_account_id BIGINT NOT NULL REFERENCES accounts(id),
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    fee BIGINT NOT NULL DEFAULT 0 CHECK (fee >= 0), -- priced at authorize, charged at capture
+    currency VARCHAR(3) NOT NULL REFERENCES currencies(code),
+    status VARCHAR(10) NOT NULL DEFAULT 'authorized'
+        CHECK (status IN ('authorized', 'captured', 'voided', 'expired')),
+    captured_amount BIGINT NOT NULL DEFAULT 0,
+    transfer_id BIGINT REFERENCES transfers(id), -- set on capture
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+-- Index
+CREATE INDEX idx_holds_expiring ON holds (expires_at) WHERE status = 'authorized';
+CREATE INDEX idx_holds_account_created ON holds (account_id, created_at, id);
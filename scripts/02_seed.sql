@@ -1,5 +1,5 @@
 -- Clear data reset id = 1
-TRUNCATE TABLE users, accounts, entries, transfers RESTART IDENTITY CASCADE;
+TRUNCATE TABLE users, accounts, journal_transactions, entries, transfers, fee_rules, interest_rates, interest_accruals RESTART IDENTITY CASCADE;
 
 INSERT INTO users (email, username, password, first_name, last_name) VALUES
 ('user1@example.com', 'somchai', '$2a$10$2Cgf4hs0BxKCbhQwt2Tq/euFD9FWd0WMicPEs/7nukVZzIniE3.Om', 'Somchai', 'Jaidee'),
@@ -11,3 +11,46 @@ INSERT INTO accounts (owner_id, balance, currency) VALUES
 (1, 10000, 'USD'), -- 100.00
 (2, 0, 'THB'), -- 0.00
 (3, 100000000, 'THB'); -- 1,000,000.00
+
+-- System owner and Cash / Clearing accounts (login disabled)
+INSERT INTO users (email, username, password, first_name, last_name) VALUES
+('system@simplebank.local', 'system', '!', 'Simple', 'Bank');
+
+INSERT INTO accounts (owner_id, balance, currency, type)
+SELECT id, 0, c.currency, t.type
+FROM users, (VALUES ('THB'), ('USD')) AS c(currency), (VALUES ('cash'), ('fx'), ('fees'), ('suspense'), ('interest')) AS t(type)
+WHERE username = 'system';
+
+-- Opening journal: seeded balances against suspense, so every balance
+-- equals the sum of its entries (one transaction: balance is checked at commit)
+BEGIN;
+INSERT INTO journal_transactions (kind) VALUES ('opening');
+
+INSERT INTO entries (journal_id, account_id, amount)
+SELECT 1, id, balance FROM accounts WHERE balance <> 0;
+
+INSERT INTO entries (journal_id, account_id, amount)
+SELECT 1, s.id, -SUM(a.balance)
+FROM accounts a JOIN accounts s ON s.currency = a.currency AND s.type = 'suspense'
+WHERE a.balance <> 0
+GROUP BY s.id;
+
+UPDATE accounts s SET balance = (SELECT COALESCE(SUM(amount), 0) FROM entries WHERE account_id = s.id)
+WHERE type = 'suspense';
+COMMIT;
+
+-- Fee rules: large THB transfers pay 0.10% (10.00-50.00), USD transfers
+-- 0.5% (1.00-25.00) and THB withdrawals a flat 20.00
+INSERT INTO fee_rules (operation, currency, min_amount, flat, rate_bps, min_fee, max_fee) VALUES
+('transfer', 'THB', 5000000, 0, 10, 1000, 5000),
+('transfer', 'USD', 0, 0, 50, 100, 2500),
+('withdrawal', 'THB', 0, 2000, 0, 0, 0);
+
+-- Savings accounts earn 1.50% a year in THB and 1.00% in USD
+INSERT INTO interest_rates (product, currency, rate_bps) VALUES
+('savings', 'THB', 150),
+('savings', 'USD', 100);
+
+-- Back-office user (no accounts)
+INSERT INTO users (email, username, password, first_name, last_name, role) VALUES
+('admin@example.com', 'admin', '$2a$10$2Cgf4hs0BxKCbhQwt2Tq/euFD9FWd0WMicPEs/7nukVZzIniE3.Om', 'Ops', 'Admin', 'admin');